@@ -13,6 +13,7 @@ interface IBridge {
     // Sends the native currency to the other layer. On Layer 1 the native currency is ETH, while on Layer 2 it is OBX.
     // When it arrives on the other side it will be wrapped as a token.
     // receiver - the L2 address that will receive the assets on the other network.
+    // The fee of the message bus is taken from the value sent, and the rest is bridged.
     function sendNative(address receiver) external payable;
 
     // Sends ERC20 assets over to the other network. The user must grant allowance to the bridge
@@ -21,11 +22,12 @@ interface IBridge {
     // asset - the address of the smart contract of the ERC20 token.
     // amount - the number of tokens being transfered.
     // receiver - the L2 address receiving the assets.
+    // The value sent pays the fee of the message bus.
     function sendERC20(
         address asset,
         uint256 amount,
         address receiver
-    ) external;
+    ) external payable;
 
     // This function is called to retrieve assets that have been sent on the other layer.
     // In the basic implementation it is only callable from the CrossChainMessenger when a message is
@@ -6,12 +6,12 @@ pragma solidity >=0.7.0 <0.9.0;
 // and has the functionality to modify it.
 interface IObscuroBridgeAdmin {
     // This will whitelist a token and generate a cross chain message to the ITokenFactory
-    // to create wrapped tokens in case of succcess.
+    // to create wrapped tokens in case of succcess. The value sent pays the fee of the message bus.
     function whitelistToken(
         address asset,
         string calldata name,
         string calldata symbol
-    ) external;
+    ) external payable;
 
     // This will delist the token and queue a message for it to be delisted on L2. Notice that the token itself
     // can still be transfered between users, just not across chains.
@@ -43,7 +43,7 @@ contract ObscuroBridge is
         address asset,
         string calldata name,
         string calldata symbol
-    ) external onlyRole(ADMIN_ROLE) {
+    ) external payable onlyRole(ADMIN_ROLE) {
         _grantRole(ERC20_TOKEN_ROLE, asset);
 
         bytes memory data = abi.encodeWithSelector(
@@ -57,7 +57,8 @@ contract ObscuroBridge is
             data,
             uint32(Topics.MANAGEMENT),
             0,
-            0
+            0,
+            msg.value
         );
     }
 
@@ -70,22 +71,28 @@ contract ObscuroBridge is
     }
 
     function sendNative(address receiver) external payable override {
-        require(msg.value > 0, "Empty transfer.");
+        // the length of the message, which the fee depends on, does not depend on the amount
+        uint256 fee = messageFee(remoteBridgeAddress, receiveNativeCall(msg.value, receiver), 0);
+        require(msg.value > fee, "Empty transfer.");
 
-        bytes memory data = abi.encodeWithSelector(
+        bytes memory data = receiveNativeCall(msg.value - fee, receiver);
+        queueMessage(remoteBridgeAddress, data, uint32(Topics.TRANSFER), 0, 0, fee);
+    }
+
+    function receiveNativeCall(uint256 amount, address receiver) private pure returns (bytes memory) {
+        return abi.encodeWithSelector(
             IBridge.receiveAssets.selector,
             address(0x0),
-            msg.value,
+            amount,
             receiver
         );
-        queueMessage(remoteBridgeAddress, data, uint32(Topics.TRANSFER), 0, 0);
     }
 
     function sendERC20(
         address asset,
         uint256 amount,
         address receiver
-    ) external override {
+    ) external payable override {
         require(amount > 0, "Attempting empty transfer.");
         require(
             hasRole(ERC20_TOKEN_ROLE, asset),
@@ -106,7 +113,7 @@ contract ObscuroBridge is
             amount,
             receiver
         );
-        queueMessage(remoteBridgeAddress, data, uint32(Topics.TRANSFER), 0, 0);
+        queueMessage(remoteBridgeAddress, data, uint32(Topics.TRANSFER), 0, 0, msg.value);
     }
 
     function receiveAssets(
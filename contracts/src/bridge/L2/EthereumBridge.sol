@@ -55,23 +55,29 @@ contract EthereumBridge is
     }
 
     function sendNative(address receiver) external payable {
-        require(msg.value > 0, "Nothing sent.");
         require(hasTokenMapping(address(0x0)), "No mapping for token.");
+        // the length of the message, which the fee depends on, does not depend on the amount
+        uint256 fee = messageFee(remoteBridgeAddress, receiveNativeCall(msg.value, receiver), 0);
+        require(msg.value > fee, "Nothing sent.");
 
-        bytes memory data = abi.encodeWithSelector(
+        bytes memory data = receiveNativeCall(msg.value - fee, receiver);
+        queueMessage(remoteBridgeAddress, data, uint32(Topics.TRANSFER), 0, 0, fee);
+    }
+
+    function receiveNativeCall(uint256 amount, address receiver) private view returns (bytes memory) {
+        return abi.encodeWithSelector(
             IBridge.receiveAssets.selector,
             localToRemoteToken[address(0x0)],
-            msg.value,
+            amount,
             receiver
         );
-        queueMessage(remoteBridgeAddress, data, uint32(Topics.TRANSFER), 0, 0);
     }
 
     function sendERC20(
         address asset,
         uint256 amount,
         address receiver
-    ) external {
+    ) external payable {
         require(hasTokenMapping(asset), "No mapping for token.");
 
         WrappedERC20 token = wrappedTokens[asset];
@@ -83,7 +89,7 @@ contract EthereumBridge is
             amount,
             receiver
         );
-        queueMessage(remoteBridgeAddress, data, uint32(Topics.TRANSFER), 0, 0);
+        queueMessage(remoteBridgeAddress, data, uint32(Topics.TRANSFER), 0, 0, msg.value);
     }
 
     function receiveAssets(
@@ -47,15 +47,18 @@ contract ManagementContract {
     //
 
 // TODO: ensure challenge period is added on top of block timestamp.
-    function pushCrossChainMessages(Structs.HeaderCrossChainData calldata crossChainData) internal {
-        uint256 messagesLength = crossChainData.messages.length;
-        for (uint256 i = 0; i < messagesLength; ++i) {
-            messageBus.storeCrossChainMessage(crossChainData.messages[i], 1); //TODO - make finality depend on rollup challenge period
+    // The outbound messages of the rollup are committed to in its cross chain root, so they are not stored one by one.
+    // They are verified against the root with a merkle proof when relayed.
+    function pushCrossChainRoot(bytes32 crossChainRoot) internal {
+        if (crossChainRoot == bytes32(0)) {
+            // the rollup has no outbound messages
+            return;
         }
+        messageBus.storeCrossChainRoot(crossChainRoot, 1); //TODO - make finality depend on rollup challenge period
     }
 
     // solc-ignore-next-line unused-param
-    function AddRollup(Structs.MetaRollup calldata r, string calldata  _rollupData, Structs.HeaderCrossChainData calldata crossChainData) public {
+    function AddRollup(Structs.MetaRollup calldata r, string calldata  _rollupData) public {
         // TODO How to ensure the sender without hashing the calldata ?
         // bytes32 derp = keccak256(abi.encodePacked(ParentHash, AggregatorID, L1Block, Number, rollupData));
 
@@ -65,7 +68,27 @@ contract ManagementContract {
         require(attested[r.AggregatorID], "aggregator not attested");
 
         AppendRollup(r);
-        pushCrossChainMessages(crossChainData);
+        pushCrossChainRoot(r.CrossChainRoot);
+    }
+
+    // Users submit L2 transactions here, encrypted with the enclave public key, when the sequencer leaves them out of the
+    // batches. The enclaves read them from the calldata, and the batches built on the L1 blocks past the inclusion
+    // window of the transaction are only valid if they include it.
+    function SubmitForcedTransaction(bytes calldata _encryptedTx) public {
+        require(_encryptedTx.length > 0, "empty forced transaction");
+    }
+
+    // Sets the fee paid to the L1 message bus for each message published to Obscuro: a base fee plus a fee per byte of
+    // payload, which prices the synthetic transactions delivering the messages.
+    function SetMessageFees(uint256 baseFee, uint256 feePerByte) public {
+        require(attested[msg.sender], "aggregator not attested");
+        MessageBus.MessageBus(address(messageBus)).setMessageFees(baseFee, feePerByte);
+    }
+
+    // Sends the message fees collected by the L1 message bus to the receiver.
+    function WithdrawMessageFees(address payable receiver) public {
+        require(attested[msg.sender], "aggregator not attested");
+        MessageBus.MessageBus(address(messageBus)).withdrawFees(receiver);
     }
 
     // InitializeNetworkSecret kickstarts the network secret, can only be called once
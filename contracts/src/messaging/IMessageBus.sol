@@ -28,12 +28,16 @@ interface IMessageBus {
     // Notice that consistencyLevel == 0 is still secure, but might make your protocol result more prone to reorganizations.
     // returns sequence - this is the unique id of the published message for the address calling the function. It can be used
     // to determine the order of incoming messages on the other side and if something is missing.
+    // The value sent must cover the fee returned by getPublishFee for the payload.
     function publishMessage(
         uint32 nonce,
         uint32 topic,
         bytes calldata payload, 
         uint8 consistencyLevel
-    ) external returns (uint64 sequence);
+    ) external payable returns (uint64 sequence);
+
+    // Returns the fee for publishing a message with a payload of the given length.
+    function getPublishFee(uint256 payloadLength) external view returns (uint256);
 
     // This function verifies that a cross chain message provided by the caller has indeed been submitted from the other network
     // and returns true only if the challenge period for the message has passed.
@@ -42,8 +46,19 @@ interface IMessageBus {
     // Returns the time when a message is final (when the rollup challenge period has passed). If the message was never submitted the call will revert.
     function getMessageTimeOfFinality(Structs.CrossChainMessage calldata crossChainMessage) external view returns (uint256);
 
+    // This function verifies that a cross chain message provided by the caller is included in the cross chain root of a rollup,
+    // using a merkle proof, and returns true only if the challenge period for the root has passed.
+    function verifyMessageInclusion(Structs.CrossChainMessage calldata crossChainMessage, bytes32[] calldata proof, bytes32 root) external view returns (bool);
+
+    // Returns the time when a cross chain root is final (when the rollup challenge period has passed). If the root was never submitted the call will revert.
+    function getRootTimeOfFinality(bytes32 root) external view returns (uint256);
+
     // This is the smart contract function which is used to store messages sent from the other linked layer. 
     // The function will be called by the ManagementContract on L1 and the enclave on L2. 
     // It should be access controlled and called according to the consistencyLevel and Obscuro platform rules.
     function storeCrossChainMessage(Structs.CrossChainMessage calldata crossChainMessage, uint256 finalAfterTimestamp) external;
+
+    // This is the smart contract function which is used to store the cross chain roots of the rollups published by the other linked layer.
+    // The function will be called by the ManagementContract on L1.
+    function storeCrossChainRoot(bytes32 root, uint256 finalAfterTimestamp) external;
 }
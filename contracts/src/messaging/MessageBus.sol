@@ -5,16 +5,22 @@ import "./IMessageBus.sol";
 import "./Structs.sol";
 
 import "@openzeppelin/contracts/access/Ownable.sol";
+import "@openzeppelin/contracts/utils/cryptography/MerkleProof.sol";
 
 contract MessageBus is IMessageBus, Ownable {
-    function messageFee() internal virtual returns (uint256) {
-        return 0;
-    }
+    // The fee paid when publishing a message, which prices the synthetic transaction delivering it on the other layer.
+    // It is a base fee per message plus a fee per byte of payload, as the gas of the delivery grows with the payload.
+    uint256 baseMessageFee;
+    uint256 messageFeePerByte;
 
     // This mapping contains the block timestamps where messages become valid
     // It is used in order to have challenge period.
     mapping(bytes32 => uint256) messageFinalityTimestamps;
 
+    // This mapping contains the block timestamps where the cross chain roots published in the rollups become valid.
+    // The messages included in a root can be verified with a merkle proof once it is final.
+    mapping(bytes32 => uint256) rootFinalityTimestamps;
+
     // The stored messages, currently unconsumed.
     mapping(address => mapping(uint32 => Structs.CrossChainMessage[])) messages;
 
@@ -38,14 +44,14 @@ contract MessageBus is IMessageBus, Ownable {
     // Notice that consistencyLevel == 0 is still secure, but might make your protocol result more prone to reorganizations.
     // returns sequence - this is the unique id of the published message for the address calling the function. It can be used
     // to determine the order of incoming messages on the other side and if something is missing.
+    // The value sent must cover the fee returned by getPublishFee for the payload.
     function publishMessage(
         uint32 nonce,
         uint32 topic,
         bytes calldata payload,
         uint8 consistencyLevel
-    ) external override returns (uint64 sequence) {
-        //TODO: implement messageFee mechanism.
-        //require(msg.value >= messageFee());
+    ) external payable override returns (uint64 sequence) {
+        require(msg.value >= getPublishFee(payload.length), "Insufficient message fee.");
 
         sequence = incrementSequence(msg.sender);
         emit LogMessagePublished(
@@ -59,6 +65,28 @@ contract MessageBus is IMessageBus, Ownable {
         return sequence;
     }
 
+    // Returns the fee for publishing a message with a payload of the given length.
+    function getPublishFee(
+        uint256 payloadLength
+    ) public view override returns (uint256) {
+        return baseMessageFee + payloadLength * messageFeePerByte;
+    }
+
+    // Sets the fee schedule of the messages published from now on.
+    function setMessageFees(
+        uint256 baseFee,
+        uint256 feePerByte
+    ) external onlyOwner {
+        baseMessageFee = baseFee;
+        messageFeePerByte = feePerByte;
+    }
+
+    // Sends the fees collected so far to the receiver.
+    function withdrawFees(address payable receiver) external onlyOwner {
+        (bool sent, ) = receiver.call{value: address(this).balance}("");
+        require(sent, "Failed to send the fees.");
+    }
+
     // This function verifies that a cross chain message provided by the caller has indeed been submitted from the other network
     // and returns true only if the challenge period for the message has passed.
     function verifyMessageFinalized(
@@ -83,6 +111,47 @@ contract MessageBus is IMessageBus, Ownable {
         return timeOfFinality;
     }
 
+    // This function verifies that a cross chain message provided by the caller is included in the cross chain root of a
+    // rollup, using the merkle proof returned by the obscuro_getCrossChainProof RPC of the other network, and returns
+    // true only if the challenge period for the root has passed.
+    function verifyMessageInclusion(
+        Structs.CrossChainMessage calldata crossChainMessage,
+        bytes32[] calldata proof,
+        bytes32 root
+    ) external view override returns (bool) {
+        uint256 timestamp = rootFinalityTimestamps[root];
+        //timestamp exists and block current time has surpassed it.
+        if (timestamp == 0 || timestamp > block.timestamp) {
+            return false;
+        }
+
+        bytes32 leaf = keccak256(abi.encode(crossChainMessage));
+        return MerkleProof.verify(proof, root, leaf);
+    }
+
+    // Returns the time when a cross chain root is final (when the rollup challenge period has passed). If the root was never submitted the call will revert.
+    function getRootTimeOfFinality(
+        bytes32 root
+    ) external view override returns (uint256) {
+        uint256 timeOfFinality = rootFinalityTimestamps[root];
+
+        require(timeOfFinality > 0, "This root was never submitted.");
+        return timeOfFinality;
+    }
+
+    // This is the smart contract function which is used to store the cross chain roots of the rollups published by the other linked layer.
+    // The function will be called by the ManagementContract on L1.
+    // A root that was already submitted keeps its original time of finality.
+    function storeCrossChainRoot(
+        bytes32 root,
+        uint256 finalAfterTimestamp
+    ) external override onlyOwner {
+        if (rootFinalityTimestamps[root] != 0) {
+            return;
+        }
+        rootFinalityTimestamps[root] = block.timestamp + finalAfterTimestamp;
+    }
+
     // This is the smart contract function which is used to store messages sent from the other linked layer.
     // The function will be called by the ManagementContract on L1 and the enclave on L2.
     // It should be access controlled and called according to the consistencyLevel and Obscuro platform rules.
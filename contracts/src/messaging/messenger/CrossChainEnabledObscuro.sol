@@ -52,16 +52,30 @@ abstract contract CrossChainEnabledObscuro {
     // gas - when doing target.call{} this gas will be the limit set for the call. *Currently Unused*
     // consinstencyLevel - Block confirmations before finalizing message. Obscuro allows that even 0 is secure,
     // but it might not be suitable for all protocols.
+    // fee - the value paid to the message bus, which must cover the fee returned by messageFee.
     function queueMessage(
         address target,
         bytes memory message,
         uint32 topic,
         uint256 gas,
-        uint8 consistencyLevel
+        uint8 consistencyLevel,
+        uint256 fee
     ) internal {
         bytes memory payload = abi.encode(
             ICrossChainMessenger.CrossChainCall(target, message, gas)
         );
-        messageBus.publishMessage(nonce++, topic, payload, consistencyLevel);
+        messageBus.publishMessage{value: fee}(nonce++, topic, payload, consistencyLevel);
+    }
+
+    // Returns the fee the message bus charges for queueing the message.
+    function messageFee(
+        address target,
+        bytes memory message,
+        uint256 gas
+    ) internal view returns (uint256) {
+        bytes memory payload = abi.encode(
+            ICrossChainMessenger.CrossChainCall(target, message, gas)
+        );
+        return messageBus.getPublishFee(payload.length);
     }
 }
@@ -19,7 +19,7 @@ contract CrossChainMessenger is ICrossChainMessenger {
 
     IMessageBus messageBusContract;
     address public crossChainSender = address(0x0);
-    mapping(bytes32 => bool) messageConsumed;
+    mapping(bytes32 => bool) public messageConsumed;
 
     constructor(address messageBusAddr) {
         messageBusContract = IMessageBus(messageBusAddr);
@@ -38,6 +38,24 @@ contract CrossChainMessenger is ICrossChainMessenger {
             messageBusContract.verifyMessageFinalized(message),
             "Message not found or finalized."
         );
+        markConsumed(message);
+    }
+
+    // Will verify that the message is included in a final cross chain root & has not been already consumed and will
+    // mark it as consumed.
+    function consumeMessageWithProof(
+        Structs.CrossChainMessage calldata message,
+        bytes32[] calldata proof,
+        bytes32 root
+    ) private {
+        require(
+            messageBusContract.verifyMessageInclusion(message, proof, root),
+            "Message not included in a finalized root."
+        );
+        markConsumed(message);
+    }
+
+    function markConsumed(Structs.CrossChainMessage calldata message) private {
         bytes32 msgHash = keccak256(abi.encode(message));
         require(messageConsumed[msgHash] == false, "Message already consumed.");
 
@@ -59,7 +77,22 @@ contract CrossChainMessenger is ICrossChainMessenger {
     // the address of the message sender on the other layer, as it is when reaching the message bus.
     function relayMessage(Structs.CrossChainMessage calldata message) public {
         consumeMessage(message);
+        deliverMessage(message);
+    }
+
+    // This function can be called by anyone and if the message @param is included in a finalized cross chain root
+    // of the message bus, then the function will push it to the targeted smart contract.
+    // The proof and the root are returned by the obscuro_getCrossChainProof RPC of the network that published the message.
+    function relayMessageWithProof(
+        Structs.CrossChainMessage calldata message,
+        bytes32[] calldata proof,
+        bytes32 root
+    ) public {
+        consumeMessageWithProof(message, proof, root);
+        deliverMessage(message);
+    }
 
+    function deliverMessage(Structs.CrossChainMessage calldata message) private {
         crossChainSender = message.sender;
 
         //TODO: Do not relay to self. Do not relay to known contracts. Consider what else not to talk to.
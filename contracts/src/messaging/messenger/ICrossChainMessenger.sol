@@ -21,4 +21,8 @@ interface ICrossChainMessenger {
     // Relays messages that exist in the message bus and have not been already consumed to the
     // target encdoded in the message, with the params encoded in the message.
     function relayMessage(Structs.CrossChainMessage calldata message) external;
+
+    // Relays messages that are included in a finalized cross chain root of the message bus and have not been already
+    // consumed to the target encoded in the message, with the params encoded in the message.
+    function relayMessageWithProof(Structs.CrossChainMessage calldata message, bytes32[] calldata proof, bytes32 root) external;
 }
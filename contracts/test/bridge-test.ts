@@ -308,4 +308,58 @@ describe("Bridge", function () {
         consistencyLevel: decodedEvent.args[5]
       })).to.be.revertedWith("Message not found or finalized.");
   });
+
+  it("Message bus charges the message fees, which the bridge takes from the value sent", async function () {
+      const [owner] = await ethers.getSigners();
+      const payload = "0x" + "01".repeat(100);
+
+      await expect(busL1.setMessageFees(1000, 10)).to.not.be.reverted;
+      expect(await busL1.getPublishFee(100)).to.equal(2000);
+      await expect(busL1.publishMessage(0, 0, payload, 0, { value: 1999 })).revertedWith("Insufficient message fee.");
+      await expect(busL1.publishMessage(0, 0, payload, 0, { value: 2000 })).to.not.be.reverted;
+
+      const sendTx = bridgeL1.sendNative(owner.address, { value: 1_000_000 });
+      await expect(sendTx).to.not.be.reverted;
+      const messages = await submitMessagesFromTx(await sendTx);
+      const message = messages!.bindings[0].msg;
+      const fee = await busL1.getPublishFee(ethers.utils.hexDataLength(message.payload));
+      const call = ethers.utils.defaultAbiCoder.decode(["tuple(address,bytes,uint256)"], message.payload)[0];
+      const transfer = bridgeL1.interface.decodeFunctionData("receiveAssets", call[1]);
+      expect(transfer.amount, "The bridged amount should be the value minus the fee").to.equal(ethers.BigNumber.from(1_000_000).sub(fee));
+      expect(await ethers.provider.getBalance(busL1.address)).to.equal(fee.add(2000));
+
+      await expect(bridgeL1.sendNative(owner.address, { value: fee }), "The value should cover more than the fee").revertedWith("Empty transfer.");
+      await expect(busL1.withdrawFees(owner.address)).to.not.be.reverted;
+      expect(await ethers.provider.getBalance(busL1.address)).to.equal(0);
+  });
+
+  it("Message bus verifies messages included in a final cross chain root", async function () {
+      const [owner] = await ethers.getSigners();
+      const messageType = ["tuple(address,uint64,uint32,uint32,bytes,uint8)"];
+      const messages = [0, 1].map((sequence) => ({
+        sender: owner.address,
+        sequence: sequence,
+        nonce: 0,
+        topic: 0,
+        payload: "0x0102",
+        consistencyLevel: 0,
+      }));
+      const leaves = messages.map((m) => ethers.utils.keccak256(ethers.utils.defaultAbiCoder.encode(messageType,
+        [[m.sender, m.sequence, m.nonce, m.topic, m.payload, m.consistencyLevel]])));
+
+      // the pairs of the tree are hashed in sorted order
+      const sortedLeaves = [...leaves].sort((a, b) => (ethers.BigNumber.from(a).lt(ethers.BigNumber.from(b)) ? -1 : 1));
+      const root = ethers.utils.keccak256(ethers.utils.concat(sortedLeaves));
+
+      expect(await busL1.verifyMessageInclusion(messages[0], [leaves[1]], root), "Unknown root should not verify").to.be.false;
+      await expect(busL1.getRootTimeOfFinality(root)).revertedWith("This root was never submitted.");
+
+      await expect(busL1.storeCrossChainRoot(root, 100)).to.not.be.reverted;
+      expect(await busL1.verifyMessageInclusion(messages[0], [leaves[1]], root), "Root should not verify before it is final").to.be.false;
+
+      await time.increase(101);
+      expect(await busL1.verifyMessageInclusion(messages[0], [leaves[1]], root)).to.be.true;
+      expect(await busL1.verifyMessageInclusion(messages[1], [leaves[0]], root)).to.be.true;
+      expect(await busL1.verifyMessageInclusion({...messages[0], nonce: 1}, [leaves[1]], root), "Tampered message should not verify").to.be.false;
+  });
 });
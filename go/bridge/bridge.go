@@ -0,0 +1,214 @@
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/ethadapter/messagebuslib"
+	"github.com/obscuronet/go-obscuro/go/wallet"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+// the gas limit of the L2 transactions if their gas cannot be estimated
+const _l2GasLimit = uint64(1_000_000)
+
+// L1Client is the part of the L1 client the bridge uses. It is implemented by the ethadapter.EthClient.
+type L1Client interface {
+	PrepareTransactionToSend(txData types.TxData, from gethcommon.Address, nonce uint64) (types.TxData, error)
+	SendTransaction(signedTx *types.Transaction) error
+	TransactionReceipt(hash gethcommon.Hash) (*types.Receipt, error)
+	Nonce(address gethcommon.Address) (uint64, error)
+	CallContract(msg ethereum.CallMsg) ([]byte, error)
+	FetchHeadBlock() (*types.Block, error)
+}
+
+// L2Client is the part of the Obscuro client the bridge uses. It is implemented by the obsclient.AuthObsClient, which
+// must be authenticated with the viewing key of the L2 wallet of the bridge client. As with the AuthObsClient, the
+// result of CallContract is hex encoded.
+type L2Client interface {
+	Address() gethcommon.Address
+	NonceAt(ctx context.Context, blockNumber *big.Int) (uint64, error)
+	EstimateGasAndGasPrice(txData types.TxData) types.TxData
+	SendTransaction(ctx context.Context, signedTx *types.Transaction) error
+	TransactionReceipt(ctx context.Context, txHash gethcommon.Hash) (*types.Receipt, error)
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	GetCrossChainProof(txHash gethcommon.Hash, logIndex uint) (*common.CrossChainProof, error)
+}
+
+// Config holds the addresses of the bridge contracts and of the messaging layer they are built on
+type Config struct {
+	L1BridgeAddress     gethcommon.Address // The ObscuroBridge contract
+	L1MessageBusAddress gethcommon.Address
+	L1MessengerAddress  gethcommon.Address // The cross chain messenger delivering the withdrawals to the L1 bridge
+	L2BridgeAddress     gethcommon.Address // The EthereumBridge contract
+	L2MessageBusAddress gethcommon.Address
+	PollInterval        time.Duration // How often the chains are polled while waiting for a transaction or a message
+}
+
+// Client transfers assets between the L1 and the Obscuro network through the bridge contracts, and tracks the
+// transfers until they can be claimed on the other layer. The progress of the transfers is reported as a stream of
+// events.
+type Client struct {
+	config       *Config
+	l1Client     L1Client
+	l2Client     L2Client
+	l1Wallet     wallet.Wallet // Pays for the deposits
+	l2Wallet     wallet.Wallet // Pays for the withdrawals
+	l1MessageBus messagebuslib.MessageBusLib
+	l2MessageBus messagebuslib.MessageBusLib
+	bridgeLib    *bridgeLib
+
+	// the transactions of each layer are sent one at a time, so that they get consecutive nonces
+	l1TxMutex sync.Mutex
+	l2TxMutex sync.Mutex
+
+	events event.Feed
+	logger gethlog.Logger
+}
+
+func NewClient(config *Config, l1Client L1Client, l2Client L2Client, l1Wallet wallet.Wallet, l2Wallet wallet.Wallet, logger gethlog.Logger) *Client {
+	return &Client{
+		config:       config,
+		l1Client:     l1Client,
+		l2Client:     l2Client,
+		l1Wallet:     l1Wallet,
+		l2Wallet:     l2Wallet,
+		l1MessageBus: messagebuslib.NewMessageBusLib(&config.L1MessageBusAddress),
+		l2MessageBus: messagebuslib.NewMessageBusLib(&config.L2MessageBusAddress),
+		bridgeLib:    newBridgeLib(),
+		logger:       logger,
+	}
+}
+
+// SubscribeEvents sends the events of the transfers made or tracked by the client to the channel, until the
+// subscription is cancelled. The events are sent synchronously, so the channel should be buffered and read until the
+// subscription is cancelled.
+func (c *Client) SubscribeEvents(ch chan<- Event) event.Subscription {
+	return c.events.Subscribe(ch)
+}
+
+// sendL1Transaction signs and sends the transaction from the L1 wallet, and waits for its successful receipt. It returns
+// the hash of the transaction with the receipt.
+func (c *Client) sendL1Transaction(ctx context.Context, to gethcommon.Address, value *big.Int, data []byte) (gethcommon.Hash, *types.Receipt, error) {
+	c.l1TxMutex.Lock()
+	defer c.l1TxMutex.Unlock()
+
+	nonce, err := c.l1Client.Nonce(c.l1Wallet.Address())
+	if err != nil {
+		return gethcommon.Hash{}, nil, fmt.Errorf("could not fetch the nonce of the L1 account. Cause: %w", err)
+	}
+	// the transactions sent before may still be pending
+	if nonce < c.l1Wallet.GetNonce() {
+		nonce = c.l1Wallet.GetNonce()
+	}
+	tx, err := c.l1Client.PrepareTransactionToSend(&types.LegacyTx{To: &to, Value: value, Data: data}, c.l1Wallet.Address(), nonce)
+	if err != nil {
+		return gethcommon.Hash{}, nil, fmt.Errorf("could not estimate gas/gas price for L1 tx. Cause: %w", err)
+	}
+	signedTx, err := c.l1Wallet.SignTransaction(tx)
+	if err != nil {
+		return gethcommon.Hash{}, nil, fmt.Errorf("could not sign L1 tx. Cause: %w", err)
+	}
+	if err = c.l1Client.SendTransaction(signedTx); err != nil {
+		return gethcommon.Hash{}, nil, fmt.Errorf("could not broadcast L1 tx. Cause: %w", err)
+	}
+	c.l1Wallet.SetNonce(nonce + 1)
+
+	receipt, err := c.awaitReceipt(ctx, signedTx.Hash(), func() (*types.Receipt, error) {
+		return c.l1Client.TransactionReceipt(signedTx.Hash())
+	})
+	return signedTx.Hash(), receipt, err
+}
+
+// sendL2Transaction signs and sends the transaction from the L2 wallet, and waits for its successful receipt
+func (c *Client) sendL2Transaction(ctx context.Context, to gethcommon.Address, value *big.Int, data []byte) (gethcommon.Hash, *types.Receipt, error) {
+	c.l2TxMutex.Lock()
+	defer c.l2TxMutex.Unlock()
+
+	nonce, err := c.l2Client.NonceAt(ctx, nil)
+	if err != nil {
+		return gethcommon.Hash{}, nil, fmt.Errorf("could not fetch the nonce of the L2 account. Cause: %w", err)
+	}
+	if nonce < c.l2Wallet.GetNonce() {
+		nonce = c.l2Wallet.GetNonce()
+	}
+	tx := c.l2Client.EstimateGasAndGasPrice(&types.LegacyTx{Nonce: nonce, Gas: _l2GasLimit, To: &to, Value: value, Data: data})
+	signedTx, err := c.l2Wallet.SignTransaction(tx)
+	if err != nil {
+		return gethcommon.Hash{}, nil, fmt.Errorf("could not sign L2 tx. Cause: %w", err)
+	}
+	if err = c.l2Client.SendTransaction(ctx, signedTx); err != nil {
+		return gethcommon.Hash{}, nil, fmt.Errorf("could not send L2 tx. Cause: %w", err)
+	}
+	c.l2Wallet.SetNonce(nonce + 1)
+
+	receipt, err := c.awaitReceipt(ctx, signedTx.Hash(), func() (*types.Receipt, error) {
+		return c.l2Client.TransactionReceipt(ctx, signedTx.Hash())
+	})
+	return signedTx.Hash(), receipt, err
+}
+
+// awaitReceipt polls the receipt of the transaction until it is found or the context is done, and checks that the
+// transaction was successful
+func (c *Client) awaitReceipt(ctx context.Context, txHash gethcommon.Hash, fetchReceipt func() (*types.Receipt, error)) (*types.Receipt, error) {
+	var receipt *types.Receipt
+	err := c.poll(ctx, func() (bool, error) {
+		var err error
+		receipt, err = fetchReceipt()
+		if err != nil || receipt == nil {
+			c.logger.Trace("Receipt not available yet", log.TxKey, txHash, log.ErrKey, err)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve receipt for tx=%s. Cause: %w", txHash, err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("unsuccessful receipt found for tx=%s", txHash)
+	}
+	return receipt, nil
+}
+
+// poll calls the function every poll interval until it is done, it fails or the context is done
+func (c *Client) poll(ctx context.Context, fn func() (bool, error)) error {
+	ticker := time.NewTicker(c.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		done, err := fn()
+		if done || err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// publishedMessage returns the message published by the message bus in the logs of the receipt of the transaction, and
+// the index of its log
+func publishedMessage(txHash gethcommon.Hash, receipt *types.Receipt, messageBus messagebuslib.MessageBusLib) (common.CrossChainMessage, uint, error) {
+	for _, l := range receipt.Logs {
+		if l.Address != *messageBus.GetContractAddr() || len(l.Topics) == 0 || l.Topics[0] != messageBus.MessagePublishedTopic() {
+			continue
+		}
+		msg, err := messageBus.DecodeMessagePublished(*l)
+		if err != nil {
+			return common.CrossChainMessage{}, 0, err
+		}
+		return msg, l.Index, nil
+	}
+	return common.CrossChainMessage{}, 0, fmt.Errorf("no message published by tx=%s", txHash)
+}
@@ -0,0 +1,133 @@
+package bridge
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/obscuronet/go-obscuro/contracts/generated/CrossChainMessenger"
+	"github.com/obscuronet/go-obscuro/contracts/generated/ERC20"
+	"github.com/obscuronet/go-obscuro/contracts/generated/EthereumBridge"
+	"github.com/obscuronet/go-obscuro/contracts/generated/ObscuroBridge"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	sendNativeMethod      = "sendNative"
+	sendERC20Method       = "sendERC20"
+	receiveAssetsMethod   = "receiveAssets"
+	approveMethod         = "approve"
+	messageConsumedMethod = "messageConsumed"
+)
+
+// bridgeLib packs the calls to the bridge contracts of both layers, to the ERC20 tokens they transfer and to the L1
+// cross chain messenger delivering the withdrawals
+type bridgeLib struct {
+	l1BridgeABI  abi.ABI
+	l2BridgeABI  abi.ABI
+	erc20ABI     abi.ABI
+	messengerABI abi.ABI
+
+	// the encoding of ICrossChainMessenger.CrossChainCall, which is the payload of the messages queued by the bridges
+	crossChainCallArgs abi.Arguments
+}
+
+func newBridgeLib() *bridgeLib {
+	crossChainCallType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "target", Type: "address"},
+		{Name: "data", Type: "bytes"},
+		{Name: "gas", Type: "uint256"},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return &bridgeLib{
+		l1BridgeABI:        mustParseABI(ObscuroBridge.ObscuroBridgeMetaData.ABI),
+		l2BridgeABI:        mustParseABI(EthereumBridge.EthereumBridgeMetaData.ABI),
+		erc20ABI:           mustParseABI(ERC20.ERC20MetaData.ABI),
+		messengerABI:       mustParseABI(CrossChainMessenger.CrossChainMessengerMetaData.ABI),
+		crossChainCallArgs: abi.Arguments{{Type: crossChainCallType}},
+	}
+}
+
+// sendNative packs the call transferring the value of the transaction to the receiver on the other layer. The L1 and
+// L2 bridges have the same signature.
+func (b *bridgeLib) sendNative(receiver gethcommon.Address) ([]byte, error) {
+	return pack(b.l1BridgeABI, sendNativeMethod, receiver)
+}
+
+// sendERC20 packs the call transferring the amount of the ERC20 asset to the receiver on the other layer. The L1 bridge
+// takes the amount from the sender, which has to approve it first, while the L2 bridge burns the wrapped tokens.
+func (b *bridgeLib) sendERC20(l2 bool, asset gethcommon.Address, amount *big.Int, receiver gethcommon.Address) ([]byte, error) {
+	if l2 {
+		return pack(b.l2BridgeABI, sendERC20Method, asset, amount, receiver)
+	}
+	return pack(b.l1BridgeABI, sendERC20Method, asset, amount, receiver)
+}
+
+// depositPayloadLength returns the length of the payload of the message the L1 bridge publishes for a deposit, which the
+// fee of the message bus is computed from. The native and ERC20 deposits both call receiveAssets on the L2 bridge, whose
+// arguments have a fixed size, so the length does not depend on the deposit.
+func (b *bridgeLib) depositPayloadLength() (int, error) {
+	data, err := pack(b.l2BridgeABI, receiveAssetsMethod, gethcommon.Address{}, big.NewInt(0), gethcommon.Address{})
+	if err != nil {
+		return 0, err
+	}
+	payload, err := b.crossChainCallArgs.Pack(struct {
+		Target gethcommon.Address
+		Data   []byte
+		Gas    *big.Int
+	}{gethcommon.Address{}, data, big.NewInt(0)})
+	if err != nil {
+		return 0, fmt.Errorf("could not pack cross chain call. Cause: %w", err)
+	}
+	return len(payload), nil
+}
+
+func (b *bridgeLib) approve(spender gethcommon.Address, amount *big.Int) ([]byte, error) {
+	return pack(b.erc20ABI, approveMethod, spender, amount)
+}
+
+// messageConsumed returns the call checking if the messenger has already delivered the message with the hash
+func (b *bridgeLib) messageConsumed(messenger gethcommon.Address, msgHash gethcommon.Hash) (ethereum.CallMsg, error) {
+	data, err := pack(b.messengerABI, messageConsumedMethod, msgHash)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+	return ethereum.CallMsg{To: &messenger, Data: data}, nil
+}
+
+func (b *bridgeLib) decodeMessageConsumed(callResponse []byte) (bool, error) {
+	unpacked, err := b.messengerABI.Unpack(messageConsumedMethod, callResponse)
+	if err != nil {
+		return false, fmt.Errorf("could not unpack %s response. Cause: %w", messageConsumedMethod, err)
+	}
+	if len(unpacked) != 1 {
+		return false, fmt.Errorf("unexpected %s response length %d", messageConsumedMethod, len(unpacked))
+	}
+	consumed, ok := unpacked[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected %s response type %T", messageConsumedMethod, unpacked[0])
+	}
+	return consumed, nil
+}
+
+func pack(contractABI abi.ABI, method string, args ...interface{}) ([]byte, error) {
+	data, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not pack %s call. Cause: %w", method, err)
+	}
+	return data, nil
+}
+
+func mustParseABI(contractABI string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(contractABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
@@ -0,0 +1,364 @@
+package bridge
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/ethadapter/messagebuslib"
+	"github.com/obscuronet/go-obscuro/go/wallet"
+	"github.com/obscuronet/go-obscuro/integration/datagenerator"
+	"github.com/stretchr/testify/require"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	timeOfFinality    = 100
+	testTimeout       = 5 * time.Second
+	baseMessageFee    = 1_000
+	messageFeePerByte = 10
+	// the offset of the CrossChainCall, its target, gas and data offset, the length of the data and the 100 bytes of the
+	// receiveAssets call, padded to 128
+	receiveAssetsPayloadLength = 32 + 3*32 + 32 + 128
+)
+
+var (
+	testConfig = &Config{
+		L1BridgeAddress:     datagenerator.RandomAddress(),
+		L1MessageBusAddress: datagenerator.RandomAddress(),
+		L1MessengerAddress:  datagenerator.RandomAddress(),
+		L2BridgeAddress:     datagenerator.RandomAddress(),
+		L2MessageBusAddress: datagenerator.RandomAddress(),
+		PollInterval:        10 * time.Millisecond,
+	}
+	messageBusABI, _ = abi.JSON(strings.NewReader(messagebuslib.MessageBusABI))
+)
+
+// fakeL1Client mines every transaction immediately, and emulates the bridge publishing a message for the calls to it.
+// The messenger and the message bus answer the calls with the state set by the test, and the message bus charges a base
+// fee plus a fee per byte of payload.
+type fakeL1Client struct {
+	mu          sync.Mutex
+	receipts    map[gethcommon.Hash]*types.Receipt
+	sentTxs     []*types.Transaction
+	rootStored  bool
+	msgRelayed  bool
+	headTime    uint64
+	messageSent common.CrossChainMessage
+}
+
+func (c *fakeL1Client) PrepareTransactionToSend(txData types.TxData, _ gethcommon.Address, nonce uint64) (types.TxData, error) {
+	tx := types.NewTx(txData)
+	return &types.LegacyTx{Nonce: nonce, Gas: 100_000, GasPrice: big.NewInt(1), To: tx.To(), Value: tx.Value(), Data: tx.Data()}, nil
+}
+
+func (c *fakeL1Client) SendTransaction(signedTx *types.Transaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sentTxs = append(c.sentTxs, signedTx)
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: signedTx.Hash()}
+	if *signedTx.To() == testConfig.L1BridgeAddress {
+		receipt.Logs = []*types.Log{messagePublishedLog(testConfig.L1MessageBusAddress, c.messageSent, 0)}
+	}
+	c.receipts[signedTx.Hash()] = receipt
+	return nil
+}
+
+func (c *fakeL1Client) TransactionReceipt(hash gethcommon.Hash) (*types.Receipt, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	receipt, found := c.receipts[hash]
+	if !found {
+		return nil, ethereum.NotFound
+	}
+	return receipt, nil
+}
+
+func (c *fakeL1Client) Nonce(gethcommon.Address) (uint64, error) {
+	return 0, nil
+}
+
+func (c *fakeL1Client) CallContract(msg ethereum.CallMsg) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch *msg.To {
+	case testConfig.L1MessengerAddress:
+		return newBridgeLib().messengerABI.Methods[messageConsumedMethod].Outputs.Pack(c.msgRelayed)
+	case testConfig.L1MessageBusAddress:
+		method, err := messageBusABI.MethodById(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+		if method.Name == messagebuslib.GetPublishFeeMethod {
+			args, err := method.Inputs.Unpack(msg.Data[4:])
+			if err != nil {
+				return nil, err
+			}
+			payloadLength := args[0].(*big.Int).Int64()
+			return method.Outputs.Pack(big.NewInt(baseMessageFee + payloadLength*messageFeePerByte))
+		}
+		if !c.rootStored {
+			return nil, errors.New("execution reverted: This root was never submitted.")
+		}
+		return messageBusABI.Methods[messagebuslib.GetRootTimeOfFinalityMethod].Outputs.Pack(big.NewInt(timeOfFinality))
+	default:
+		return nil, errors.New("unexpected call")
+	}
+}
+
+// update changes the state of the L1 contracts
+func (c *fakeL1Client) update(fn func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fn()
+}
+
+func (c *fakeL1Client) FetchHeadBlock() (*types.Block, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1), Time: c.headTime}), nil
+}
+
+// fakeL2Client emulates the L2 bridge publishing a message for the calls to it, and the L2 message bus storing the
+// messages delivered by the test
+type fakeL2Client struct {
+	mu          sync.Mutex
+	account     gethcommon.Address
+	receipts    map[gethcommon.Hash]*types.Receipt
+	delivered   map[gethcommon.Hash]bool
+	proofs      map[gethcommon.Hash]*common.CrossChainProof
+	messageSent common.CrossChainMessage
+}
+
+func (c *fakeL2Client) Address() gethcommon.Address {
+	return c.account
+}
+
+func (c *fakeL2Client) NonceAt(context.Context, *big.Int) (uint64, error) {
+	return 0, nil
+}
+
+func (c *fakeL2Client) EstimateGasAndGasPrice(txData types.TxData) types.TxData {
+	tx := types.NewTx(txData)
+	return &types.LegacyTx{Nonce: tx.Nonce(), Gas: 100_000, GasPrice: big.NewInt(1), To: tx.To(), Value: tx.Value(), Data: tx.Data()}
+}
+
+func (c *fakeL2Client) SendTransaction(_ context.Context, signedTx *types.Transaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: signedTx.Hash()}
+	if *signedTx.To() == testConfig.L2BridgeAddress {
+		receipt.Logs = []*types.Log{messagePublishedLog(testConfig.L2MessageBusAddress, c.messageSent, 3)}
+	}
+	c.receipts[signedTx.Hash()] = receipt
+	return nil
+}
+
+func (c *fakeL2Client) TransactionReceipt(_ context.Context, txHash gethcommon.Hash) (*types.Receipt, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	receipt, found := c.receipts[txHash]
+	if !found {
+		return nil, ethereum.NotFound
+	}
+	return receipt, nil
+}
+
+func (c *fakeL2Client) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	method := messageBusABI.Methods[messagebuslib.GetMessageTimeOfFinalityMethod]
+	args, err := method.Inputs.Unpack(msg.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	delivered, ok := abi.ConvertType(args[0], new(common.CrossChainMessage)).(*common.CrossChainMessage)
+	if !ok {
+		return nil, errors.New("unexpected call")
+	}
+	msgHash, err := common.CrossChainMessageHash(*delivered)
+	if err != nil {
+		return nil, err
+	}
+	if !c.delivered[msgHash] {
+		return nil, errors.New("execution reverted: This message was never submitted.")
+	}
+	response, err := method.Outputs.Pack(big.NewInt(timeOfFinality))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(hexutil.Encode(response)), nil
+}
+
+func (c *fakeL2Client) GetCrossChainProof(txHash gethcommon.Hash, logIndex uint) (*common.CrossChainProof, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	proof, found := c.proofs[txHash]
+	if !found || logIndex != 3 {
+		return nil, ethereum.NotFound
+	}
+	return proof, nil
+}
+
+func (c *fakeL2Client) deliver(msg common.CrossChainMessage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	msgHash, _ := common.CrossChainMessageHash(msg)
+	c.delivered[msgHash] = true
+}
+
+func (c *fakeL2Client) rollUp(txHash gethcommon.Hash) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.proofs[txHash] = &common.CrossChainProof{Message: c.messageSent, Root: gethcommon.BytesToHash(datagenerator.RandomBytes(32))}
+}
+
+func TestDepositIsMatchedWithItsDeliveryOnL2(t *testing.T) {
+	client, l1Client, l2Client := newTestClient(t)
+	events := make(chan Event, 10)
+	sub := client.SubscribeEvents(events)
+	defer sub.Unsubscribe()
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+	receiver := datagenerator.RandomAddress()
+	deposit, err := client.DepositNative(ctx, big.NewInt(1000), receiver)
+	require.NoError(t, err)
+	require.Equal(t, l1Client.messageSent, deposit.Message)
+	require.False(t, deposit.Delivered)
+	// the deposit pays the fee of its message on top of the amount, which the L1 bridge takes from the value
+	fee, err := client.DepositFee()
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(baseMessageFee+receiveAssetsPayloadLength*messageFeePerByte), fee)
+	require.Equal(t, new(big.Int).Add(big.NewInt(1000), fee), l1Client.sentTxs[0].Value())
+	require.Equal(t, Event{Type: DepositSent, TxHash: deposit.L1TxHash, Message: deposit.Message}, <-events)
+
+	// the deposit waits for its message to be delivered, and not for another one
+	go func() {
+		l2Client.deliver(randomMessage())
+		time.Sleep(5 * testConfig.PollInterval)
+		l2Client.deliver(deposit.Message)
+	}()
+	require.NoError(t, client.WaitForDeposit(ctx, deposit))
+	require.True(t, deposit.Delivered)
+	require.Equal(t, uint64(timeOfFinality), deposit.TimeOfFinality)
+	require.Equal(t, Event{Type: DepositDelivered, TxHash: deposit.L1TxHash, Message: deposit.Message}, <-events)
+
+	// a deposit that is never delivered times out
+	undelivered := &Deposit{Message: randomMessage()}
+	shortCtx, shortCancel := context.WithTimeout(context.Background(), 5*testConfig.PollInterval)
+	defer shortCancel()
+	require.ErrorIs(t, client.WaitForDeposit(shortCtx, undelivered), context.DeadlineExceeded)
+}
+
+func TestERC20DepositApprovesTheBridgeFirst(t *testing.T) {
+	client, l1Client, _ := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	asset := datagenerator.RandomAddress()
+	_, err := client.DepositERC20(ctx, asset, big.NewInt(1000), datagenerator.RandomAddress())
+	require.NoError(t, err)
+	require.Len(t, l1Client.sentTxs, 2)
+	require.Equal(t, asset, *l1Client.sentTxs[0].To())
+	require.Equal(t, testConfig.L1BridgeAddress, *l1Client.sentTxs[1].To())
+	require.Less(t, l1Client.sentTxs[0].Nonce(), l1Client.sentTxs[1].Nonce())
+	// the bridge takes the tokens, so the value of the deposit only pays the message fee
+	fee, err := client.DepositFee()
+	require.NoError(t, err)
+	require.Zero(t, l1Client.sentTxs[0].Value().Sign())
+	require.Equal(t, fee, l1Client.sentTxs[1].Value())
+}
+
+func TestWithdrawalStatusProgressesUntilRelayed(t *testing.T) {
+	client, l1Client, l2Client := newTestClient(t)
+	events := make(chan Event, 10)
+	sub := client.SubscribeEvents(events)
+	defer sub.Unsubscribe()
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+	withdrawal, err := client.Withdraw(ctx, gethcommon.Address{}, big.NewInt(1000), datagenerator.RandomAddress())
+	require.NoError(t, err)
+	require.Equal(t, l2Client.messageSent, withdrawal.Message)
+	require.Equal(t, uint(3), withdrawal.LogIndex)
+	require.Equal(t, Event{Type: WithdrawalSent, TxHash: withdrawal.L2TxHash, Message: withdrawal.Message}, <-events)
+
+	requireStatus := func(expected WithdrawalStatus) {
+		status, err := client.WithdrawalStatus(withdrawal)
+		require.NoError(t, err)
+		require.Equal(t, expected, status)
+		require.Equal(t, expected, withdrawal.Status)
+	}
+	requireStatus(WithdrawalPending)
+
+	l2Client.rollUp(withdrawal.L2TxHash)
+	requireStatus(WithdrawalInRollup)
+	require.NotNil(t, withdrawal.Proof)
+
+	// the root is stored in the L1 message bus, but its challenge period has not passed
+	l1Client.update(func() { l1Client.rootStored = true })
+	requireStatus(WithdrawalInRollup)
+
+	l1Client.update(func() { l1Client.headTime = timeOfFinality })
+	requireStatus(WithdrawalFinalized)
+
+	l1Client.update(func() { l1Client.msgRelayed = true })
+	requireStatus(WithdrawalRelayed)
+
+	// each change of status is reported once
+	for _, expected := range []WithdrawalStatus{WithdrawalInRollup, WithdrawalFinalized, WithdrawalRelayed} {
+		require.Equal(t, Event{Type: WithdrawalStatusChanged, TxHash: withdrawal.L2TxHash, Message: withdrawal.Message, Status: expected}, <-events)
+	}
+	require.Empty(t, events)
+}
+
+func newTestClient(t *testing.T) (*Client, *fakeL1Client, *fakeL2Client) {
+	l1Wallet := wallet.NewInMemoryWalletFromPK(big.NewInt(1337), newKey(t), gethlog.Root())
+	l2Wallet := wallet.NewInMemoryWalletFromPK(big.NewInt(777), newKey(t), gethlog.Root())
+	l1Client := &fakeL1Client{receipts: map[gethcommon.Hash]*types.Receipt{}, messageSent: randomMessage()}
+	l2Client := &fakeL2Client{
+		account:     l2Wallet.Address(),
+		receipts:    map[gethcommon.Hash]*types.Receipt{},
+		delivered:   map[gethcommon.Hash]bool{},
+		proofs:      map[gethcommon.Hash]*common.CrossChainProof{},
+		messageSent: randomMessage(),
+	}
+	return NewClient(testConfig, l1Client, l2Client, l1Wallet, l2Wallet, gethlog.Root()), l1Client, l2Client
+}
+
+func messagePublishedLog(messageBus gethcommon.Address, msg common.CrossChainMessage, index uint) *types.Log {
+	event := messageBusABI.Events[messagebuslib.MessagePublishedEvent]
+	data, err := event.Inputs.Pack(msg.Sender, msg.Sequence, msg.Nonce, msg.Topic, msg.Payload, msg.ConsistencyLevel)
+	if err != nil {
+		panic(err)
+	}
+	return &types.Log{Address: messageBus, Topics: []gethcommon.Hash{event.ID}, Data: data, Index: index}
+}
+
+func randomMessage() common.CrossChainMessage {
+	return common.CrossChainMessage{
+		Sender:   datagenerator.RandomAddress(),
+		Sequence: datagenerator.RandomUInt64(),
+		Payload:  datagenerator.RandomBytes(32),
+	}
+}
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return key
+}
@@ -0,0 +1,139 @@
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// Deposit is a transfer of assets from the L1 to the Obscuro network, identified by the message the L1 bridge
+// published for it
+type Deposit struct {
+	L1TxHash gethcommon.Hash
+	Message  common.CrossChainMessage
+	// Delivered is set once the message is stored in the L2 message bus, from where it can be relayed to the L2 bridge
+	// after TimeOfFinality
+	Delivered      bool
+	TimeOfFinality uint64
+}
+
+// DepositFee returns the fee the L1 message bus charges for the message of a deposit, which is paid by the deposit
+// transaction on top of the amount transferred
+func (c *Client) DepositFee() (*big.Int, error) {
+	payloadLength, err := c.bridgeLib.depositPayloadLength()
+	if err != nil {
+		return nil, err
+	}
+	callMsg, err := c.l1MessageBus.GetPublishFee(payloadLength)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.l1Client.CallContract(callMsg)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the message fee. Cause: %w", err)
+	}
+	return c.l1MessageBus.DecodePublishFee(response)
+}
+
+// DepositNative transfers the amount of the L1 native currency to the receiver on the Obscuro network. The L1 bridge
+// takes the message fee from the value sent, so the value is the amount plus the fee.
+func (c *Client) DepositNative(ctx context.Context, amount *big.Int, receiver gethcommon.Address) (*Deposit, error) {
+	data, err := c.bridgeLib.sendNative(receiver)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := c.DepositFee()
+	if err != nil {
+		return nil, err
+	}
+	return c.deposit(ctx, new(big.Int).Add(amount, fee), data)
+}
+
+// DepositERC20 transfers the amount of the ERC20 asset to the receiver on the Obscuro network, where it is issued as
+// the wrapped token of the asset. The bridge is first approved to take the amount from the L1 wallet. The message fee is
+// the value of the deposit transaction.
+func (c *Client) DepositERC20(ctx context.Context, asset gethcommon.Address, amount *big.Int, receiver gethcommon.Address) (*Deposit, error) {
+	fee, err := c.DepositFee()
+	if err != nil {
+		return nil, err
+	}
+	approveData, err := c.bridgeLib.approve(c.config.L1BridgeAddress, amount)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err = c.sendL1Transaction(ctx, asset, big.NewInt(0), approveData); err != nil {
+		return nil, fmt.Errorf("could not approve the transfer of %s. Cause: %w", asset, err)
+	}
+
+	data, err := c.bridgeLib.sendERC20(false, asset, amount, receiver)
+	if err != nil {
+		return nil, err
+	}
+	return c.deposit(ctx, fee, data)
+}
+
+// deposit calls the L1 bridge and returns the deposit of the message it published. The gas of the call is estimated with
+// the value paying the message fee, as the message bus reverts the calls which do not pay it.
+func (c *Client) deposit(ctx context.Context, value *big.Int, data []byte) (*Deposit, error) {
+	txHash, receipt, err := c.sendL1Transaction(ctx, c.config.L1BridgeAddress, value, data)
+	if err != nil {
+		return nil, fmt.Errorf("could not deposit. Cause: %w", err)
+	}
+	msg, _, err := publishedMessage(txHash, receipt, c.l1MessageBus)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("Deposit sent", log.TxKey, txHash, "sequence", msg.Sequence)
+	c.events.Send(Event{Type: DepositSent, TxHash: txHash, Message: msg})
+	return &Deposit{L1TxHash: txHash, Message: msg}, nil
+}
+
+// WaitForDeposit waits until the message of the deposit is delivered to the L2 message bus, or the context is done.
+//
+// Once the message reaches its consistency level, the enclaves create a synthetic transaction storing it in the L2
+// message bus (see CreateSyntheticTransactions). The synthetic transactions are not exposed by the nodes, so the
+// deposit is matched with the message the synthetic transaction stored, which the bus identifies by its hash.
+func (c *Client) WaitForDeposit(ctx context.Context, deposit *Deposit) error {
+	if deposit.Delivered {
+		return nil
+	}
+	callMsg, err := c.l2MessageBus.GetMessageTimeOfFinality(deposit.Message)
+	if err != nil {
+		return err
+	}
+	callMsg.From = c.l2Client.Address()
+
+	err = c.poll(ctx, func() (bool, error) {
+		response, err := c.l2Client.CallContract(ctx, callMsg, nil)
+		if err != nil {
+			// the call reverts until the message is delivered
+			c.logger.Trace("Deposit not delivered yet", log.TxKey, deposit.L1TxHash, log.ErrKey, err)
+			return false, nil
+		}
+		// the Obscuro client returns the hex encoding of the result
+		decoded, err := hexutil.Decode(string(response))
+		if err != nil {
+			return false, fmt.Errorf("could not decode call response. Cause: %w", err)
+		}
+		timeOfFinality, err := c.l2MessageBus.DecodeTimeOfFinality(decoded)
+		if err != nil {
+			return false, err
+		}
+		deposit.Delivered, deposit.TimeOfFinality = true, timeOfFinality.Uint64()
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("deposit of tx=%s not delivered. Cause: %w", deposit.L1TxHash, err)
+	}
+
+	c.logger.Info("Deposit delivered", log.TxKey, deposit.L1TxHash, "sequence", deposit.Message.Sequence)
+	c.events.Send(Event{Type: DepositDelivered, TxHash: deposit.L1TxHash, Message: deposit.Message})
+	return nil
+}
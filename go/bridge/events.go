@@ -0,0 +1,46 @@
+package bridge
+
+import (
+	"github.com/obscuronet/go-obscuro/go/common"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// EventType is the step of a transfer an Event reports
+type EventType int
+
+const (
+	// DepositSent - the L1 bridge published the message of a deposit
+	DepositSent EventType = iota
+	// DepositDelivered - the message of a deposit was stored in the L2 message bus, from where it can be relayed
+	DepositDelivered
+	// WithdrawalSent - the L2 bridge published the message of a withdrawal
+	WithdrawalSent
+	// WithdrawalStatusChanged - a withdrawal moved to a new WithdrawalStatus
+	WithdrawalStatusChanged
+)
+
+func (t EventType) String() string {
+	switch t {
+	case DepositSent:
+		return "DepositSent"
+	case DepositDelivered:
+		return "DepositDelivered"
+	case WithdrawalSent:
+		return "WithdrawalSent"
+	case WithdrawalStatusChanged:
+		return "WithdrawalStatusChanged"
+	default:
+		return "Unknown"
+	}
+}
+
+// Event reports the progress of a deposit or a withdrawal made or tracked by the client
+type Event struct {
+	Type EventType
+	// TxHash is the L1 transaction of a deposit, or the L2 transaction of a withdrawal
+	TxHash  gethcommon.Hash
+	Message common.CrossChainMessage
+	// Status is the status of the withdrawal, for the WithdrawalSent and WithdrawalStatusChanged events
+	Status WithdrawalStatus
+}
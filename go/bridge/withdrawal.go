@@ -0,0 +1,172 @@
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// WithdrawalStatus is the progress of a withdrawal towards the L1
+type WithdrawalStatus int
+
+const (
+	// WithdrawalPending - the batch of the withdrawal is not in a rollup yet
+	WithdrawalPending WithdrawalStatus = iota
+	// WithdrawalInRollup - the message of the withdrawal is in a rollup, but the cross chain root of the rollup is not
+	// stored in the L1 message bus yet, or its challenge period has not passed
+	WithdrawalInRollup
+	// WithdrawalFinalized - the root of the rollup is final, so the message can be relayed to the L1 bridge with its proof
+	WithdrawalFinalized
+	// WithdrawalRelayed - the messenger delivered the message to the L1 bridge, which transferred the assets
+	WithdrawalRelayed
+)
+
+func (s WithdrawalStatus) String() string {
+	switch s {
+	case WithdrawalPending:
+		return "Pending"
+	case WithdrawalInRollup:
+		return "InRollup"
+	case WithdrawalFinalized:
+		return "Finalized"
+	case WithdrawalRelayed:
+		return "Relayed"
+	default:
+		return "Unknown"
+	}
+}
+
+// Withdrawal is a transfer of assets from the Obscuro network to the L1, identified by the message the L2 bridge
+// published for it
+type Withdrawal struct {
+	L2TxHash gethcommon.Hash
+	LogIndex uint // The index of the log of the message, which identifies it with the transaction
+	Message  common.CrossChainMessage
+	// Proof is the proof of the inclusion of the message in the cross chain root of its rollup. It is set once the
+	// withdrawal is in a rollup.
+	Proof  *common.CrossChainProof
+	Status WithdrawalStatus
+}
+
+// Withdraw transfers the amount of the asset to the receiver on the L1. The asset is the wrapped token of an L1 ERC20
+// on the Obscuro network, or the zero address for the native currency.
+func (c *Client) Withdraw(ctx context.Context, asset gethcommon.Address, amount *big.Int, receiver gethcommon.Address) (*Withdrawal, error) {
+	var data []byte
+	var err error
+	value := big.NewInt(0)
+	if asset == (gethcommon.Address{}) {
+		data, err = c.bridgeLib.sendNative(receiver)
+		value = amount
+	} else {
+		data, err = c.bridgeLib.sendERC20(true, asset, amount, receiver)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	txHash, _, err := c.sendL2Transaction(ctx, c.config.L2BridgeAddress, value, data)
+	if err != nil {
+		return nil, fmt.Errorf("could not withdraw. Cause: %w", err)
+	}
+	return c.TrackWithdrawal(ctx, txHash)
+}
+
+// TrackWithdrawal returns the withdrawal of the message published by the L2 transaction, so that its status can be
+// followed. The transaction must have been sent from the L2 account of the client, as only its receipts are visible.
+func (c *Client) TrackWithdrawal(ctx context.Context, l2TxHash gethcommon.Hash) (*Withdrawal, error) {
+	receipt, err := c.l2Client.TransactionReceipt(ctx, l2TxHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve receipt for tx=%s. Cause: %w", l2TxHash, err)
+	}
+	msg, logIndex, err := publishedMessage(l2TxHash, receipt, c.l2MessageBus)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("Withdrawal sent", log.TxKey, l2TxHash, "sequence", msg.Sequence)
+	c.events.Send(Event{Type: WithdrawalSent, TxHash: l2TxHash, Message: msg, Status: WithdrawalPending})
+	return &Withdrawal{L2TxHash: l2TxHash, LogIndex: logIndex, Message: msg, Status: WithdrawalPending}, nil
+}
+
+// WithdrawalStatus returns the current status of the withdrawal, and records it in the withdrawal. A change of status
+// is reported to the event subscribers.
+func (c *Client) WithdrawalStatus(withdrawal *Withdrawal) (WithdrawalStatus, error) {
+	status, err := c.withdrawalStatus(withdrawal)
+	if err != nil {
+		return withdrawal.Status, err
+	}
+	if status != withdrawal.Status {
+		withdrawal.Status = status
+		c.logger.Info("Withdrawal status changed", log.TxKey, withdrawal.L2TxHash, "status", status)
+		c.events.Send(Event{Type: WithdrawalStatusChanged, TxHash: withdrawal.L2TxHash, Message: withdrawal.Message, Status: status})
+	}
+	return status, nil
+}
+
+func (c *Client) withdrawalStatus(withdrawal *Withdrawal) (WithdrawalStatus, error) {
+	if withdrawal.Proof == nil {
+		proof, err := c.l2Client.GetCrossChainProof(withdrawal.L2TxHash, withdrawal.LogIndex)
+		if errors.Is(err, ethereum.NotFound) {
+			return WithdrawalPending, nil
+		}
+		if err != nil {
+			return WithdrawalPending, fmt.Errorf("could not fetch the proof of the withdrawal. Cause: %w", err)
+		}
+		withdrawal.Proof = proof
+	}
+
+	relayed, err := c.isRelayed(withdrawal.Message)
+	if err != nil {
+		return WithdrawalInRollup, err
+	}
+	if relayed {
+		return WithdrawalRelayed, nil
+	}
+
+	callMsg, err := c.l1MessageBus.GetRootTimeOfFinality(withdrawal.Proof.Root)
+	if err != nil {
+		return WithdrawalInRollup, err
+	}
+	response, err := c.l1Client.CallContract(callMsg)
+	if err != nil {
+		// the call reverts until the rollup of the root is published
+		c.logger.Trace("Cross chain root not stored in the L1 message bus yet", "root", withdrawal.Proof.Root, log.ErrKey, err)
+		return WithdrawalInRollup, nil
+	}
+	timeOfFinality, err := c.l1MessageBus.DecodeTimeOfFinality(response)
+	if err != nil {
+		return WithdrawalInRollup, err
+	}
+	head, err := c.l1Client.FetchHeadBlock()
+	if err != nil {
+		return WithdrawalInRollup, fmt.Errorf("could not fetch L1 head block. Cause: %w", err)
+	}
+	if timeOfFinality.Uint64() > head.Time() {
+		return WithdrawalInRollup, nil
+	}
+	return WithdrawalFinalized, nil
+}
+
+// isRelayed returns true if the L1 messenger has delivered the message
+func (c *Client) isRelayed(msg common.CrossChainMessage) (bool, error) {
+	msgHash, err := common.CrossChainMessageHash(msg)
+	if err != nil {
+		return false, err
+	}
+	callMsg, err := c.bridgeLib.messageConsumed(c.config.L1MessengerAddress, msgHash)
+	if err != nil {
+		return false, err
+	}
+	response, err := c.l1Client.CallContract(callMsg)
+	if err != nil {
+		return false, fmt.Errorf("could not check if the message was relayed. Cause: %w", err)
+	}
+	return c.bridgeLib.decodeMessageConsumed(response)
+}
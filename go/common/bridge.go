@@ -0,0 +1,51 @@
+package common
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BridgeTransferKind is the effect of a bridge transfer on the ledger of its token
+type BridgeTransferKind uint8
+
+const (
+	BridgeDeposit    BridgeTransferKind = iota // The L1 bridge locked the assets, to be released on the L2
+	BridgeWithdrawal                           // The L2 bridge sent the assets back, to be released by the L1 bridge
+	BridgeMint                                 // A wrapped token was issued on the L2
+	BridgeBurn                                 // A wrapped token was burned on the L2
+)
+
+// BridgeTransfer is an ERC-20 or native currency transfer decoded from the payload of a message published by a bridge,
+// or from a transfer log of a wrapped token minting or burning it
+type BridgeTransfer struct {
+	Kind BridgeTransferKind
+	// The L1 token for deposits and withdrawals, the L2 wrapped token for mints and burns. The zero address is the
+	// native currency.
+	Token    L1Address
+	Amount   *big.Int
+	Sender   L1Address
+	Receiver L1Address
+}
+
+// WrappedToken is the token created by the L2 bridge to represent an L1 token
+type WrappedToken struct {
+	Token        L1Address
+	WrappedToken L2Address
+}
+
+// BridgeTokenBalance is the ledger of a token bridged from the L1, computed over the canonical blocks and batches
+type BridgeTokenBalance struct {
+	Token        L1Address  `json:"token"`
+	WrappedToken *L2Address `json:"wrappedToken,omitempty"` // nil until the L2 bridge created the wrapped token
+	// The amount held by the L1 bridge, which is the deposits minus the withdrawals
+	Locked *hexutil.Big `json:"locked"`
+	// The supply of the wrapped token, which is the mints minus the burns
+	Minted *hexutil.Big `json:"minted"`
+}
+
+// SupplyExceedsLocked returns true if more wrapped tokens circulate on the L2 than the L1 bridge holds, which means
+// the bridge is insolvent
+func (b *BridgeTokenBalance) SupplyExceedsLocked() bool {
+	return b.Minted.ToInt().Cmp(b.Locked.ToInt()) > 0
+}
@@ -0,0 +1,54 @@
+package common
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// L1Message is an inbound cross chain message, with the L1 transaction which published it
+type L1Message struct {
+	Message  CrossChainMessage
+	TxHash   TxHash
+	TxSender L1Address // The account which sent the L1 transaction, the only one allowed to follow the delivery of the message
+}
+
+// InboundMessageState is the progress of an inbound message towards the L2 message bus
+type InboundMessageState string
+
+const (
+	// InboundMessagePending - the message is published in a canonical L1 block, but has not reached its consistency
+	// level or has not been included in a batch yet
+	InboundMessagePending InboundMessageState = "Pending"
+	// InboundMessageDelivered - a synthetic transaction of a canonical batch stored the message in the L2 message bus
+	InboundMessageDelivered InboundMessageState = "Delivered"
+)
+
+// InboundMessageStatus is the status of one of the cross chain messages published by an L1 transaction
+type InboundMessageStatus struct {
+	Message       CrossChainMessage   `json:"message"`
+	L1TxHash      TxHash              `json:"l1TxHash"`
+	L1TxSender    L1Address           `json:"l1TxSender"`
+	L1BlockHash   L1BlockHash         `json:"l1BlockHash"`
+	L1BlockHeight hexutil.Uint64      `json:"l1BlockHeight"`
+	Status        InboundMessageState `json:"status"`
+	// The synthetic transaction which stored the message in the L2 message bus and its batch, once delivered
+	L2TxHash    *L2TxHash       `json:"l2TxHash,omitempty"`
+	BatchHash   *L2BatchHash    `json:"batchHash,omitempty"`
+	BatchHeight *hexutil.Uint64 `json:"batchHeight,omitempty"`
+}
+
+// OutboundMessage is a cross chain message published in the L2 message bus by a transaction. The transaction hash and
+// the log index identify it when requesting its CrossChainProof.
+type OutboundMessage struct {
+	Message     CrossChainMessage `json:"message"`
+	TxHash      L2TxHash          `json:"txHash"`
+	LogIndex    hexutil.Uint64    `json:"logIndex"`
+	BatchHash   L2BatchHash       `json:"batchHash"`
+	BatchHeight hexutil.Uint64    `json:"batchHeight"`
+}
+
+// L1MessageDelivery records the synthetic transaction which stored an inbound message in the L2 message bus
+type L1MessageDelivery struct {
+	MessageHash common.Hash
+	TxHash      L2TxHash
+}
@@ -0,0 +1,170 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// The outbound cross chain messages are committed to in merkle trees, so that only their root has to be published to
+// the L1. The messages of a batch are the leaves of the tree whose root is BatchHeader.CrossChainRoot, and the roots
+// of the batches of a rollup which have messages are the leaves of the tree whose root is RollupHeader.CrossChainRoot.
+// A message is verified against the root of a rollup with the proof of the message in its batch tree, followed by the
+// proof of the batch root in the rollup tree.
+//
+// The trees are built in the same way as OpenZeppelin's MerkleProof verifies them: the pairs of nodes are hashed in
+// sorted order, and a node without a sibling is carried over to the next level.
+
+// crossChainMessageArgs is used to hash the messages the same way the message bus does (`keccak256(abi.encode(msg))`)
+var crossChainMessageArgs = func() abi.Arguments {
+	messageType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "sender", Type: "address"},
+		{Name: "sequence", Type: "uint64"},
+		{Name: "nonce", Type: "uint32"},
+		{Name: "topic", Type: "uint32"},
+		{Name: "payload", Type: "bytes"},
+		{Name: "consistencyLevel", Type: "uint8"},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return abi.Arguments{{Type: messageType}}
+}()
+
+// CrossChainProof is the proof that an outbound message is included in the cross chain root of a rollup. It is
+// verified by the L1 message bus with `verifyMessageInclusion` once the root is final.
+type CrossChainProof struct {
+	Message    CrossChainMessage `json:"message"`
+	Proof      []common.Hash     `json:"proof"`
+	Root       common.Hash       `json:"root"`       // The cross chain root of the rollup, stored in the L1 message bus
+	RollupHash L2RollupHash      `json:"rollupHash"` // The rollup which published the batch of the message
+	BatchHash  L2BatchHash       `json:"batchHash"`  // The batch whose outbound messages include the message
+}
+
+// CrossChainMessageHash returns the hash identifying the message in the message bus, which is also its leaf in the
+// cross chain tree of its batch.
+func CrossChainMessageHash(msg CrossChainMessage) (common.Hash, error) {
+	encoded, err := crossChainMessageArgs.Pack(msg)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("could not encode cross chain message. Cause: %w", err)
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// CrossChainMessagesRoot returns the root of the cross chain tree of the messages, or the empty hash if there are none.
+func CrossChainMessagesRoot(msgs CrossChainMessages) (common.Hash, error) {
+	leaves, err := crossChainMessageLeaves(msgs)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return CrossChainTreeRoot(leaves), nil
+}
+
+// CrossChainMessageProof returns the proof of the message at the index in the cross chain tree of the messages.
+func CrossChainMessageProof(msgs CrossChainMessages, index int) ([]common.Hash, error) {
+	leaves, err := crossChainMessageLeaves(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return CrossChainTreeProof(leaves, index)
+}
+
+// BatchRootsRoot returns the root of the cross chain tree of a rollup, given the cross chain roots of its batches.
+// The batches without messages are left out.
+func BatchRootsRoot(batchRoots []common.Hash) common.Hash {
+	return CrossChainTreeRoot(nonEmptyRoots(batchRoots))
+}
+
+// BatchRootProof returns the proof of the cross chain root of a batch in the cross chain tree of its rollup.
+func BatchRootProof(batchRoots []common.Hash, batchRoot common.Hash) ([]common.Hash, error) {
+	leaves := nonEmptyRoots(batchRoots)
+	for i, leaf := range leaves {
+		if leaf == batchRoot {
+			return CrossChainTreeProof(leaves, i)
+		}
+	}
+	return nil, fmt.Errorf("batch root %s is not a leaf of the tree", batchRoot)
+}
+
+// CrossChainTreeRoot returns the root of the tree with the given leaves, or the empty hash if there are none.
+func CrossChainTreeRoot(leaves []common.Hash) common.Hash {
+	if len(leaves) == 0 {
+		return common.Hash{}
+	}
+	level := leaves
+	for len(level) > 1 {
+		level = nextTreeLevel(level)
+	}
+	return level[0]
+}
+
+// CrossChainTreeProof returns the siblings of the nodes on the path from the leaf at the index to the root.
+func CrossChainTreeProof(leaves []common.Hash, index int) ([]common.Hash, error) {
+	if index < 0 || index >= len(leaves) {
+		return nil, fmt.Errorf("leaf %d is not in a tree of %d leaves", index, len(leaves))
+	}
+	proof := make([]common.Hash, 0)
+	level := leaves
+	for len(level) > 1 {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		level = nextTreeLevel(level)
+		index /= 2
+	}
+	return proof, nil
+}
+
+// VerifyCrossChainProof returns whether the proof links the leaf to the root, as the L1 message bus checks it.
+func VerifyCrossChainProof(leaf common.Hash, proof []common.Hash, root common.Hash) bool {
+	computed := leaf
+	for _, sibling := range proof {
+		computed = hashTreePair(computed, sibling)
+	}
+	return computed == root
+}
+
+func crossChainMessageLeaves(msgs CrossChainMessages) ([]common.Hash, error) {
+	leaves := make([]common.Hash, len(msgs))
+	for i, msg := range msgs {
+		leaf, err := CrossChainMessageHash(msg)
+		if err != nil {
+			return nil, err
+		}
+		leaves[i] = leaf
+	}
+	return leaves, nil
+}
+
+func nonEmptyRoots(roots []common.Hash) []common.Hash {
+	nonEmpty := make([]common.Hash, 0, len(roots))
+	for _, root := range roots {
+		if root != (common.Hash{}) {
+			nonEmpty = append(nonEmpty, root)
+		}
+	}
+	return nonEmpty
+}
+
+func nextTreeLevel(level []common.Hash) []common.Hash {
+	next := make([]common.Hash, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+			continue
+		}
+		next = append(next, hashTreePair(level[i], level[i+1]))
+	}
+	return next
+}
+
+func hashTreePair(a common.Hash, b common.Hash) common.Hash {
+	if bytes.Compare(a.Bytes(), b.Bytes()) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256Hash(a.Bytes(), b.Bytes())
+}
@@ -0,0 +1,80 @@
+package common
+
+import (
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrossChainTreeOfNoMessagesIsEmpty(t *testing.T) {
+	root, err := CrossChainMessagesRoot(nil)
+	require.NoError(t, err)
+	require.Equal(t, gethcommon.Hash{}, root)
+	require.Equal(t, gethcommon.Hash{}, BatchRootsRoot([]gethcommon.Hash{{}, {}}))
+}
+
+func TestCrossChainMessageProofsVerify(t *testing.T) {
+	for count := 1; count <= 17; count++ {
+		msgs := randomCrossChainMessages(count)
+		root, err := CrossChainMessagesRoot(msgs)
+		require.NoError(t, err)
+
+		for i, msg := range msgs {
+			proof, err := CrossChainMessageProof(msgs, i)
+			require.NoError(t, err)
+			leaf, err := CrossChainMessageHash(msg)
+			require.NoError(t, err)
+			require.True(t, VerifyCrossChainProof(leaf, proof, root), "proof of message %d of %d does not verify", i, count)
+
+			tampered := msg
+			tampered.Payload = append([]byte{1}, msg.Payload...)
+			tamperedLeaf, err := CrossChainMessageHash(tampered)
+			require.NoError(t, err)
+			require.False(t, VerifyCrossChainProof(tamperedLeaf, proof, root))
+		}
+	}
+
+	_, err := CrossChainMessageProof(randomCrossChainMessages(2), 2)
+	require.Error(t, err)
+}
+
+func TestCrossChainMessageProofsVerifyAgainstRollupRoot(t *testing.T) {
+	// the batches of a rollup, some of which have no messages
+	batches := []CrossChainMessages{randomCrossChainMessages(3), nil, randomCrossChainMessages(1), nil, randomCrossChainMessages(5), randomCrossChainMessages(2)}
+	batchRoots := make([]gethcommon.Hash, len(batches))
+	for i, msgs := range batches {
+		root, err := CrossChainMessagesRoot(msgs)
+		require.NoError(t, err)
+		batchRoots[i] = root
+	}
+	rollupRoot := BatchRootsRoot(batchRoots)
+
+	for i, msgs := range batches {
+		for j, msg := range msgs {
+			batchProof, err := CrossChainMessageProof(msgs, j)
+			require.NoError(t, err)
+			rollupProof, err := BatchRootProof(batchRoots, batchRoots[i])
+			require.NoError(t, err)
+			leaf, err := CrossChainMessageHash(msg)
+			require.NoError(t, err)
+			require.True(t, VerifyCrossChainProof(leaf, append(batchProof, rollupProof...), rollupRoot),
+				"proof of message %d of batch %d does not verify", j, i)
+		}
+	}
+
+	_, err := BatchRootProof(batchRoots, randomHash())
+	require.Error(t, err)
+}
+
+func randomCrossChainMessages(count int) CrossChainMessages {
+	msgs := make(CrossChainMessages, count)
+	for i := range msgs {
+		msgs[i] = CrossChainMessage{
+			Sender:   gethcommon.BytesToAddress(randomHash().Bytes()),
+			Sequence: uint64(i),
+			Payload:  randomHash().Bytes(),
+		}
+	}
+	return msgs
+}
@@ -97,6 +97,17 @@ type Enclave interface {
 	// EstimateGas tries to estimate the gas needed to execute a specific transaction based on the pending state.
 	EstimateGas(encryptedParams EncryptedParamsEstimateGas) (*responses.Gas, SystemError)
 
+	// SimulateCalls executes an ordered list of calls from the same account on top of the head batch state, carrying
+	// the state changes between calls. The per-call results are encrypted with the viewing key of the account.
+	SimulateCalls(encryptedParams EncryptedParamsSimulateCalls) (*responses.SimulatedCalls, SystemError)
+
+	// CreateAccessList returns the access list of a specific call, and the gas it uses with the access list attached.
+	CreateAccessList(encryptedParams EncryptedParamsAccessList) (*responses.AccessList, SystemError)
+
+	// RevokeViewingKey records that the viewing key can no longer be used to encrypt responses. The revocation is
+	// authenticated by the signature which authorised the viewing key, and shared with the other enclaves in a batch.
+	RevokeViewingKey(encryptedParams EncryptedParamsRevokeViewingKey) (*responses.ViewingKeyRevocation, SystemError)
+
 	// GetLogs returns all the logs matching the filter.
 	GetLogs(encryptedParams EncryptedParamsGetLogs) (*responses.Logs, SystemError)
 
@@ -109,6 +120,19 @@ type Enclave interface {
 	// GetBatchBySeqNo - retrieve batch by sequencer number if it's in the db
 	GetBatchBySeqNo(seqNo uint64) (*ExtBatch, SystemError)
 
+	// GetCrossChainProof returns the proof that the outbound cross chain message published by the log of the
+	// transaction is included in the cross chain root of a rollup, or nil if there is no such message or its batch
+	// has not been published in a rollup yet.
+	GetCrossChainProof(txHash gethcommon.Hash, logIndex uint64) (*CrossChainProof, SystemError)
+
+	// GetInboundMessageStatus returns the delivery status of the cross chain messages published by an L1 transaction,
+	// encrypted with the viewing key of the sender of the transaction.
+	GetInboundMessageStatus(encryptedParams EncryptedParamsInboundMessages) (*responses.InboundMessageStatus, SystemError)
+
+	// GetOutboundMessages returns the cross chain messages published by the transactions of an account in a range of
+	// batches, encrypted with the viewing key of the account.
+	GetOutboundMessages(encryptedParams EncryptedParamsOutboundMessages) (*responses.OutboundMessages, SystemError)
+
 	// CreateBatch - creates a new head batch extending the previous one for the latest known L1 head if the node is
 	// a sequencer. Will panic otherwise.
 	CreateBatch() SystemError
@@ -137,6 +161,9 @@ type EnclaveScan interface {
 
 	// GetPublicTransactionData returns a list of public transaction data
 	GetPublicTransactionData(pagination *QueryPagination) (*TransactionListingResponse, SystemError)
+
+	// GetBridgeLedger returns the locked and minted balance of each token bridged from the L1
+	GetBridgeLedger() ([]BridgeTokenBalance, SystemError)
 }
 
 // BlockSubmissionResponse is the response sent from the enclave back to the node after ingesting a block
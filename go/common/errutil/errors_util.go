@@ -21,6 +21,9 @@ var (
 
 	ErrBlockAlreadyProcessed = errors.New("block already processed")
 	ErrBlockAncestorNotFound = errors.New("block ancestor not found")
+	ErrBlockBeforeL1Start    = errors.New("block is before the L1 start block")
+	ErrInvalidBlockHeader    = errors.New("invalid block header")
+	ErrReorgTooDeep          = errors.New("reorg deeper than the maximum reorg depth")
 	ErrBlockForBatchNotFound = errors.New("block for batch not found")
 	ErrAncestorBatchNotFound = errors.New("parent for batch not found")
 )
@@ -29,19 +29,20 @@ const (
 	callFieldGasPrice             = "gasprice"
 	callFieldMaxFeePerGas         = "maxfeepergas"
 	callFieldMaxPriorityFeePerGas = "maxpriorityfeepergas"
+	callFieldAccessList           = "accesslist"
 )
 
 // ExtractEthCallMapString extracts the eth_call gethapi.TransactionArgs from an interface{}
 // it ensures that :
-// - All types are string
+// - All types are string, except for the access list which is passed through as is
 // - All keys are lowercase
 // - There is only one key per value
 // - From field is set by default
-func ExtractEthCallMapString(paramBytes interface{}) (map[string]string, error) {
+func ExtractEthCallMapString(paramBytes interface{}) (map[string]interface{}, error) {
 	// geth lowercase the field name and uses the last seen value
 	var valString string
 	var ok bool
-	callMsg := map[string]string{
+	callMsg := map[string]interface{}{
 		// From field is set by default
 		"from": gethcommon.HexToAddress("0x0").Hex(),
 	}
@@ -49,6 +50,10 @@ func ExtractEthCallMapString(paramBytes interface{}) (map[string]string, error)
 		if val == nil {
 			continue
 		}
+		if strings.ToLower(field) == callFieldAccessList {
+			callMsg[callFieldAccessList] = val
+			continue
+		}
 		valString, ok = val.(string)
 		if !ok {
 			return nil, fmt.Errorf("unexpected type supplied in `%s` field", field)
@@ -135,6 +140,7 @@ func ExtractEthCall(param interface{}) (*gethapi.TransactionArgs, error) {
 	var to, from *gethcommon.Address
 	var data *hexutil.Bytes
 	var value, gasPrice, maxFeePerGas, maxPriorityFeePerGas *hexutil.Big
+	var accessList *types.AccessList
 	var ok bool
 	zeroUint := hexutil.Uint64(0)
 	nonce := &zeroUint
@@ -145,6 +151,15 @@ func ExtractEthCall(param interface{}) (*gethapi.TransactionArgs, error) {
 		if val == nil {
 			continue
 		}
+		// the access list is the only field that is not a string
+		if strings.ToLower(field) == callFieldAccessList {
+			accessListVal, err := extractAccessList(val)
+			if err != nil {
+				return nil, fmt.Errorf("could not decode access list in CallMsg - %w", err)
+			}
+			accessList = accessListVal
+			continue
+		}
 		valString, ok = val.(string)
 		if !ok {
 			return nil, fmt.Errorf("unexpected type supplied in `%s` field", field)
@@ -218,18 +233,95 @@ func ExtractEthCall(param interface{}) (*gethapi.TransactionArgs, error) {
 		Value:                value,
 		Data:                 data,
 		Nonce:                nonce,
-		AccessList:           nil,
+		AccessList:           accessList,
 	}
 
 	return callMsg, nil
 }
 
+// extractAccessList converts the decoded JSON access list of a call into a types.AccessList
+func extractAccessList(param interface{}) (*types.AccessList, error) {
+	jsonAccessList, err := json.Marshal(param)
+	if err != nil {
+		return nil, err
+	}
+	var accessList types.AccessList
+	if err = json.Unmarshal(jsonAccessList, &accessList); err != nil {
+		return nil, err
+	}
+	return &accessList, nil
+}
+
+// ExtractEthCalls extracts a list of gethapi.TransactionArgs from an interface{}
+func ExtractEthCalls(param interface{}) ([]*gethapi.TransactionArgs, error) {
+	callList, ok := param.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a list of calls but got %T", param)
+	}
+
+	calls := make([]*gethapi.TransactionArgs, len(callList))
+	for i, callParam := range callList {
+		callMap, ok := callParam.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected type supplied for call %d", i)
+		}
+		call, err := ExtractEthCall(callMap)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode call %d - %w", i, err)
+		}
+		calls[i] = call
+	}
+	return calls, nil
+}
+
+// ExtractOptionalStateOverride returns the gethapi.StateOverride at the given index, or nil if not present
+func ExtractOptionalStateOverride(params []interface{}, idx int) (*gethapi.StateOverride, error) {
+	if len(params) <= idx || params[idx] == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	// the overrides arrive as a map, so we marshal them back to JSON to decode them into the typed struct
+	overridesJSON, err := json.Marshal(params[idx])
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal state overrides to JSON - %w", err)
+	}
+
+	var overrides gethapi.StateOverride
+	if err = json.Unmarshal(overridesJSON, &overrides); err != nil {
+		return nil, fmt.Errorf("could not decode state overrides - %w", err)
+	}
+	return &overrides, nil
+}
+
+// ExtractOptionalBlockOverrides returns the gethapi.BlockOverrides at the given index, or nil if not present
+func ExtractOptionalBlockOverrides(params []interface{}, idx int) (*gethapi.BlockOverrides, error) {
+	if len(params) <= idx || params[idx] == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	overridesJSON, err := json.Marshal(params[idx])
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal block overrides to JSON - %w", err)
+	}
+
+	var overrides gethapi.BlockOverrides
+	if err = json.Unmarshal(overridesJSON, &overrides); err != nil {
+		return nil, fmt.Errorf("could not decode block overrides - %w", err)
+	}
+	return &overrides, nil
+}
+
 // CreateEthHeaderForBatch - the EVM requires an Ethereum "block" header.
 // In this function we are creating one from the Batch Header
 func CreateEthHeaderForBatch(h *common.BatchHeader, secret []byte) (*types.Header, error) {
 	// deterministically calculate private randomness that will be exposed to the evm
 	randomness := crypto.CalculateRootBatchEntropy(secret, h.Number)
 
+	baseFee := gethcommon.Big0
+	if h.BaseFee != nil {
+		baseFee = h.BaseFee
+	}
+
 	return &types.Header{
 		ParentHash:  h.ParentHash,
 		Root:        h.Root,
@@ -237,9 +329,9 @@ func CreateEthHeaderForBatch(h *common.BatchHeader, secret []byte) (*types.Heade
 		ReceiptHash: h.ReceiptHash,
 		Difficulty:  big.NewInt(0),
 		Number:      h.Number,
-		GasLimit:    1_000_000_000,   // todo (@stefan) - gas
-		GasUsed:     0,               // todo (@stefan) - gas
-		BaseFee:     gethcommon.Big0, // todo (@stefan) - gas
+		GasLimit:    h.GasLimit,
+		GasUsed:     h.GasUsed,
+		BaseFee:     baseFee,
 		Time:        h.Time,
 		MixDigest:   randomness,
 		Nonce:       types.BlockNonce{},
@@ -296,3 +388,23 @@ func ExtractPrivateCustomQuery(_ interface{}, query interface{}) (*common.Privat
 
 	return &result, nil
 }
+
+// ExtractBatchRange - converts the decoded JSON batch range param to a BatchRange, validating it on the way
+func ExtractBatchRange(param interface{}) (*common.BatchRange, error) {
+	if param == nil {
+		return nil, fmt.Errorf("no batch range specified")
+	}
+
+	jsonData, err := json.Marshal(param)
+	if err != nil {
+		return nil, err
+	}
+
+	var result common.BatchRange
+	err = json.Unmarshal(jsonData, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -41,6 +41,7 @@ type BatchHeader struct {
 	CrossChainMessages            []MessageBus.StructsCrossChainMessage `json:"crossChainMessages"`
 	LatestInboundCrossChainHash   common.Hash                           `json:"inboundCrossChainHash"`   // The block hash of the latest block that has been scanned for cross chain messages.
 	LatestInboundCrossChainHeight *big.Int                              `json:"inboundCrossChainHeight"` // The block height of the latest block that has been scanned for cross chain messages.
+	CrossChainRoot                common.Hash                           `json:"crossChainTreeRoot"`      // The merkle root of the outbound cross chain messages of the batch. See CrossChainTreeRoot.
 }
 
 // MarshalJSON custom marshals the BatchHeader into a json
@@ -75,7 +76,7 @@ type RollupHeader struct {
 	Coinbase          common.Address
 	CompressionL1Head L1BlockHash // the l1 block that the sequencer considers canonical at the time when this rollup is created
 
-	CrossChainMessages []MessageBus.StructsCrossChainMessage `json:"crossChainMessages"`
+	CrossChainRoot common.Hash `json:"crossChainTreeRoot"` // The merkle root of the cross chain roots of the batches in the rollup, stored in the L1 message bus
 
 	PayloadHash common.Hash // The hash of the compressed batches. TODO
 	R, S        *big.Int    // signature values
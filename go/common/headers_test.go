@@ -26,6 +26,7 @@ func TestBatchHeader_MarshalJSON(t *testing.T) {
 		R:                             gethcommon.Big3,
 		S:                             gethcommon.Big3,
 		CrossChainMessages:            nil,
+		CrossChainRoot:                randomHash(),
 		LatestInboundCrossChainHash:   gethcommon.Hash{},
 		LatestInboundCrossChainHeight: nil,
 	}
@@ -52,6 +53,7 @@ func TestBatchHeader_MarshalJSON(t *testing.T) {
 	require.Equal(t, batchHeader.R, batchUnmarshalled.R)
 	require.Equal(t, batchHeader.S, batchUnmarshalled.S)
 	require.Equal(t, batchHeader.CrossChainMessages, batchUnmarshalled.CrossChainMessages)
+	require.Equal(t, batchHeader.CrossChainRoot, batchUnmarshalled.CrossChainRoot)
 	require.Equal(t, batchHeader.LatestInboundCrossChainHash, batchUnmarshalled.LatestInboundCrossChainHash)
 	require.Equal(t, batchHeader.LatestInboundCrossChainHeight, batchUnmarshalled.LatestInboundCrossChainHeight)
 	require.Equal(t, batchHeader.Hash(), batchUnmarshalled.Hash())
@@ -19,6 +19,8 @@ type Host interface {
 	Start() error
 	// SubmitAndBroadcastTx submits an encrypted transaction to the enclave, and broadcasts it to the other hosts on the network.
 	SubmitAndBroadcastTx(encryptedParams common.EncryptedParamsSendRawTx) (*responses.RawTx, error)
+	// SubmitAndBroadcastViewingKeyRevocation submits an encrypted viewing key revocation to the enclave, and forwards it to the sequencer.
+	SubmitAndBroadcastViewingKeyRevocation(encryptedParams common.EncryptedParamsRevokeViewingKey) (*responses.ViewingKeyRevocation, error)
 	// Subscribe feeds logs matching the encrypted log subscription to the matchedLogs channel.
 	Subscribe(id rpc.ID, encryptedLogSubscription common.EncryptedParamsLogSubscription, matchedLogs chan []byte) error
 	// Unsubscribe terminates a log subscription between the host and the enclave.
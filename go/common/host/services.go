@@ -41,6 +41,8 @@ type P2P interface {
 	BroadcastBatches(batches []*common.ExtBatch) error
 	// SendTxToSequencer sends the encrypted transaction to the sequencer.
 	SendTxToSequencer(tx common.EncryptedTx) error
+	// SendViewingKeyRevocationToSequencer sends the encrypted viewing key revocation to the sequencer.
+	SendViewingKeyRevocationToSequencer(encryptedParams common.EncryptedParamsRevokeViewingKey) error
 
 	// RequestBatchesFromSequencer asynchronously requests batches from the sequencer, from the given sequence number
 	RequestBatchesFromSequencer(fromSeqNo *big.Int) error
@@ -69,6 +71,8 @@ type P2PBatchHandler interface {
 type P2PTxHandler interface {
 	// HandleTransaction will be called in a new goroutine for each new tx as it arrives
 	HandleTransaction(tx common.EncryptedTx)
+	// HandleViewingKeyRevocation will be called in a new goroutine for each new viewing key revocation as it arrives
+	HandleViewingKeyRevocation(encryptedParams common.EncryptedParamsRevokeViewingKey)
 }
 
 type P2PBatchRequestHandler interface {
@@ -82,6 +86,8 @@ type L1BlockRepository interface {
 	Subscribe(handler L1BlockHandler) func()
 
 	FetchBlockByHeight(height *big.Int) (*types.Block, error)
+	// FetchBlock returns the block with the given hash
+	FetchBlock(blockHash gethcommon.Hash) (*types.Block, error)
 	// FetchNextBlock returns the next canonical block after a given block hash
 	// It returns the new block, a bool which is true if the block is the current L1 head and a bool if the block is on a different fork to prevBlock
 	FetchNextBlock(prevBlock gethcommon.Hash) (*types.Block, bool, error)
@@ -147,6 +153,10 @@ type EnclaveService interface {
 	// SubmitAndBroadcastTx submits an encrypted transaction to the enclave, and broadcasts it to other hosts on the network (in particular, to the sequencer)
 	SubmitAndBroadcastTx(encryptedParams common.EncryptedParamsSendRawTx) (*responses.RawTx, error)
 
+	// SubmitAndBroadcastViewingKeyRevocation submits an encrypted viewing key revocation to the enclave, and forwards
+	// it to the sequencer, which shares it with the other enclaves in a batch
+	SubmitAndBroadcastViewingKeyRevocation(encryptedParams common.EncryptedParamsRevokeViewingKey) (*responses.ViewingKeyRevocation, error)
+
 	Subscribe(id rpc.ID, encryptedLogSubscription common.EncryptedParamsLogSubscription) error
 	Unsubscribe(id rpc.ID) error
 }
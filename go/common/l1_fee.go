@@ -0,0 +1,66 @@
+package common
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/obscuronet/go-obscuro/go/common/compression"
+)
+
+// signatureSize - the size of the V, R, S values, which are missing from the transactions that are not signed yet
+const signatureSize = 65
+
+var l1FeeCompression = compression.NewBrotliDataCompressionService()
+
+// L1DataFee - the fee the sequencer pays to publish the transaction to the L1 as part of a rollup, which is debited from
+// the balance of the sender on top of the L2 gas. The transaction is compressed the same way as the rollup payload, and each byte is charged as a non-zero calldata byte
+// at the base fee of the L1 block the batch is built on.
+// The transactions that don't pay any fee (e.g. the synthetic transactions) are not charged.
+func L1DataFee(tx *L2Tx, l1BaseFee *big.Int) (*big.Int, uint64, error) {
+	if isFeeExempt(tx) {
+		return big.NewInt(0), 0, nil
+	}
+	l1Gas, err := l1DataGas(tx, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	return l1Fee(l1Gas, l1BaseFee), l1Gas, nil
+}
+
+// EstimateL1DataFee - same as L1DataFee, for a transaction that is not signed yet. Like the L2 gas, the transactions
+// which don't pay any fee are not charged.
+func EstimateL1DataFee(tx *L2Tx, l1BaseFee *big.Int) (*big.Int, error) {
+	if isFeeExempt(tx) {
+		return big.NewInt(0), nil
+	}
+	l1Gas, err := l1DataGas(tx, signatureSize)
+	if err != nil {
+		return nil, err
+	}
+	return l1Fee(l1Gas, l1BaseFee), nil
+}
+
+func l1DataGas(tx *L2Tx, extraBytes int) (uint64, error) {
+	encodedTx, err := tx.MarshalBinary()
+	if err != nil {
+		return 0, fmt.Errorf("could not encode transaction. Cause: %w", err)
+	}
+	compressedTx, err := l1FeeCompression.CompressRollup(encodedTx)
+	if err != nil {
+		return 0, fmt.Errorf("could not compress transaction. Cause: %w", err)
+	}
+	return uint64(len(compressedTx)+extraBytes) * params.TxDataNonZeroGasEIP2028, nil
+}
+
+func l1Fee(l1Gas uint64, l1BaseFee *big.Int) *big.Int {
+	if l1BaseFee == nil {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(l1Gas), l1BaseFee)
+}
+
+func isFeeExempt(tx *types.Transaction) bool {
+	return tx.GasFeeCap().Sign() == 0 && tx.GasTipCap().Sign() == 0
+}
@@ -0,0 +1,70 @@
+package common
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestL1DataFeeIsPricedPerCompressedByte(t *testing.T) {
+	privateKey, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	signer := types.NewLondonSigner(big.NewInt(443))
+	to := gethcommon.HexToAddress("0x1234")
+	l1BaseFee := big.NewInt(30)
+
+	smallTx, err := types.SignNewTx(privateKey, signer, &types.LegacyTx{GasPrice: big.NewInt(1), Gas: 21_000, To: &to})
+	require.NoError(t, err)
+	largeTx, err := types.SignNewTx(privateKey, signer, &types.LegacyTx{GasPrice: big.NewInt(1), Gas: 21_000, To: &to, Data: randomBytes(t, 1000)})
+	require.NoError(t, err)
+
+	smallFee, smallL1Gas, err := L1DataFee(smallTx, l1BaseFee)
+	require.NoError(t, err)
+	largeFee, largeL1Gas, err := L1DataFee(largeTx, l1BaseFee)
+	require.NoError(t, err)
+
+	assert.Zero(t, smallL1Gas%params.TxDataNonZeroGasEIP2028)
+	assert.Equal(t, new(big.Int).Mul(big.NewInt(int64(smallL1Gas)), l1BaseFee), smallFee)
+	assert.Greater(t, largeL1Gas, smallL1Gas)
+	assert.Equal(t, 1, largeFee.Cmp(smallFee))
+
+	// the estimate for the unsigned transaction accounts for the missing signature
+	unsignedFee, err := EstimateL1DataFee(types.NewTx(&types.LegacyTx{GasPrice: big.NewInt(1), Gas: 21_000, To: &to}), l1BaseFee)
+	require.NoError(t, err)
+	assert.Equal(t, 1, unsignedFee.Cmp(big.NewInt(0)))
+}
+
+func TestL1DataFeeIsNotChargedWithoutBaseFeeOrGasPrice(t *testing.T) {
+	to := gethcommon.HexToAddress("0x1234")
+
+	// the synthetic transactions don't pay for gas
+	freeTx := types.NewTx(&types.LegacyTx{GasPrice: big.NewInt(0), Gas: 21_000, To: &to})
+	fee, l1Gas, err := L1DataFee(freeTx, big.NewInt(30))
+	require.NoError(t, err)
+	assert.Zero(t, fee.Sign())
+	assert.Zero(t, l1Gas)
+	estimatedFee, err := EstimateL1DataFee(freeTx, big.NewInt(30))
+	require.NoError(t, err)
+	assert.Zero(t, estimatedFee.Sign())
+
+	// an L1 without EIP-1559 has no base fee
+	paidTx := types.NewTx(&types.LegacyTx{GasPrice: big.NewInt(1), Gas: 21_000, To: &to})
+	fee, l1Gas, err = L1DataFee(paidTx, nil)
+	require.NoError(t, err)
+	assert.Zero(t, fee.Sign())
+	assert.NotZero(t, l1Gas)
+}
+
+func randomBytes(t *testing.T, length int) []byte {
+	data := make([]byte, length)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+	return data
+}
@@ -43,6 +43,7 @@ const (
 	EthereumL1Cmp   = "l1_host"
 	ObscuroscanCmp  = "obscuroscan"
 	CrossChainCmp   = "cross_chain"
+	RelayerCmp      = "relayer"
 )
 
 // Used when the logger has to write to Sys.out
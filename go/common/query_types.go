@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -45,6 +47,23 @@ type PublicBlock struct {
 	RollupHash  common.Hash  `json:"rollupHash"`
 }
 
+// SimulatedCallResult is the outcome of executing one of the calls of a simulated bundle.
+// The logs only contain the events that are visible to the account that sent the calls.
+type SimulatedCallResult struct {
+	ReturnData hexutil.Bytes                 `json:"returnData"`
+	Logs       []*types.Log                  `json:"logs"`
+	GasUsed    hexutil.Uint64                `json:"gasUsed"`
+	Status     hexutil.Uint64                `json:"status"`
+	Error      *errutil.EVMSerialisableError `json:"error,omitempty"`
+}
+
+// AccessListResult is the result of an `eth_createAccessList` request.
+type AccessListResult struct {
+	Accesslist *types.AccessList `json:"accessList"`
+	Error      string            `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64    `json:"gasUsed"`
+}
+
 type FinalityType string
 
 const (
@@ -78,6 +97,46 @@ func (p *QueryPagination) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MaxBatchRangeSize is the maximum number of batches a BatchRange can cover
+const MaxBatchRangeSize = 1000
+
+// BatchRange is an inclusive range of batch heights
+type BatchRange struct {
+	From uint64
+	To   uint64
+}
+
+func (r *BatchRange) UnmarshalJSON(data []byte) error {
+	// Use a temporary struct to avoid infinite unmarshalling loop
+	type Temp struct {
+		From hexutil.Uint64 `json:"fromBatch"`
+		To   hexutil.Uint64 `json:"toBatch"`
+	}
+
+	var temp Temp
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+
+	if temp.To < temp.From {
+		return fmt.Errorf("toBatch must not be lower than fromBatch")
+	}
+	if uint64(temp.To-temp.From) >= MaxBatchRangeSize {
+		return fmt.Errorf("the range must cover at most %d batches", MaxBatchRangeSize)
+	}
+
+	r.From = uint64(temp.From)
+	r.To = uint64(temp.To)
+	return nil
+}
+
+func (r BatchRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		From hexutil.Uint64 `json:"fromBatch"`
+		To   hexutil.Uint64 `json:"toBatch"`
+	}{hexutil.Uint64(r.From), hexutil.Uint64(r.To)})
+}
+
 type PrivateCustomQueryListTransactions struct {
 	Address    common.Address  `json:"address"`
 	Pagination QueryPagination `json:"pagination"`
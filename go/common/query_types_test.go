@@ -51,3 +51,18 @@ func TestPublicBlockListing_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchRange_JSON(t *testing.T) {
+	marshal, err := json.Marshal(BatchRange{From: 10, To: 20})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"fromBatch":"0xa","toBatch":"0x14"}`, string(marshal))
+
+	var batchRange BatchRange
+	require.NoError(t, json.Unmarshal(marshal, &batchRange))
+	require.Equal(t, BatchRange{From: 10, To: 20}, batchRange)
+
+	// the range must not be reversed nor exceed the maximum size
+	require.Error(t, json.Unmarshal([]byte(`{"fromBatch":"0x14","toBatch":"0xa"}`), &batchRange))
+	require.Error(t, json.Unmarshal([]byte(`{"fromBatch":"0x0","toBatch":"0x3e8"}`), &batchRange))
+	require.NoError(t, json.Unmarshal([]byte(`{"fromBatch":"0x0","toBatch":"0x3e7"}`), &batchRange))
+}
@@ -145,6 +145,7 @@ func ToBatchHeaderMsg(header *common.BatchHeader) *generated.BatchHeaderMsg {
 		Time:                        header.Time,
 		BaseFee:                     baseFee,
 		CrossChainMessages:          ToCrossChainMsgs(header.CrossChainMessages),
+		CrossChainRoot:              header.CrossChainRoot.Bytes(),
 		LatestInboundCrossChainHash: header.LatestInboundCrossChainHash.Bytes(),
 	}
 
@@ -198,6 +199,7 @@ func FromBatchHeaderMsg(header *generated.BatchHeaderMsg) *common.BatchHeader {
 		Time:                          header.Time,
 		BaseFee:                       big.NewInt(int64(header.BaseFee)),
 		CrossChainMessages:            FromCrossChainMsgs(header.CrossChainMessages),
+		CrossChainRoot:                gethcommon.BytesToHash(header.CrossChainRoot),
 		LatestInboundCrossChainHash:   gethcommon.BytesToHash(header.LatestInboundCrossChainHash),
 		LatestInboundCrossChainHeight: big.NewInt(0).SetBytes(header.LatestInboundCrossChainHeight),
 	}
@@ -216,12 +218,12 @@ func ToRollupHeaderMsg(header *common.RollupHeader) *generated.RollupHeaderMsg {
 		return nil
 	}
 	headerMsg := generated.RollupHeaderMsg{
-		CompressionL1Head:  header.CompressionL1Head.Bytes(),
-		R:                  header.R.Bytes(),
-		S:                  header.S.Bytes(),
-		Coinbase:           header.Coinbase.Bytes(),
-		CrossChainMessages: ToCrossChainMsgs(header.CrossChainMessages),
-		LastBatchSeqNo:     header.LastBatchSeqNo,
+		CompressionL1Head: header.CompressionL1Head.Bytes(),
+		R:                 header.R.Bytes(),
+		S:                 header.S.Bytes(),
+		Coinbase:          header.Coinbase.Bytes(),
+		CrossChainRoot:    header.CrossChainRoot.Bytes(),
+		LastBatchSeqNo:    header.LastBatchSeqNo,
 	}
 
 	return &headerMsg
@@ -249,11 +251,11 @@ func FromRollupHeaderMsg(header *generated.RollupHeaderMsg) *common.RollupHeader
 	r := &big.Int{}
 	s := &big.Int{}
 	return &common.RollupHeader{
-		CompressionL1Head:  gethcommon.BytesToHash(header.CompressionL1Head),
-		R:                  r.SetBytes(header.R),
-		S:                  s.SetBytes(header.S),
-		Coinbase:           gethcommon.BytesToAddress(header.Coinbase),
-		CrossChainMessages: FromCrossChainMsgs(header.CrossChainMessages),
-		LastBatchSeqNo:     header.LastBatchSeqNo,
+		CompressionL1Head: gethcommon.BytesToHash(header.CompressionL1Head),
+		R:                 r.SetBytes(header.R),
+		S:                 s.SetBytes(header.S),
+		Coinbase:          gethcommon.BytesToAddress(header.Coinbase),
+		CrossChainRoot:    gethcommon.BytesToHash(header.CrossChainRoot),
+		LastBatchSeqNo:    header.LastBatchSeqNo,
 	}
 }
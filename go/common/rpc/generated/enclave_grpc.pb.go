@@ -77,6 +77,23 @@ type EnclaveProtoClient interface {
 	GetTotalContractCount(ctx context.Context, in *GetTotalContractCountRequest, opts ...grpc.CallOption) (*GetTotalContractCountResponse, error)
 	GetReceiptsByAddress(ctx context.Context, in *GetReceiptsByAddressRequest, opts ...grpc.CallOption) (*GetReceiptsByAddressResponse, error)
 	GetPublicTransactionData(ctx context.Context, in *GetPublicTransactionDataRequest, opts ...grpc.CallOption) (*GetPublicTransactionDataResponse, error)
+	// SimulateCalls executes an ordered list of calls on top of the same state, and returns the per-call results
+	// encrypted with the viewing key of the account sending the calls
+	SimulateCalls(ctx context.Context, in *SimulateCallsRequest, opts ...grpc.CallOption) (*SimulateCallsResponse, error)
+	// CreateAccessList traces a call and returns the access list it uses, encrypted with the viewing key of the caller
+	CreateAccessList(ctx context.Context, in *CreateAccessListRequest, opts ...grpc.CallOption) (*CreateAccessListResponse, error)
+	// RevokeViewingKey records the revocation of a viewing key, authenticated by the signature which authorised it
+	RevokeViewingKey(ctx context.Context, in *RevokeViewingKeyRequest, opts ...grpc.CallOption) (*RevokeViewingKeyResponse, error)
+	// GetCrossChainProof returns the proof that an outbound cross chain message is included in the cross chain root of a rollup
+	GetCrossChainProof(ctx context.Context, in *GetCrossChainProofRequest, opts ...grpc.CallOption) (*GetCrossChainProofResponse, error)
+	// GetInboundMessageStatus returns the delivery status of the messages published by an L1 transaction, encrypted with
+	// the viewing key of the sender of the transaction
+	GetInboundMessageStatus(ctx context.Context, in *GetInboundMessageStatusRequest, opts ...grpc.CallOption) (*GetInboundMessageStatusResponse, error)
+	// GetOutboundMessages returns the messages published by the transactions of an account in a range of batches,
+	// encrypted with the viewing key of the account
+	GetOutboundMessages(ctx context.Context, in *GetOutboundMessagesRequest, opts ...grpc.CallOption) (*GetOutboundMessagesResponse, error)
+	// GetBridgeLedger returns the locked and minted balance of each token bridged from the L1
+	GetBridgeLedger(ctx context.Context, in *GetBridgeLedgerRequest, opts ...grpc.CallOption) (*GetBridgeLedgerResponse, error)
 }
 
 type enclaveProtoClient struct {
@@ -371,6 +388,69 @@ func (c *enclaveProtoClient) GetPublicTransactionData(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *enclaveProtoClient) SimulateCalls(ctx context.Context, in *SimulateCallsRequest, opts ...grpc.CallOption) (*SimulateCallsResponse, error) {
+	out := new(SimulateCallsResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/SimulateCalls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) CreateAccessList(ctx context.Context, in *CreateAccessListRequest, opts ...grpc.CallOption) (*CreateAccessListResponse, error) {
+	out := new(CreateAccessListResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/CreateAccessList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) RevokeViewingKey(ctx context.Context, in *RevokeViewingKeyRequest, opts ...grpc.CallOption) (*RevokeViewingKeyResponse, error) {
+	out := new(RevokeViewingKeyResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/RevokeViewingKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetCrossChainProof(ctx context.Context, in *GetCrossChainProofRequest, opts ...grpc.CallOption) (*GetCrossChainProofResponse, error) {
+	out := new(GetCrossChainProofResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetCrossChainProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetInboundMessageStatus(ctx context.Context, in *GetInboundMessageStatusRequest, opts ...grpc.CallOption) (*GetInboundMessageStatusResponse, error) {
+	out := new(GetInboundMessageStatusResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetInboundMessageStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetOutboundMessages(ctx context.Context, in *GetOutboundMessagesRequest, opts ...grpc.CallOption) (*GetOutboundMessagesResponse, error) {
+	out := new(GetOutboundMessagesResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetOutboundMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetBridgeLedger(ctx context.Context, in *GetBridgeLedgerRequest, opts ...grpc.CallOption) (*GetBridgeLedgerResponse, error) {
+	out := new(GetBridgeLedgerResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetBridgeLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnclaveProtoServer is the server API for EnclaveProto service.
 // All implementations must embed UnimplementedEnclaveProtoServer
 // for forward compatibility
@@ -430,6 +510,23 @@ type EnclaveProtoServer interface {
 	GetTotalContractCount(context.Context, *GetTotalContractCountRequest) (*GetTotalContractCountResponse, error)
 	GetReceiptsByAddress(context.Context, *GetReceiptsByAddressRequest) (*GetReceiptsByAddressResponse, error)
 	GetPublicTransactionData(context.Context, *GetPublicTransactionDataRequest) (*GetPublicTransactionDataResponse, error)
+	// SimulateCalls executes an ordered list of calls on top of the same state, and returns the per-call results
+	// encrypted with the viewing key of the account sending the calls
+	SimulateCalls(context.Context, *SimulateCallsRequest) (*SimulateCallsResponse, error)
+	// CreateAccessList traces a call and returns the access list it uses, encrypted with the viewing key of the caller
+	CreateAccessList(context.Context, *CreateAccessListRequest) (*CreateAccessListResponse, error)
+	// RevokeViewingKey records the revocation of a viewing key, authenticated by the signature which authorised it
+	RevokeViewingKey(context.Context, *RevokeViewingKeyRequest) (*RevokeViewingKeyResponse, error)
+	// GetCrossChainProof returns the proof that an outbound cross chain message is included in the cross chain root of a rollup
+	GetCrossChainProof(context.Context, *GetCrossChainProofRequest) (*GetCrossChainProofResponse, error)
+	// GetInboundMessageStatus returns the delivery status of the messages published by an L1 transaction, encrypted with
+	// the viewing key of the sender of the transaction
+	GetInboundMessageStatus(context.Context, *GetInboundMessageStatusRequest) (*GetInboundMessageStatusResponse, error)
+	// GetOutboundMessages returns the messages published by the transactions of an account in a range of batches,
+	// encrypted with the viewing key of the account
+	GetOutboundMessages(context.Context, *GetOutboundMessagesRequest) (*GetOutboundMessagesResponse, error)
+	// GetBridgeLedger returns the locked and minted balance of each token bridged from the L1
+	GetBridgeLedger(context.Context, *GetBridgeLedgerRequest) (*GetBridgeLedgerResponse, error)
 	mustEmbedUnimplementedEnclaveProtoServer()
 }
 
@@ -524,6 +621,27 @@ func (UnimplementedEnclaveProtoServer) GetReceiptsByAddress(context.Context, *Ge
 func (UnimplementedEnclaveProtoServer) GetPublicTransactionData(context.Context, *GetPublicTransactionDataRequest) (*GetPublicTransactionDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPublicTransactionData not implemented")
 }
+func (UnimplementedEnclaveProtoServer) SimulateCalls(context.Context, *SimulateCallsRequest) (*SimulateCallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateCalls not implemented")
+}
+func (UnimplementedEnclaveProtoServer) CreateAccessList(context.Context, *CreateAccessListRequest) (*CreateAccessListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessList not implemented")
+}
+func (UnimplementedEnclaveProtoServer) RevokeViewingKey(context.Context, *RevokeViewingKeyRequest) (*RevokeViewingKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeViewingKey not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetCrossChainProof(context.Context, *GetCrossChainProofRequest) (*GetCrossChainProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCrossChainProof not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetInboundMessageStatus(context.Context, *GetInboundMessageStatusRequest) (*GetInboundMessageStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInboundMessageStatus not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetOutboundMessages(context.Context, *GetOutboundMessagesRequest) (*GetOutboundMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutboundMessages not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetBridgeLedger(context.Context, *GetBridgeLedgerRequest) (*GetBridgeLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBridgeLedger not implemented")
+}
 func (UnimplementedEnclaveProtoServer) mustEmbedUnimplementedEnclaveProtoServer() {}
 
 // UnsafeEnclaveProtoServer may be embedded to opt out of forward compatibility for this service.
@@ -1062,6 +1180,132 @@ func _EnclaveProto_GetPublicTransactionData_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_SimulateCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateCallsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).SimulateCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/SimulateCalls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).SimulateCalls(ctx, req.(*SimulateCallsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_CreateAccessList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).CreateAccessList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/CreateAccessList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).CreateAccessList(ctx, req.(*CreateAccessListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_RevokeViewingKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeViewingKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).RevokeViewingKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/RevokeViewingKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).RevokeViewingKey(ctx, req.(*RevokeViewingKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetCrossChainProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCrossChainProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetCrossChainProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetCrossChainProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetCrossChainProof(ctx, req.(*GetCrossChainProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetInboundMessageStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInboundMessageStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetInboundMessageStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetInboundMessageStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetInboundMessageStatus(ctx, req.(*GetInboundMessageStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetOutboundMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutboundMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetOutboundMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetOutboundMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetOutboundMessages(ctx, req.(*GetOutboundMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetBridgeLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBridgeLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetBridgeLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetBridgeLedger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetBridgeLedger(ctx, req.(*GetBridgeLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EnclaveProto_ServiceDesc is the grpc.ServiceDesc for EnclaveProto service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1181,6 +1425,34 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPublicTransactionData",
 			Handler:    _EnclaveProto_GetPublicTransactionData_Handler,
 		},
+		{
+			MethodName: "SimulateCalls",
+			Handler:    _EnclaveProto_SimulateCalls_Handler,
+		},
+		{
+			MethodName: "CreateAccessList",
+			Handler:    _EnclaveProto_CreateAccessList_Handler,
+		},
+		{
+			MethodName: "RevokeViewingKey",
+			Handler:    _EnclaveProto_RevokeViewingKey_Handler,
+		},
+		{
+			MethodName: "GetCrossChainProof",
+			Handler:    _EnclaveProto_GetCrossChainProof_Handler,
+		},
+		{
+			MethodName: "GetInboundMessageStatus",
+			Handler:    _EnclaveProto_GetInboundMessageStatus_Handler,
+		},
+		{
+			MethodName: "GetOutboundMessages",
+			Handler:    _EnclaveProto_GetOutboundMessages_Handler,
+		},
+		{
+			MethodName: "GetBridgeLedger",
+			Handler:    _EnclaveProto_GetBridgeLedger_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
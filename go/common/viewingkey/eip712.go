@@ -0,0 +1,155 @@
+package viewingkey
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	EIP712DomainName    = "Obscuro"
+	EIP712DomainVersion = "1"
+	EIP712PrimaryType   = "Authentication"
+
+	signatureLen = 65
+	// EIP712SignatureLen is the length of an EIP-712 viewing key signature. The issued-at and expiry timestamps
+	// (big-endian unix seconds) follow the signature, as they are part of the signed message but cannot be derived by
+	// the enclave. This allows the signature to be stored and sent to the enclave in the same way as a legacy one.
+	EIP712SignatureLen = signatureLen + 8 + 8
+)
+
+var (
+	ErrViewingKeyExpired      = errors.New("viewing key signature has expired")
+	ErrInvalidEIP712Signature = errors.New("invalid EIP-712 viewing key signature")
+)
+
+var eip712Types = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+	},
+	EIP712PrimaryType: {
+		{Name: "userID", Type: "string"},
+		{Name: "viewingKey", Type: "bytes"},
+		{Name: "issuedAt", Type: "uint256"},
+		{Name: "expiry", Type: "uint256"},
+	},
+}
+
+// GenerateEIP712TypedData creates the typed data to be signed with eth_signTypedData_v4 to register the viewing key.
+// The user ID is derived from the viewing key, and the timestamps are in unix seconds.
+func GenerateEIP712TypedData(vkPubKey []byte, chainID int64, issuedAt uint64, expiry uint64) apitypes.TypedData {
+	userID := crypto.Keccak256Hash(vkPubKey).Bytes()
+	return apitypes.TypedData{
+		Types:       eip712Types,
+		PrimaryType: EIP712PrimaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:    EIP712DomainName,
+			Version: EIP712DomainVersion,
+			ChainId: (*math.HexOrDecimal256)(big.NewInt(chainID)),
+		},
+		Message: apitypes.TypedDataMessage{
+			"userID":     hex.EncodeToString(userID),
+			"viewingKey": hexutil.Bytes(vkPubKey),
+			"issuedAt":   new(big.Int).SetUint64(issuedAt),
+			"expiry":     new(big.Int).SetUint64(expiry),
+		},
+	}
+}
+
+// EncodeEIP712Signature appends the issued-at and expiry timestamps to the 65 bytes of the signature
+func EncodeEIP712Signature(signature []byte, issuedAt uint64, expiry uint64) []byte {
+	encoded := make([]byte, 0, EIP712SignatureLen)
+	encoded = append(encoded, signature...)
+	encoded = binary.BigEndian.AppendUint64(encoded, issuedAt)
+	return binary.BigEndian.AppendUint64(encoded, expiry)
+}
+
+// DecodeEIP712Signature splits an encoded EIP-712 signature into the signature and the issued-at and expiry timestamps
+func DecodeEIP712Signature(encoded []byte) ([]byte, uint64, uint64, error) {
+	if !IsEIP712Signature(encoded) {
+		return nil, 0, 0, fmt.Errorf("expected %d bytes for an EIP-712 signature, got %d", EIP712SignatureLen, len(encoded))
+	}
+	issuedAt := binary.BigEndian.Uint64(encoded[signatureLen : signatureLen+8])
+	expiry := binary.BigEndian.Uint64(encoded[signatureLen+8:])
+	return encoded[:signatureLen], issuedAt, expiry, nil
+}
+
+// IsEIP712Signature returns whether the signature was produced over the EIP-712 typed data (rather than a legacy text)
+func IsEIP712Signature(signature []byte) bool {
+	return len(signature) == EIP712SignatureLen
+}
+
+// CheckEIP712Expiry returns ErrViewingKeyExpired if the EIP-712 signature registering the viewing key has expired at
+// the given time
+func CheckEIP712Expiry(encodedSignature []byte, now time.Time) error {
+	_, issuedAt, expiry, err := DecodeEIP712Signature(encodedSignature)
+	if err != nil {
+		return err
+	}
+	if issuedAt > expiry || uint64(now.Unix()) > expiry {
+		return ErrViewingKeyExpired
+	}
+	return nil
+}
+
+// RecoverEIP712Signer returns the account that signed the typed data registering the viewing key, provided the
+// signature has not expired at the given time. The signature is expected in the format returned by
+// EncodeEIP712Signature, with a V of 0 or 1.
+func RecoverEIP712Signer(vkPubKey []byte, encodedSignature []byte, chainID int64, now time.Time) (gethcommon.Address, error) {
+	if err := CheckEIP712Expiry(encodedSignature, now); err != nil {
+		return gethcommon.Address{}, err
+	}
+	return recoverEIP712Signer(vkPubKey, encodedSignature, chainID)
+}
+
+// CheckEIP712Signature checks that the EIP-712 signature registering the viewing key was produced by the account for
+// the given chain, and that it has not expired at the given time
+func CheckEIP712Signature(account *gethcommon.Address, vkPubKey []byte, encodedSignature []byte, chainID int64, now time.Time) error {
+	if err := CheckEIP712Expiry(encodedSignature, now); err != nil {
+		return err
+	}
+	return CheckEIP712Authorisation(account, vkPubKey, encodedSignature, chainID)
+}
+
+// CheckEIP712Authorisation checks that the EIP-712 signature registering the viewing key was produced by the account
+// for the given chain, regardless of its expiry
+func CheckEIP712Authorisation(account *gethcommon.Address, vkPubKey []byte, encodedSignature []byte, chainID int64) error {
+	recoveredAccount, err := recoverEIP712Signer(vkPubKey, encodedSignature, chainID)
+	if err != nil {
+		return err
+	}
+	if recoveredAccount != *account {
+		return ErrInvalidEIP712Signature
+	}
+	return nil
+}
+
+func recoverEIP712Signer(vkPubKey []byte, encodedSignature []byte, chainID int64) (gethcommon.Address, error) {
+	signature, issuedAt, expiry, err := DecodeEIP712Signature(encodedSignature)
+	if err != nil {
+		return gethcommon.Address{}, err
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(GenerateEIP712TypedData(vkPubKey, chainID, issuedAt, expiry))
+	if err != nil {
+		return gethcommon.Address{}, fmt.Errorf("could not hash the typed data - %w", err)
+	}
+
+	pubKey, err := crypto.SigToPub(hash, signature)
+	if err != nil {
+		return gethcommon.Address{}, fmt.Errorf("%w - %s", ErrInvalidEIP712Signature, err)
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
@@ -27,10 +27,6 @@ type EnclaveConfig struct {
 	ObscuroChainID int64
 	// Whether to produce a verified attestation report
 	WillAttest bool
-	// Whether to validate incoming L1 blocks
-	ValidateL1Blocks bool
-	// When validating incoming blocks, the genesis config for the L1 chain
-	GenesisJSON []byte
 	// The management contract address on the L1 network
 	ManagementContractAddress gethcommon.Address
 	// LogLevel determines the verbosity of output logs
@@ -63,32 +59,67 @@ type EnclaveConfig struct {
 	// a protocol limit, but a miner imposed limit and it might be hard to find someone
 	// to include a transaction if it goes above it
 	MaxRollupSize uint64
+	// TargetGasPerBatch is the gas used by a batch for which the base fee of the next batch stays the same
+	TargetGasPerBatch uint64
+	// ElasticityMultiplier - the gas limit of a batch is TargetGasPerBatch multiplied by this value
+	ElasticityMultiplier uint64
+	// MaxSyntheticGasPerBatch is the gas the synthetic transactions delivering the inbound messages can use in a batch.
+	// The messages over it are delivered by the next batches.
+	MaxSyntheticGasPerBatch uint64
+	// SequencerFeeRecipient is the address collecting the fees paid by the transactions. It defaults to the SequencerID,
+	// and a sequencer does not start without either of them.
+	SequencerFeeRecipient gethcommon.Address
+	// AllowLegacyViewingKeySignatures - whether viewing keys signed as personal-sign text (rather than EIP-712 typed
+	// data) are accepted
+	AllowLegacyViewingKeySignatures bool
+	// ForcedTxInclusionWindow is the number of L1 blocks after which a transaction forced through the management
+	// contract must be included in a batch. Batches past the deadline which leave it out are rejected by the validators.
+	ForcedTxInclusionWindow uint64
+	// L1StartHash is the hash of the first L1 block the enclave ingests (e.g. the management contract deployment block).
+	// Earlier blocks are refused. If empty, the enclave starts from the L1 genesis block.
+	L1StartHash gethcommon.Hash
+	// L1MaxReorgDepth is the number of L1 blocks a reorg can replace. Deeper reorgs are refused, and the non-canonical
+	// blocks deeper than this below the head are pruned.
+	L1MaxReorgDepth uint64
+	// L1BridgeAddress is the address of the ObscuroBridge contract, whose deposits are recorded in the bridge ledger
+	L1BridgeAddress gethcommon.Address
+	// L2BridgeAddress is the address of the EthereumBridge contract, whose withdrawals and wrapped tokens are recorded in
+	// the bridge ledger. The ledger is not kept if neither bridge address is set.
+	L2BridgeAddress gethcommon.Address
 }
 
 // DefaultEnclaveConfig returns an EnclaveConfig with default values.
 func DefaultEnclaveConfig() *EnclaveConfig {
 	return &EnclaveConfig{
-		HostID:                    gethcommon.BytesToAddress([]byte("")),
-		HostAddress:               "127.0.0.1:10000",
-		Address:                   "127.0.0.1:11000",
-		NodeType:                  common.Sequencer,
-		L1ChainID:                 1337,
-		ObscuroChainID:            777,
-		WillAttest:                false, // todo (config) - attestation should be on by default before production release
-		ValidateL1Blocks:          false,
-		GenesisJSON:               nil,
-		ManagementContractAddress: gethcommon.BytesToAddress([]byte("")),
-		LogLevel:                  int(gethlog.LvlInfo),
-		LogPath:                   log.SysOut,
-		UseInMemoryDB:             true, // todo (config) - persistence should be on by default before production release
-		EdgelessDBHost:            "",
-		SqliteDBPath:              "",
-		ProfilerEnabled:           false,
-		MinGasPrice:               big.NewInt(1),
-		SequencerID:               gethcommon.BytesToAddress([]byte("")),
-		ObscuroGenesis:            "",
-		DebugNamespaceEnabled:     false,
-		MaxBatchSize:              1024 * 25,
-		MaxRollupSize:             1024 * 64,
+		HostID:                          gethcommon.BytesToAddress([]byte("")),
+		HostAddress:                     "127.0.0.1:10000",
+		Address:                         "127.0.0.1:11000",
+		NodeType:                        common.Sequencer,
+		L1ChainID:                       1337,
+		ObscuroChainID:                  777,
+		WillAttest:                      false, // todo (config) - attestation should be on by default before production release
+		ManagementContractAddress:       gethcommon.BytesToAddress([]byte("")),
+		LogLevel:                        int(gethlog.LvlInfo),
+		LogPath:                         log.SysOut,
+		UseInMemoryDB:                   true, // todo (config) - persistence should be on by default before production release
+		EdgelessDBHost:                  "",
+		SqliteDBPath:                    "",
+		ProfilerEnabled:                 false,
+		MinGasPrice:                     big.NewInt(1),
+		SequencerID:                     gethcommon.BytesToAddress([]byte("")),
+		ObscuroGenesis:                  "",
+		DebugNamespaceEnabled:           false,
+		MaxBatchSize:                    1024 * 25,
+		MaxRollupSize:                   1024 * 64,
+		TargetGasPerBatch:               1_000_000_000,
+		ElasticityMultiplier:            2,
+		MaxSyntheticGasPerBatch:         100_000_000,
+		SequencerFeeRecipient:           gethcommon.BytesToAddress([]byte("")),
+		AllowLegacyViewingKeySignatures: true,
+		ForcedTxInclusionWindow:         20,
+		L1StartHash:                     gethcommon.Hash{},
+		L1MaxReorgDepth:                 64,
+		L1BridgeAddress:                 gethcommon.Address{},
+		L2BridgeAddress:                 gethcommon.Address{},
 	}
 }
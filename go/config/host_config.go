@@ -245,7 +245,7 @@ func DefaultHostParsedConfig() *HostInputConfig {
 		L1ChainID:                 1337,
 		ObscuroChainID:            777,
 		ProfilerEnabled:           false,
-		L1StartHash:               common.L1BlockHash{}, // this hash will not be found, host will log a warning and then stream from L1 genesis
+		L1StartHash:               common.L1BlockHash{}, // the host will log a warning and then stream from L1 genesis
 		SequencerID:               gethcommon.BytesToAddress([]byte("")),
 		MetricsEnabled:            true,
 		MetricsHTTPPort:           14000,
@@ -0,0 +1,98 @@
+package components
+
+import (
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/obscuronet/go-obscuro/go/common"
+)
+
+// GasConfig - the parameters of the EIP-1559 fee market of the batches.
+// The base fee and the gas limit are part of the batch header, so all the nodes of a network must run with the same values,
+// otherwise the batches they recompute will not match the ones produced by the sequencer.
+type GasConfig struct {
+	// TargetGasPerBatch - the amount of gas a batch should use for the base fee to stay constant
+	TargetGasPerBatch uint64
+	// ElasticityMultiplier - the gas limit of a batch is the target multiplied by this value
+	ElasticityMultiplier uint64
+	// MinBaseFee - the base fee of the genesis batch, and the value below which the base fee never drops
+	MinBaseFee *big.Int
+	// FeeRecipient - the address collecting both the priority fees and the base fees paid by the transactions
+	FeeRecipient gethcommon.Address
+	// MaxSyntheticGasPerBatch - the gas the synthetic transactions delivering the inbound messages can use in a batch
+	MaxSyntheticGasPerBatch uint64
+}
+
+// GasLimit returns the maximum amount of gas the transactions of a batch can use
+func (gc *GasConfig) GasLimit() uint64 {
+	return gc.TargetGasPerBatch * gc.ElasticityMultiplier
+}
+
+// SyntheticGasLimit returns the gas the synthetic transactions of a batch can use, which is never above the gas limit
+// of the batch since they are executed against it
+func (gc *GasConfig) SyntheticGasLimit() uint64 {
+	if gc.MaxSyntheticGasPerBatch == 0 || gc.MaxSyntheticGasPerBatch > gc.GasLimit() {
+		return gc.GasLimit()
+	}
+	return gc.MaxSyntheticGasPerBatch
+}
+
+// CalcBaseFee - calculates the base fee of the batch following the given parent.
+// It is the EIP-1559 update rule, with the target derived from the gas limit of the parent, as in geth's misc.CalcBaseFee.
+func (gc *GasConfig) CalcBaseFee(parent *common.BatchHeader) *big.Int {
+	// the parent was produced without a base fee (e.g. a genesis batch created before the fee market was introduced)
+	if parent.BaseFee == nil {
+		return new(big.Int).Set(gc.MinBaseFee)
+	}
+
+	parentGasTarget := parent.GasLimit / gc.ElasticityMultiplier
+	if parentGasTarget == 0 || parent.GasUsed == parentGasTarget {
+		return gc.applyFloor(new(big.Int).Set(parent.BaseFee))
+	}
+
+	num := new(big.Int)
+	denom := new(big.Int)
+	if parent.GasUsed > parentGasTarget {
+		// If the parent batch used more gas than its target, the base fee should increase.
+		// max(1, parentBaseFee * gasUsedDelta / parentGasTarget / baseFeeChangeDenominator)
+		num.SetUint64(parent.GasUsed - parentGasTarget)
+		num.Mul(num, parent.BaseFee)
+		num.Div(num, denom.SetUint64(parentGasTarget))
+		num.Div(num, denom.SetUint64(params.DefaultBaseFeeChangeDenominator))
+		if num.Cmp(gethcommon.Big1) < 0 {
+			num.Set(gethcommon.Big1)
+		}
+		return gc.applyFloor(num.Add(num, parent.BaseFee))
+	}
+
+	// Otherwise if the parent batch used less gas than its target, the base fee should decrease.
+	// max(minBaseFee, parentBaseFee - parentBaseFee * gasUsedDelta / parentGasTarget / baseFeeChangeDenominator)
+	num.SetUint64(parentGasTarget - parent.GasUsed)
+	num.Mul(num, parent.BaseFee)
+	num.Div(num, denom.SetUint64(parentGasTarget))
+	num.Div(num, denom.SetUint64(params.DefaultBaseFeeChangeDenominator))
+	return gc.applyFloor(num.Sub(parent.BaseFee, num))
+}
+
+// CollectedBaseFees returns the base fees paid by the transactions, which are credited to the fee recipient instead of being burned.
+// The transactions that don't pay any fee (e.g. the synthetic transactions) are skipped, as the EVM doesn't charge them.
+func (gc *GasConfig) CollectedBaseFees(baseFee *big.Int, txs []*common.L2Tx, receipts types.Receipts) *big.Int {
+	total := big.NewInt(0)
+	for i, tx := range txs {
+		if tx.GasFeeCap().Sign() == 0 && tx.GasTipCap().Sign() == 0 {
+			continue
+		}
+		fee := new(big.Int).SetUint64(receipts[i].GasUsed)
+		total.Add(total, fee.Mul(fee, baseFee))
+	}
+	return total
+}
+
+func (gc *GasConfig) applyFloor(baseFee *big.Int) *big.Int {
+	if baseFee.Cmp(gc.MinBaseFee) < 0 {
+		return baseFee.Set(gc.MinBaseFee)
+	}
+	return baseFee
+}
@@ -0,0 +1,58 @@
+package components
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/integration/datagenerator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaseFeeFollowsParentGasUsage(t *testing.T) {
+	gasConfig := &GasConfig{
+		TargetGasPerBatch:    10_000_000,
+		ElasticityMultiplier: 2,
+		MinBaseFee:           big.NewInt(1_000),
+	}
+
+	testCases := []struct {
+		name          string
+		parentGasUsed uint64
+		parentBaseFee *big.Int
+		expected      *big.Int
+	}{
+		{"usage at target keeps the base fee", 10_000_000, big.NewInt(1_000_000), big.NewInt(1_000_000)},
+		{"full batch increases the base fee by 12.5%", 20_000_000, big.NewInt(1_000_000), big.NewInt(1_125_000)},
+		{"empty batch decreases the base fee by 12.5%", 0, big.NewInt(1_000_000), big.NewInt(875_000)},
+		{"half of the excess increases the base fee by 6.25%", 15_000_000, big.NewInt(1_000_000), big.NewInt(1_062_500)},
+		{"usage above the target always increases the base fee", 10_000_001, big.NewInt(1_000), big.NewInt(1_001)},
+		{"the base fee never drops below the minimum", 0, big.NewInt(1_050), big.NewInt(1_000)},
+		{"parents without a base fee start from the minimum", 0, nil, big.NewInt(1_000)},
+	}
+
+	for _, tc := range testCases {
+		parent := &common.BatchHeader{
+			GasLimit: gasConfig.GasLimit(),
+			GasUsed:  tc.parentGasUsed,
+			BaseFee:  tc.parentBaseFee,
+		}
+		assert.Equal(t, tc.expected, gasConfig.CalcBaseFee(parent), tc.name)
+	}
+}
+
+func TestCollectedBaseFeesSkipFreeTransactions(t *testing.T) {
+	gasConfig := &GasConfig{MinBaseFee: big.NewInt(1)}
+	to := datagenerator.RandomAddress()
+
+	txs := []*common.L2Tx{
+		types.NewTx(&types.LegacyTx{GasPrice: big.NewInt(20), Gas: 21_000, To: &to}),
+		// synthetic transactions don't pay for gas
+		types.NewTx(&types.LegacyTx{GasPrice: big.NewInt(0), Gas: 50_000, To: &to}),
+		types.NewTx(&types.DynamicFeeTx{GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(30), Gas: 30_000, To: &to}),
+	}
+	receipts := types.Receipts{{GasUsed: 21_000}, {GasUsed: 50_000}, {GasUsed: 25_000}}
+
+	assert.Equal(t, big.NewInt(10*(21_000+25_000)), gasConfig.CollectedBaseFees(big.NewInt(10), txs, receipts))
+}
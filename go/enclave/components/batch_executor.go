@@ -24,6 +24,7 @@ import (
 	"github.com/obscuronet/go-obscuro/go/enclave/crosschain"
 	"github.com/obscuronet/go-obscuro/go/enclave/evm"
 	"github.com/obscuronet/go-obscuro/go/enclave/genesis"
+	"github.com/obscuronet/go-obscuro/go/enclave/vkhandler"
 )
 
 // batchExecutor - the component responsible for executing batches
@@ -33,19 +34,24 @@ type batchExecutor struct {
 	genesis              *genesis.Genesis
 	logger               gethlog.Logger
 	chainConfig          *params.ChainConfig
+	gasConfig            *GasConfig
+	// forcedTxInclusionWindow - the number of L1 blocks after which the forced transactions must be in a batch
+	forcedTxInclusionWindow uint64
 
 	// stateDBMutex - used to protect calls to stateDB.Commit as it is not safe for async access.
 	stateDBMutex sync.Mutex
 }
 
-func NewBatchExecutor(storage storage.Storage, cc *crosschain.Processors, genesis *genesis.Genesis, chainConfig *params.ChainConfig, logger gethlog.Logger) BatchExecutor {
+func NewBatchExecutor(storage storage.Storage, cc *crosschain.Processors, genesis *genesis.Genesis, chainConfig *params.ChainConfig, gasConfig *GasConfig, forcedTxInclusionWindow uint64, logger gethlog.Logger) BatchExecutor {
 	return &batchExecutor{
-		storage:              storage,
-		crossChainProcessors: cc,
-		genesis:              genesis,
-		chainConfig:          chainConfig,
-		logger:               logger,
-		stateDBMutex:         sync.Mutex{},
+		storage:                 storage,
+		crossChainProcessors:    cc,
+		genesis:                 genesis,
+		chainConfig:             chainConfig,
+		gasConfig:               gasConfig,
+		forcedTxInclusionWindow: forcedTxInclusionWindow,
+		logger:                  logger,
+		stateDBMutex:            sync.Mutex{},
 	}
 }
 
@@ -80,39 +86,82 @@ func (executor *batchExecutor) ComputeBatch(context *BatchExecutionContext) (*Co
 		}
 	}
 
-	// Create a new batch based on the fromBlock of inclusion of the previous, including all new transactions
-	batch := core.DeterministicEmptyBatch(parent.Header, block, context.AtTime, context.SequencerNo)
+	// Create a new batch based on the fromBlock of inclusion of the previous, including all new transactions.
+	// The base fee depends only on the parent, so validators and nodes rebuilding the batches from the rollups reach the same value.
+	baseFee := executor.gasConfig.CalcBaseFee(parent.Header)
+	batch := core.DeterministicEmptyBatch(parent.Header, block, context.AtTime, context.SequencerNo, executor.gasConfig.GasLimit(), baseFee)
 
 	stateDB, err := executor.storage.CreateStateDB(batch.Header.ParentHash)
 	if err != nil {
 		return nil, fmt.Errorf("could not create stateDB. Cause: %w", err)
 	}
 
-	var messages common.CrossChainMessages
+	var messages, deferredMessages common.CrossChainMessages
+	var forcedTxs common.L2Transactions
 	// Cross chain data is not accessible until one after the genesis batch
 	if context.SequencerNo.Int64() > int64(common.L2GenesisSeqNo+1) {
-		messages = executor.crossChainProcessors.Local.RetrieveInboundMessages(parentBlock, block, stateDB)
+		// the messages the parent left out are delivered before the ones maturing with this batch
+		messages, err = executor.storage.GetDeferredL1Messages(parent.Hash())
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve the inbound cross chain messages deferred by the parent. Cause: %w", err)
+		}
+		newMessages, err := executor.crossChainProcessors.Local.RetrieveInboundMessages(parentBlock, block, stateDB)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve inbound cross chain messages. Cause: %w", err)
+		}
+		var droppedMessages common.CrossChainMessages
+		messages, deferredMessages, droppedMessages = limitSyntheticGas(append(messages, newMessages...), executor.gasConfig.SyntheticGasLimit(), executor.gasConfig.GasLimit())
+		for _, msg := range droppedMessages {
+			executor.logger.Error("Dropping inbound cross chain message whose synthetic transaction does not fit in a batch",
+				"sender", msg.Sender, "sequence", msg.Sequence, "payloadSize", len(msg.Payload))
+		}
+		if len(deferredMessages) > 0 {
+			executor.logger.Info(fmt.Sprintf("Deferring %d inbound cross chain messages to the next batch", len(deferredMessages)))
+		}
+		forcedTxs, err = executor.retrieveForcedTransactions(parentBlock, block)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve forced transactions. Cause: %w", err)
+		}
+	}
+	crossChainTransactions, err := executor.crossChainProcessors.Local.CreateSyntheticTransactions(messages, stateDB)
+	if err != nil {
+		return nil, fmt.Errorf("could not create synthetic transactions. Cause: %w", err)
 	}
-	crossChainTransactions := executor.crossChainProcessors.Local.CreateSyntheticTransactions(messages, stateDB)
 
-	successfulTxs, txReceipts, err := executor.processTransactions(batch, 0, context.Transactions, stateDB, context.ChainConfig)
+	// the viewing key revocations are not executed by the EVM, they are recorded when the batch is committed
+	transactions, revocationTxs, revocations := executor.extractRevocations(context.Transactions)
+
+	// the forced transactions reaching their inclusion deadline are executed first, unless the batch already has them
+	_, forcedTxs = partitionTransactions(forcedTxs, transactions)
+	transactions = append(append(make([]*common.L2Tx, 0, len(forcedTxs)+len(transactions)), forcedTxs...), transactions...)
+
+	// the L1 data fee is priced with the base fee of the L1 block the batch is built on, so all nodes charge the same amount
+	successfulTxs, txReceipts, err := executor.processTransactions(batch, 0, transactions, stateDB, context.ChainConfig, block.BaseFee())
 	if err != nil {
 		return nil, fmt.Errorf("could not process transactions. Cause: %w", err)
 	}
 
-	ccSuccessfulTxs, ccReceipts, err := executor.processTransactions(batch, len(successfulTxs), crossChainTransactions, stateDB, context.ChainConfig)
+	includedForcedTxs, _ := partitionTransactions(forcedTxs, successfulTxs)
+
+	ccSuccessfulTxs, ccReceipts, err := executor.processTransactions(batch, len(successfulTxs), crossChainTransactions, stateDB, context.ChainConfig, block.BaseFee())
 	if err != nil {
 		return nil, err
 	}
 
-	if err = executor.verifyInboundCrossChainTransactions(crossChainTransactions, ccSuccessfulTxs, ccReceipts); err != nil {
+	failedCrossChainTxs, err := executor.verifyInboundCrossChainTransactions(crossChainTransactions, ccSuccessfulTxs, ccReceipts)
+	if err != nil {
 		return nil, fmt.Errorf("batch computation failed due to cross chain messages. Cause: %w", err)
 	}
 
+	// the base fees are not burned, but collected by the sequencer together with the priority fees
+	if collectedFees := executor.gasConfig.CollectedBaseFees(baseFee, successfulTxs, txReceipts); collectedFees.Sign() > 0 {
+		stateDB.AddBalance(executor.gasConfig.FeeRecipient, collectedFees)
+	}
+
 	// we need to copy the batch to reset the internal hash cache
 	copyBatch := *batch
 	copyBatch.Header.Root = stateDB.IntermediateRoot(false)
-	copyBatch.Transactions = successfulTxs
+	copyBatch.Transactions = append(successfulTxs, revocationTxs...)
 	copyBatch.ResetHash()
 
 	if err = executor.populateOutboundCrossChainData(&copyBatch, block, txReceipts); err != nil {
@@ -129,9 +178,28 @@ func (executor *batchExecutor) ComputeBatch(context *BatchExecutionContext) (*Co
 		}
 	}
 
+	// the synthetic transactions are created in the order of the messages, one per message
+	deliveries := make([]common.L1MessageDelivery, 0, len(crossChainTransactions))
+	for i, tx := range crossChainTransactions {
+		if failedCrossChainTxs[tx.Hash()] {
+			continue
+		}
+		msgHash, err := common.CrossChainMessageHash(messages[i])
+		if err != nil {
+			return nil, fmt.Errorf("could not hash inbound cross chain message. Cause: %w", err)
+		}
+		deliveries = append(deliveries, common.L1MessageDelivery{MessageHash: msgHash, TxHash: tx.Hash()})
+	}
+
+	bridgeTransfers, wrappedTokens, err := executor.crossChainProcessors.Bridge.ExtractBatchTransfers(copyBatch.Transactions, txReceipts)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract the bridge transfers. Cause: %w", err)
+	}
+
 	return &ComputedBatch{
-		Batch:    &copyBatch,
-		Receipts: txReceipts,
+		Batch:              &copyBatch,
+		Receipts:           txReceipts,
+		ForcedTransactions: includedForcedTxs,
 		Commit: func(deleteEmptyObjects bool) (gethcommon.Hash, error) {
 			executor.stateDBMutex.Lock()
 			defer executor.stateDBMutex.Unlock()
@@ -140,8 +208,27 @@ func (executor *batchExecutor) ComputeBatch(context *BatchExecutionContext) (*Co
 				return gethcommon.Hash{}, err
 			}
 			trieDB := executor.storage.TrieDB()
-			err = trieDB.Commit(h, true)
-			return h, err
+			if err = trieDB.Commit(h, true); err != nil {
+				return h, err
+			}
+			for _, revocation := range revocations {
+				if err = executor.storage.StoreViewingKeyRevocation(revocation.ViewingKey, revocation.Account); err != nil {
+					return h, fmt.Errorf("could not store viewing key revocation. Cause: %w", err)
+				}
+			}
+			if err = executor.storage.StoreL1MessageDeliveries(copyBatch.Hash(), deliveries); err != nil {
+				return h, fmt.Errorf("could not store inbound message deliveries. Cause: %w", err)
+			}
+			if err = executor.storage.StoreDeferredL1Messages(copyBatch.Hash(), deferredMessages); err != nil {
+				return h, fmt.Errorf("could not store deferred inbound messages. Cause: %w", err)
+			}
+			if err = executor.storage.StoreWrappedTokens(copyBatch.Hash(), wrappedTokens); err != nil {
+				return h, fmt.Errorf("could not store wrapped tokens. Cause: %w", err)
+			}
+			if err = executor.storage.StoreBridgeTransfers(copyBatch.Hash(), bridgeTransfers); err != nil {
+				return h, fmt.Errorf("could not store bridge transfers. Cause: %w", err)
+			}
+			return h, nil
 		},
 	}, nil
 }
@@ -166,6 +253,12 @@ func (executor *batchExecutor) ExecuteBatch(batch *core.Batch) (types.Receipts,
 		return nil, fmt.Errorf("failed computing batch %s. Cause: %w", batch.Hash(), err)
 	}
 
+	// the sequencer is not allowed to leave out the transactions forced through the L1 once their deadline has passed
+	if len(cb.ForcedTransactions) > 0 {
+		executor.logger.Error("Batch censors forced transactions", log.BatchHashKey, batch.Hash(), "count", len(cb.ForcedTransactions))
+		return nil, fmt.Errorf("batch %s does not include the forced transaction %s past its inclusion deadline", batch.Hash(), cb.ForcedTransactions[0].Hash())
+	}
+
 	if cb.Batch.Hash() != batch.Hash() {
 		// todo @stefan - generate a validator challenge here and return it
 		executor.logger.Error(fmt.Sprintf("Error validating batch. Calculated: %+v\n Incoming: %+v\n", cb.Batch.Header, batch.Header))
@@ -195,6 +288,8 @@ func (executor *batchExecutor) CreateGenesisState(blkHash common.L1BlockHash, ti
 			SequencerOrderNo: big.NewInt(int64(common.L2GenesisSeqNo)), // genesis batch has seq number 1
 			ReceiptHash:      types.EmptyRootHash,
 			Time:             timeNow,
+			GasLimit:         executor.gasConfig.GasLimit(),
+			BaseFee:          new(big.Int).Set(executor.gasConfig.MinBaseFee),
 		},
 		Transactions: []*common.L2Tx{},
 	}
@@ -219,6 +314,10 @@ func (executor *batchExecutor) populateOutboundCrossChainData(batch *core.Batch,
 	}
 
 	batch.Header.CrossChainMessages = crossChainMessages
+	batch.Header.CrossChainRoot, err = common.CrossChainMessagesRoot(crossChainMessages)
+	if err != nil {
+		return fmt.Errorf("could not compute the cross chain root. Cause: %w", err)
+	}
 
 	executor.logger.Trace(fmt.Sprintf("Added %d cross chain messages to batch.",
 		len(batch.Header.CrossChainMessages)), log.CmpKey, log.CrossChainCmp)
@@ -230,6 +329,11 @@ func (executor *batchExecutor) populateOutboundCrossChainData(batch *core.Batch,
 }
 
 func (executor *batchExecutor) populateHeader(batch *core.Batch, receipts types.Receipts) {
+	batch.Header.GasUsed = 0
+	for _, receipt := range receipts {
+		batch.Header.GasUsed += receipt.GasUsed
+	}
+
 	if len(receipts) == 0 {
 		batch.Header.ReceiptHash = types.EmptyRootHash
 	} else {
@@ -243,25 +347,107 @@ func (executor *batchExecutor) populateHeader(batch *core.Batch, receipts types.
 	}
 }
 
-func (executor *batchExecutor) verifyInboundCrossChainTransactions(transactions types.Transactions, executedTxs types.Transactions, receipts types.Receipts) error {
+func (executor *batchExecutor) verifyInboundCrossChainTransactions(transactions types.Transactions, executedTxs types.Transactions, receipts types.Receipts) (map[gethcommon.Hash]bool, error) {
 	if transactions.Len() != executedTxs.Len() {
-		return fmt.Errorf("some synthetic transactions have not been executed")
+		return nil, fmt.Errorf("some synthetic transactions have not been executed")
 	}
 
+	// a synthetic transaction can run out of gas if its gas is under-estimated. Every node executing the batch gets the
+	// same failure, so the message is reported and not recorded as delivered rather than halting the chain.
+	failed := make(map[gethcommon.Hash]bool)
 	for _, rec := range receipts {
-		if rec.Status == 1 {
+		if rec.Status == types.ReceiptStatusSuccessful {
 			continue
 		}
-		return fmt.Errorf("found a failed receipt for a synthetic transaction: %s", rec.TxHash.Hex())
+		executor.logger.Error("Found a failed receipt for a synthetic transaction", log.TxKey, rec.TxHash, "gasUsed", rec.GasUsed)
+		failed[rec.TxHash] = true
 	}
-	return nil
+	return failed, nil
 }
 
-func (executor *batchExecutor) processTransactions(batch *core.Batch, tCount int, txs []*common.L2Tx, stateDB *state.StateDB, cc *params.ChainConfig) ([]*common.L2Tx, []*types.Receipt, error) {
+// extractRevocations separates the system transactions carrying viewing key revocations from the transactions to be
+// executed. Revocations which cannot be verified, or which revoke a viewing key already revoked earlier in the batch, are
+// excluded from the batch, like failed transactions.
+func (executor *batchExecutor) extractRevocations(txs []*common.L2Tx) ([]*common.L2Tx, []*common.L2Tx, []*vkhandler.Revocation) {
+	var transactions []*common.L2Tx
+	var revocationTxs []*common.L2Tx
+	var revocations []*vkhandler.Revocation
+	revokedViewingKeys := make(map[string]struct{})
+	for _, tx := range txs {
+		if !vkhandler.IsRevocationTx(tx) {
+			transactions = append(transactions, tx)
+			continue
+		}
+		revocation, err := vkhandler.DecodeRevocationTx(tx, executor.chainConfig.ChainID.Int64())
+		if err != nil {
+			executor.logger.Info("Excluding viewing key revocation from batch", log.TxKey, tx.Hash(), "cause", err)
+			continue
+		}
+		if _, found := revokedViewingKeys[string(revocation.ViewingKey)]; found {
+			executor.logger.Info("Excluding duplicate viewing key revocation from batch", log.TxKey, tx.Hash())
+			continue
+		}
+		revokedViewingKeys[string(revocation.ViewingKey)] = struct{}{}
+		revocationTxs = append(revocationTxs, tx)
+		revocations = append(revocations, revocation)
+	}
+	return transactions, revocationTxs, revocations
+}
+
+// retrieveForcedTransactions returns the transactions forced through the management contract whose inclusion deadline
+// falls in the (fromBlock, toBlock] range, in the order they were published on the L1.
+func (executor *batchExecutor) retrieveForcedTransactions(fromBlock *common.L1Block, toBlock *common.L1Block) (common.L2Transactions, error) {
+	window := executor.forcedTxInclusionWindow
+	fromHeight, toHeight := fromBlock.NumberU64(), toBlock.NumberU64()
+	if toHeight <= fromHeight || toHeight < window {
+		return nil, nil
+	}
+
+	lowestHeight := uint64(0)
+	if fromHeight+1 > window {
+		lowestHeight = fromHeight + 1 - window
+	}
+	highestHeight := toHeight - window
+
+	var blocks []*common.L1Block
+	for b := toBlock; ; {
+		if b.NumberU64() <= highestHeight {
+			blocks = append(blocks, b)
+		}
+		if b.NumberU64() <= lowestHeight {
+			break
+		}
+		p, err := executor.storage.FetchBlock(b.ParentHash())
+		if errors.Is(err, errutil.ErrNotFound) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve the parent of block %s. Cause: %w", b.Hash(), err)
+		}
+		b = p
+	}
+
+	var forcedTxs common.L2Transactions
+	for i := len(blocks) - 1; i >= 0; i-- {
+		txs, err := executor.storage.GetForcedTransactions(blocks[i].Hash())
+		if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+			return nil, fmt.Errorf("could not retrieve the forced transactions of block %s. Cause: %w", blocks[i].Hash(), err)
+		}
+		for _, tx := range txs {
+			// revocations are only accepted from the sequencer, as they are not executed by the EVM
+			if !vkhandler.IsRevocationTx(tx) {
+				forcedTxs = append(forcedTxs, tx)
+			}
+		}
+	}
+	return forcedTxs, nil
+}
+
+func (executor *batchExecutor) processTransactions(batch *core.Batch, tCount int, txs []*common.L2Tx, stateDB *state.StateDB, cc *params.ChainConfig, l1BaseFee *big.Int) ([]*common.L2Tx, []*types.Receipt, error) {
 	var executedTransactions []*common.L2Tx
 	var txReceipts []*types.Receipt
 
-	txResults := evm.ExecuteTransactions(txs, stateDB, batch.Header, executor.storage, cc, tCount, executor.logger)
+	txResults := evm.ExecuteTransactions(txs, stateDB, batch.Header, executor.storage, cc, tCount, executor.gasConfig.FeeRecipient, l1BaseFee, executor.logger)
 	for _, tx := range txs {
 		result, f := txResults[tx.Hash()]
 		if !f {
@@ -281,6 +467,46 @@ func (executor *batchExecutor) processTransactions(batch *core.Batch, tCount int
 	return executedTransactions, txReceipts, nil
 }
 
+// limitSyntheticGas splits the inbound messages into the ones the batch delivers, which are the longest prefix whose
+// synthetic transactions fit in the synthetic gas limit, the ones deferred to the next batch, and the ones dropped
+// because their synthetic transaction needs more gas than a whole batch has, which could never be executed. The first
+// message which is not dropped is always delivered, so that a message needing more gas than the synthetic limit is not
+// deferred forever.
+func limitSyntheticGas(messages common.CrossChainMessages, syntheticGasLimit uint64, gasLimit uint64) (common.CrossChainMessages, common.CrossChainMessages, common.CrossChainMessages) {
+	var delivered, deferred, dropped common.CrossChainMessages
+	gasUsed := uint64(0)
+	for _, msg := range messages {
+		gas := crosschain.SyntheticTxGas(msg)
+		switch {
+		case gas > gasLimit:
+			dropped = append(dropped, msg)
+		case len(deferred) > 0 || (len(delivered) > 0 && gasUsed+gas > syntheticGasLimit):
+			deferred = append(deferred, msg)
+		default:
+			gasUsed += gas
+			delivered = append(delivered, msg)
+		}
+	}
+	return delivered, deferred, dropped
+}
+
+// partitionTransactions splits the transactions into the ones present in the others list and the ones missing from it
+func partitionTransactions(txs []*common.L2Tx, others []*common.L2Tx) ([]*common.L2Tx, []*common.L2Tx) {
+	otherHashes := make(map[gethcommon.Hash]bool, len(others))
+	for _, tx := range others {
+		otherHashes[tx.Hash()] = true
+	}
+	var present, missing []*common.L2Tx
+	for _, tx := range txs {
+		if otherHashes[tx.Hash()] {
+			present = append(present, tx)
+		} else {
+			missing = append(missing, tx)
+		}
+	}
+	return present, missing
+}
+
 func allReceipts(txReceipts []*types.Receipt, depositReceipts []*types.Receipt) types.Receipts {
 	return append(txReceipts, depositReceipts...)
 }
@@ -0,0 +1,138 @@
+package components
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/ecies"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/viewingkey"
+	"github.com/obscuronet/go-obscuro/go/enclave/crosschain"
+	"github.com/obscuronet/go-obscuro/go/enclave/mempool"
+	"github.com/obscuronet/go-obscuro/go/enclave/vkhandler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyntheticGasLimitIsCappedByBatchGasLimit(t *testing.T) {
+	gasConfig := &GasConfig{TargetGasPerBatch: 10_000_000, ElasticityMultiplier: 2, MaxSyntheticGasPerBatch: 5_000_000}
+	assert.Equal(t, uint64(5_000_000), gasConfig.SyntheticGasLimit())
+
+	gasConfig.MaxSyntheticGasPerBatch = 50_000_000
+	assert.Equal(t, uint64(20_000_000), gasConfig.SyntheticGasLimit())
+
+	gasConfig.MaxSyntheticGasPerBatch = 0
+	assert.Equal(t, uint64(20_000_000), gasConfig.SyntheticGasLimit())
+}
+
+func TestInboundMessagesOverSyntheticGasLimitAreDeferred(t *testing.T) {
+	messages := make(common.CrossChainMessages, 4)
+	for i := range messages {
+		messages[i] = common.CrossChainMessage{Sequence: uint64(i), Payload: make([]byte, 100*i)}
+	}
+	gasOf := func(msgs common.CrossChainMessages) uint64 {
+		gas := uint64(0)
+		for _, msg := range msgs {
+			gas += crosschain.SyntheticTxGas(msg)
+		}
+		return gas
+	}
+
+	testCases := []struct {
+		name      string
+		gasLimit  uint64
+		delivered int
+	}{
+		{"all the messages fit", gasOf(messages), 4},
+		{"the messages after the limit are deferred", gasOf(messages[:3]), 3},
+		{"a limit between two messages defers the second one", gasOf(messages[:2]) + 1, 2},
+		{"the first message is delivered even if it is over the synthetic limit", 1, 1},
+	}
+
+	for _, tc := range testCases {
+		delivered, deferred, dropped := limitSyntheticGas(messages, tc.gasLimit, gasOf(messages))
+		assert.Equal(t, messages[:tc.delivered], delivered, tc.name)
+		assert.Len(t, deferred, len(messages)-tc.delivered, tc.name)
+		if len(deferred) > 0 {
+			// the deferred messages keep their order, to be delivered first by the next batch
+			assert.Equal(t, messages[tc.delivered:], deferred, tc.name)
+		}
+		assert.Empty(t, dropped, tc.name)
+	}
+
+	delivered, deferred, dropped := limitSyntheticGas(nil, 1, 1)
+	assert.Empty(t, delivered)
+	assert.Empty(t, deferred)
+	assert.Empty(t, dropped)
+}
+
+func TestInboundMessagesOverBatchGasLimitAreDropped(t *testing.T) {
+	const gasLimit = 10_000_000
+	small := common.CrossChainMessage{Sequence: 0, Payload: make([]byte, 100)}
+	// each word of payload costs more than the 20k gas of storing it, so the synthetic transaction needs more gas than
+	// a whole batch has
+	oversized := common.CrossChainMessage{Sequence: 1, Payload: make([]byte, 32*gasLimit/20_000)}
+	require.Greater(t, crosschain.SyntheticTxGas(oversized), uint64(gasLimit))
+	last := common.CrossChainMessage{Sequence: 2, Payload: make([]byte, 100)}
+
+	// the oversized message is dropped even when it comes first, instead of being delivered and halting the chain
+	delivered, deferred, dropped := limitSyntheticGas(common.CrossChainMessages{oversized, small, last}, gasLimit, gasLimit)
+	assert.Equal(t, common.CrossChainMessages{small, last}, delivered)
+	assert.Empty(t, deferred)
+	assert.Equal(t, common.CrossChainMessages{oversized}, dropped)
+
+	// it is dropped rather than deferred when the messages before it fill the synthetic gas limit
+	delivered, deferred, dropped = limitSyntheticGas(common.CrossChainMessages{small, oversized, last}, crosschain.SyntheticTxGas(small), gasLimit)
+	assert.Equal(t, common.CrossChainMessages{small}, delivered)
+	assert.Equal(t, common.CrossChainMessages{last}, deferred)
+	assert.Equal(t, common.CrossChainMessages{oversized}, dropped)
+}
+
+func TestDuplicateRevocationsAreDropped(t *testing.T) {
+	const chainID = 443
+	userKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	vkKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	vkPubKeyBytes := crypto.CompressPubkey(ecies.ImportECDSAPublic(&vkKey.PublicKey).ExportECDSA())
+	now := uint64(time.Now().Unix())
+	hash, _, err := apitypes.TypedDataAndHash(viewingkey.GenerateEIP712TypedData(vkPubKeyBytes, chainID, now, now+3600))
+	require.NoError(t, err)
+	signature, err := crypto.Sign(hash, userKey)
+	require.NoError(t, err)
+	revocation := &vkhandler.Revocation{
+		Account:    crypto.PubkeyToAddress(userKey.PublicKey),
+		ViewingKey: vkPubKeyBytes,
+		Signature:  viewingkey.EncodeEIP712Signature(signature, now, now+3600),
+	}
+
+	// each revocation tx is signed with a new key, so revoking the same viewing key twice gives two different txs
+	revocationTxs := make([]*common.L2Tx, 2)
+	for i := range revocationTxs {
+		revocationTxs[i], err = vkhandler.NewRevocationTx(revocation, chainID)
+		require.NoError(t, err)
+	}
+	require.NotEqual(t, revocationTxs[0].Hash(), revocationTxs[1].Hash())
+
+	// the mempool only keeps one of them until it is included in a batch
+	memp := mempool.New(chainID, log.New())
+	for _, tx := range revocationTxs {
+		require.NoError(t, memp.AddMempoolTx(tx))
+	}
+	require.Equal(t, revocationTxs[:1], memp.FetchMempoolTxs())
+	require.NoError(t, memp.RemoveTxs(revocationTxs[:1]))
+	require.NoError(t, memp.AddMempoolTx(revocationTxs[1]))
+	require.Equal(t, revocationTxs[1:], memp.FetchMempoolTxs())
+
+	// a batch only records the first revocation of each viewing key
+	executor := &batchExecutor{chainConfig: &params.ChainConfig{ChainID: big.NewInt(chainID)}, logger: log.New()}
+	transactions, includedTxs, revocations := executor.extractRevocations(revocationTxs)
+	assert.Empty(t, transactions)
+	assert.Equal(t, revocationTxs[:1], includedTxs)
+	assert.Equal(t, []*vkhandler.Revocation{revocation}, revocations)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/core/types"
 
+	"github.com/obscuronet/go-obscuro/go/enclave/crosschain"
 	"github.com/obscuronet/go-obscuro/go/enclave/storage"
 
 	"github.com/ethereum/go-ethereum/core/state"
@@ -21,15 +22,17 @@ import (
 
 type batchRegistry struct {
 	storage storage.Storage
+	bridge  crosschain.BridgeWatcher
 	logger  gethlog.Logger
 
 	batchesCallback func(*core.Batch, types.Receipts)
 	callbackMutex   sync.RWMutex
 }
 
-func NewBatchRegistry(storage storage.Storage, logger gethlog.Logger) BatchRegistry {
+func NewBatchRegistry(storage storage.Storage, bridge crosschain.BridgeWatcher, logger gethlog.Logger) BatchRegistry {
 	return &batchRegistry{
 		storage: storage,
+		bridge:  bridge,
 		logger:  logger,
 	}
 }
@@ -53,6 +56,13 @@ func (br *batchRegistry) OnBatchExecuted(batch *core.Batch, receipts types.Recei
 
 	defer br.logger.Debug("Sending batch and events", log.BatchHashKey, batch.Hash(), log.DurationKey, measure.NewStopwatch())
 
+	// the ledger of the bridge must hold once the transfers of the batch are on the canonical chain
+	if br.bridge.Enabled() {
+		if _, err := br.bridge.OnBatchExecuted(batch.Hash(), batch.Header.ParentHash); err != nil {
+			br.logger.Error("Could not check the bridge ledger", log.BatchHashKey, batch.Hash(), log.ErrKey, err)
+		}
+	}
+
 	if br.batchesCallback != nil {
 		br.batchesCallback(batch, receipts)
 	}
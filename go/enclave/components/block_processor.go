@@ -3,6 +3,7 @@ package components
 import (
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/obscuronet/go-obscuro/go/enclave/storage"
 
@@ -14,22 +15,74 @@ import (
 	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/common/measure"
 	"github.com/obscuronet/go-obscuro/go/enclave/crosschain"
+	"github.com/obscuronet/go-obscuro/go/enclave/l1chain"
+	"github.com/obscuronet/go-obscuro/go/enclave/rpc"
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+	"github.com/obscuronet/go-obscuro/go/ethadapter/mgmtcontractlib"
 )
 
 type l1BlockProcessor struct {
 	storage              storage.Storage
 	logger               gethlog.Logger
 	crossChainProcessors *crosschain.Processors
+	mgmtContractLib      mgmtcontractlib.MgmtContractLib
+	encryptionManager    *rpc.EncryptionManager // decrypts the transactions forced through the management contract
+	// l1StartHash - the first block ingested, the L1 genesis block if empty
+	l1StartHash gethcommon.Hash
+	headerChain *l1chain.HeaderChain
 }
 
-func NewBlockProcessor(storage storage.Storage, cc *crosschain.Processors, logger gethlog.Logger) L1BlockProcessor {
+func NewBlockProcessor(storage storage.Storage, cc *crosschain.Processors, mgmtContractLib mgmtcontractlib.MgmtContractLib, encryptionManager *rpc.EncryptionManager, l1StartHash gethcommon.Hash, maxReorgDepth uint64, logger gethlog.Logger) L1BlockProcessor {
 	return &l1BlockProcessor{
 		storage:              storage,
 		logger:               logger,
 		crossChainProcessors: cc,
+		mgmtContractLib:      mgmtContractLib,
+		encryptionManager:    encryptionManager,
+		l1StartHash:          l1StartHash,
+		headerChain:          l1chain.NewHeaderChain(storage, maxReorgDepth, logger),
 	}
 }
 
+func (bp *l1BlockProcessor) VerifyL1Start() error {
+	_, err := bp.storage.FetchHeadBlock()
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			// nothing was ingested yet, so the first block will be checked when it arrives
+			return nil
+		}
+		return fmt.Errorf("could not retrieve head block. Cause: %w", err)
+	}
+
+	startBlock, err := bp.storage.FetchCanonicaBlockByHeight(big.NewInt(0))
+	if bp.l1StartHash != gethutil.EmptyHash {
+		startBlock, err = bp.storage.FetchBlock(bp.l1StartHash)
+	}
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return fmt.Errorf("the database was not initialised from the configured L1 start block %s", bp.l1StartHash)
+		}
+		return fmt.Errorf("could not retrieve L1 start block. Cause: %w", err)
+	}
+
+	// the stored chain must start with the configured block, rather than only contain it
+	firstBlock := startBlock
+	for {
+		parent, err := bp.storage.FetchBlock(firstBlock.ParentHash())
+		if errors.Is(err, errutil.ErrNotFound) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("could not retrieve parent of block %s. Cause: %w", firstBlock.Hash(), err)
+		}
+		firstBlock = parent
+	}
+	if firstBlock.Hash() != startBlock.Hash() {
+		return fmt.Errorf("the database starts from L1 block %s, not from the configured L1 start block %s", firstBlock.Hash(), startBlock.Hash())
+	}
+	return nil
+}
+
 func (bp *l1BlockProcessor) Process(br *common.BlockAndReceipts) (*BlockIngestionType, error) {
 	defer bp.logger.Info("L1 block processed", log.BlockHashKey, br.Block.Hash(), log.DurationKey, measure.NewStopwatch())
 
@@ -44,11 +97,43 @@ func (bp *l1BlockProcessor) Process(br *common.BlockAndReceipts) (*BlockIngestio
 		if err != nil {
 			return nil, errors.New("failed to process cross chain messages")
 		}
+
+		if err = bp.storage.StoreForcedTransactions(br.Block.Hash(), bp.extractForcedTransactions(br)); err != nil {
+			return nil, fmt.Errorf("failed to store forced transactions. Cause: %w", err)
+		}
 	}
+	bp.crossChainProcessors.Bridge.OnBlockProcessed(br.Block)
 
 	return ingestion, nil
 }
 
+// extractForcedTransactions returns the L2 transactions submitted through the management contract in the block. The
+// submissions which can't be decrypted or decoded are skipped, as they are not a valid L2 transaction anyone could include.
+func (bp *l1BlockProcessor) extractForcedTransactions(br *common.BlockAndReceipts) common.L2Transactions {
+	forcedTxs := make(common.L2Transactions, 0)
+	for _, tx := range *br.SuccessfulTransactions() {
+		forcedTx, ok := bp.mgmtContractLib.DecodeTx(tx).(*ethadapter.L1ForcedTx)
+		if !ok {
+			continue
+		}
+
+		txBytes, err := bp.encryptionManager.DecryptBytes(forcedTx.EncryptedTx)
+		if err != nil {
+			bp.logger.Warn("Could not decrypt forced transaction", log.TxKey, tx.Hash(), log.ErrKey, err)
+			continue
+		}
+		l2Tx := new(common.L2Tx)
+		if err = l2Tx.UnmarshalBinary(txBytes); err != nil {
+			bp.logger.Warn("Could not decode forced transaction", log.TxKey, tx.Hash(), log.ErrKey, err)
+			continue
+		}
+
+		bp.logger.Info("Extracted forced transaction from block", log.TxKey, l2Tx.Hash(), log.BlockHashKey, br.Block.Hash())
+		forcedTxs = append(forcedTxs, l2Tx)
+	}
+	return forcedTxs
+}
+
 func (bp *l1BlockProcessor) tryAndInsertBlock(br *common.BlockAndReceipts) (*BlockIngestionType, error) {
 	block := br.Block
 
@@ -75,37 +160,61 @@ func (bp *l1BlockProcessor) tryAndInsertBlock(br *common.BlockAndReceipts) (*Blo
 		return nil, fmt.Errorf("1. could not store block. Cause: %w", err)
 	}
 
+	if err = bp.headerChain.Prune(block); err != nil {
+		// the block was stored, so it is not rejected because of the blocks left behind
+		bp.logger.Warn("Could not prune the L1 chain", log.BlockHashKey, block.Hash(), log.ErrKey, err)
+	}
+
 	return ingestionType, nil
 }
 
 func (bp *l1BlockProcessor) ingestBlock(block *common.L1Block) (*BlockIngestionType, error) {
-	// todo (#1056) - this is minimal L1 tracking/validation, and should be removed when we are using geth's blockchain or lightchain structures for validation
 	prevL1Head, err := bp.storage.FetchHeadBlock()
 	if err != nil {
 		if errors.Is(err, errutil.ErrNotFound) {
-			// todo (@matt) - we should enforce that this block is a configured hash (e.g. the L1 management contract deployment block)
+			// the first block must be the configured start block (e.g. the L1 management contract deployment block)
+			if !bp.isL1Start(block) {
+				return nil, fmt.Errorf("first block %s at height %d is not the L1 start block. Cause: %w", block.Hash(), block.NumberU64(), errutil.ErrBlockBeforeL1Start)
+			}
 			return &BlockIngestionType{PreGenesis: true}, nil
 		}
 		return nil, fmt.Errorf("could not retrieve head block. Cause: %w", err)
 	}
-	// we do a basic sanity check, comparing the received block to the head block on the chain
-	if block.ParentHash() != prevL1Head.Hash() {
-		chainFork, err := gethutil.LCA(block, prevL1Head, bp.storage)
-		if err != nil {
-			bp.logger.Trace("parent not found",
-				"blkHeight", block.NumberU64(), log.BlockHashKey, block.Hash(),
-				"l1HeadHeight", prevL1Head.NumberU64(), "l1HeadHash", prevL1Head.Hash(),
-			)
-			return nil, errutil.ErrBlockAncestorNotFound
-		}
 
-		if chainFork.IsFork() {
-			bp.logger.Info("Fork detected in the l1 chain", "can", chainFork.CommonAncestor.Hash().Hex(), "noncan", prevL1Head.Hash().Hex())
-		}
-		return &BlockIngestionType{ChainFork: chainFork, PreGenesis: false}, nil
+	startHeight, err := bp.l1StartHeight()
+	if err != nil {
+		return nil, err
+	}
+	if block.NumberU64() <= startHeight {
+		return nil, fmt.Errorf("block %s at height %d is not after the L1 start block. Cause: %w", block.Hash(), block.NumberU64(), errutil.ErrBlockBeforeL1Start)
 	}
 
-	return &BlockIngestionType{ChainFork: nil, PreGenesis: false}, nil
+	chainFork, err := bp.headerChain.ChainFork(block)
+	if err != nil {
+		return nil, err
+	}
+	if chainFork != nil && chainFork.IsFork() {
+		bp.logger.Info("Fork detected in the l1 chain", "can", chainFork.CommonAncestor.Hash().Hex(), "noncan", prevL1Head.Hash().Hex())
+	}
+	return &BlockIngestionType{ChainFork: chainFork, PreGenesis: false}, nil
+}
+
+func (bp *l1BlockProcessor) isL1Start(block *common.L1Block) bool {
+	if bp.l1StartHash == gethutil.EmptyHash {
+		return block.NumberU64() == 0
+	}
+	return block.Hash() == bp.l1StartHash
+}
+
+func (bp *l1BlockProcessor) l1StartHeight() (uint64, error) {
+	if bp.l1StartHash == gethutil.EmptyHash {
+		return 0, nil
+	}
+	startBlock, err := bp.storage.FetchBlock(bp.l1StartHash)
+	if err != nil {
+		return 0, fmt.Errorf("could not retrieve L1 start block. Cause: %w", err)
+	}
+	return startBlock.NumberU64(), nil
 }
 
 func (bp *l1BlockProcessor) GetHead() (*common.L1Block, error) {
@@ -0,0 +1,108 @@
+package components
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/enclave/crosschain"
+	"github.com/obscuronet/go-obscuro/go/enclave/storage"
+	"github.com/obscuronet/go-obscuro/go/enclave/storage/init/sqlite"
+	"github.com/obscuronet/go-obscuro/integration"
+	"github.com/obscuronet/go-obscuro/integration/datagenerator"
+	"github.com/obscuronet/go-obscuro/integration/ethereummock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockProcessorStartsFromL1StartBlock(t *testing.T) {
+	chain := mockL1Chain(4)
+	bp := newTestBlockProcessor(t, newTestStorage(t), chain[2].Hash())
+
+	// the blocks before the start block are refused, including the L1 genesis block
+	for _, block := range chain[:2] {
+		_, err := bp.Process(blockAndReceipts(t, block))
+		require.ErrorIs(t, err, errutil.ErrBlockBeforeL1Start)
+	}
+
+	ingestion, err := bp.Process(blockAndReceipts(t, chain[2]))
+	require.NoError(t, err)
+	require.True(t, ingestion.PreGenesis)
+
+	ingestion, err = bp.Process(blockAndReceipts(t, chain[3]))
+	require.NoError(t, err)
+	require.False(t, ingestion.PreGenesis)
+
+	// once the chain started, the blocks at the height of the start block or lower are still refused
+	_, err = bp.Process(blockAndReceipts(t, chain[1]))
+	require.ErrorIs(t, err, errutil.ErrBlockBeforeL1Start)
+	fork := ethereummock.NewBlock(chain[1], datagenerator.RandomAddress(), []*types.Transaction{})
+	_, err = bp.Process(blockAndReceipts(t, fork))
+	require.ErrorIs(t, err, errutil.ErrBlockBeforeL1Start)
+}
+
+func TestBlockProcessorWithoutL1StartBlockStartsFromGenesis(t *testing.T) {
+	chain := mockL1Chain(2)
+	bp := newTestBlockProcessor(t, newTestStorage(t), gethcommon.Hash{})
+
+	_, err := bp.Process(blockAndReceipts(t, chain[1]))
+	require.ErrorIs(t, err, errutil.ErrBlockBeforeL1Start)
+
+	ingestion, err := bp.Process(blockAndReceipts(t, chain[0]))
+	require.NoError(t, err)
+	require.True(t, ingestion.PreGenesis)
+}
+
+func TestBlockProcessorVerifiesStoredL1Start(t *testing.T) {
+	chain := mockL1Chain(3)
+	db := newTestStorage(t)
+
+	// an empty database is accepted by any configuration
+	require.NoError(t, newTestBlockProcessor(t, db, chain[1].Hash()).VerifyL1Start())
+
+	bp := newTestBlockProcessor(t, db, gethcommon.Hash{})
+	for _, block := range chain {
+		_, err := bp.Process(blockAndReceipts(t, block))
+		require.NoError(t, err)
+	}
+	require.NoError(t, bp.VerifyL1Start())
+
+	// the database started from the L1 genesis block, so it disagrees with a later start block
+	require.Error(t, newTestBlockProcessor(t, db, chain[1].Hash()).VerifyL1Start())
+	// or with a start block which was never ingested
+	require.Error(t, newTestBlockProcessor(t, db, gethcommon.BytesToHash(datagenerator.RandomBytes(32))).VerifyL1Start())
+}
+
+// mockL1Chain returns a chain of blocks produced by the mock L1, starting with its genesis block
+func mockL1Chain(length int) []*types.Block {
+	chain := []*types.Block{ethereummock.MockGenesisBlock}
+	for len(chain) < length {
+		chain = append(chain, ethereummock.NewBlock(chain[len(chain)-1], datagenerator.RandomAddress(), []*types.Transaction{}))
+	}
+	return chain
+}
+
+func newTestStorage(t *testing.T) storage.Storage {
+	logger := log.New(log.TestLogCmp, int(gethlog.LvlError), log.SysOut)
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	require.NoError(t, err)
+	return storage.NewStorage(backingDB, nil, logger)
+}
+
+func newTestBlockProcessor(t *testing.T, db storage.Storage, l1StartHash gethcommon.Hash) L1BlockProcessor {
+	logger := log.New(log.TestLogCmp, int(gethlog.LvlError), log.SysOut)
+	cc := crosschain.New(&ethereummock.MessageBusAddress, &ethereummock.BridgeAddress, &gethcommon.Address{}, db, big.NewInt(integration.ObscuroChainID), logger)
+	bp := NewBlockProcessor(db, cc, ethereummock.NewMgmtContractLibMock(), nil, l1StartHash, 64, logger)
+	require.NotNil(t, bp)
+	return bp
+}
+
+func blockAndReceipts(t *testing.T, block *types.Block) *common.BlockAndReceipts {
+	br, err := common.ParseBlockAndReceipts(block, &types.Receipts{})
+	require.NoError(t, err)
+	return br
+}
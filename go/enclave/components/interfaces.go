@@ -37,6 +37,8 @@ type L1BlockProcessor interface {
 	Process(br *common.BlockAndReceipts) (*BlockIngestionType, error)
 	GetHead() (*common.L1Block, error)
 	GetCrossChainContractAddress() *gethcommon.Address
+	// VerifyL1Start - returns an error if the stored L1 chain does not start with the configured L1 start block
+	VerifyL1Start() error
 }
 
 // BatchExecutionContext - Contains all of the data that each batch depends on
@@ -60,6 +62,9 @@ type ComputedBatch struct {
 	Batch    *core.Batch
 	Receipts types.Receipts
 	Commit   func(bool) (gethcommon.Hash, error)
+	// ForcedTransactions are the transactions past their inclusion deadline which were missing from the batch context,
+	// and were added to the batch
+	ForcedTransactions common.L2Transactions
 }
 
 type BatchExecutor interface {
@@ -0,0 +1,71 @@
+package components
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/compression"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/enclave/crypto"
+	"github.com/obscuronet/go-obscuro/integration"
+	"github.com/obscuronet/go-obscuro/integration/datagenerator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollupPayloadPreservesTypedTransactions(t *testing.T) {
+	logger := log.New(log.TestLogCmp, int(gethlog.LvlError), log.SysOut)
+	// only the payload serialisation is exercised, so the components used for re-executing the batches are not needed
+	rc := NewRollupCompression(nil, nil, crypto.NewDataEncryptionService(logger), compression.NewBrotliDataCompressionService(), nil, nil, logger)
+
+	privateKey, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	signer := types.NewLondonSigner(big.NewInt(integration.ObscuroChainID))
+	to := datagenerator.RandomAddress()
+	accessList := types.AccessList{{
+		Address:     datagenerator.RandomAddress(),
+		StorageKeys: []gethcommon.Hash{gethcommon.BigToHash(big.NewInt(1))},
+	}}
+
+	txsData := []types.TxData{
+		&types.LegacyTx{Nonce: 0, GasPrice: big.NewInt(1), Gas: 21_000, To: &to, Value: big.NewInt(1)},
+		&types.AccessListTx{ChainID: big.NewInt(integration.ObscuroChainID), Nonce: 1, GasPrice: big.NewInt(1), Gas: 30_000, To: &to, Value: big.NewInt(1), AccessList: accessList},
+		&types.DynamicFeeTx{ChainID: big.NewInt(integration.ObscuroChainID), Nonce: 2, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2), Gas: 30_000, To: &to, Value: big.NewInt(1), AccessList: accessList},
+	}
+	txs := make([]*common.L2Tx, len(txsData))
+	for i, txData := range txsData {
+		txs[i], err = types.SignNewTx(privateKey, signer, txData)
+		require.NoError(t, err)
+	}
+
+	// the transactions are split across batches, the same way CreateExtRollup does it
+	transactionsPerBatch := [][]*common.L2Tx{txs[:2], txs[2:]}
+	payload, err := rc.serialiseCompressAndEncrypt(transactionsPerBatch)
+	require.NoError(t, err)
+
+	decodedTransactionsPerBatch := make([][]*common.L2Tx, 0)
+	require.NoError(t, rc.decryptDecompressAndDeserialise(payload, &decodedTransactionsPerBatch))
+	require.Len(t, decodedTransactionsPerBatch, len(transactionsPerBatch))
+
+	for i, batchTxs := range transactionsPerBatch {
+		require.Len(t, decodedTransactionsPerBatch[i], len(batchTxs))
+		for j, tx := range batchTxs {
+			decodedTx := decodedTransactionsPerBatch[i][j]
+			assert.Equal(t, tx.Hash(), decodedTx.Hash())
+			assert.Equal(t, tx.Type(), decodedTx.Type())
+			assert.Equal(t, tx.AccessList(), decodedTx.AccessList())
+			assert.Equal(t, tx.GasFeeCap(), decodedTx.GasFeeCap())
+			assert.Equal(t, tx.GasTipCap(), decodedTx.GasTipCap())
+
+			// the signature must still be valid, so the sender can be recovered when the batches are re-executed
+			sender, err := types.Sender(signer, decodedTx)
+			require.NoError(t, err)
+			assert.Equal(t, gethcrypto.PubkeyToAddress(privateKey.PublicKey), sender)
+		}
+	}
+}
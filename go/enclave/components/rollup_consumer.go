@@ -7,6 +7,7 @@ import (
 
 	"github.com/obscuronet/go-obscuro/go/common/measure"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/obscuronet/go-obscuro/go/common"
 	"github.com/obscuronet/go-obscuro/go/common/log"
@@ -66,6 +67,11 @@ func (rc *rollupConsumerImpl) ProcessRollupsInBlock(b *common.BlockAndReceipts)
 				// todo - issue challenge as a validator
 				return err
 			}
+			if err := rc.verifyCrossChainRoot(rollup, internalHeader); err != nil {
+				rc.logger.Error("Invalid cross chain root", log.RollupHashKey, rollup.Hash(), log.ErrKey, err)
+				// todo - issue challenge as a validator
+				return err
+			}
 			if err := rc.storage.StoreRollup(rollup, internalHeader); err != nil {
 				rc.logger.Error("Failed storing rollup", log.RollupHashKey, rollup.Hash(), log.ErrKey, err)
 				return err
@@ -75,6 +81,23 @@ func (rc *rollupConsumerImpl) ProcessRollupsInBlock(b *common.BlockAndReceipts)
 	return nil
 }
 
+// verifyCrossChainRoot checks that the cross chain root of the rollup, which the management contract publishes to the
+// L1 message bus, commits to the outbound messages of the batches recreated from the rollup
+func (rc *rollupConsumerImpl) verifyCrossChainRoot(rollup *common.ExtRollup, internalHeader *common.CalldataRollupHeader) error {
+	batchRoots := make([]gethcommon.Hash, 0)
+	for seqNo := internalHeader.FirstBatchSequence.Uint64(); seqNo <= rollup.Header.LastBatchSeqNo; seqNo++ {
+		batch, err := rc.storage.FetchBatchBySeqNo(seqNo)
+		if err != nil {
+			return fmt.Errorf("could not retrieve batch %d of the rollup. Cause: %w", seqNo, err)
+		}
+		batchRoots = append(batchRoots, batch.Header.CrossChainRoot)
+	}
+	if root := common.BatchRootsRoot(batchRoots); root != rollup.Header.CrossChainRoot {
+		return fmt.Errorf("the cross chain root of the rollup is %s, but its batches have root %s", rollup.Header.CrossChainRoot, root)
+	}
+	return nil
+}
+
 func (rc *rollupConsumerImpl) getSignedRollup(rollups []*common.ExtRollup) ([]*common.ExtRollup, error) {
 	signedRollup := make([]*common.ExtRollup, 0)
 
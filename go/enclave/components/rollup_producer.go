@@ -10,8 +10,6 @@ import (
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 
-	"github.com/obscuronet/go-obscuro/contracts/generated/MessageBus"
-
 	"github.com/obscuronet/go-obscuro/go/common"
 	"github.com/obscuronet/go-obscuro/go/enclave/crypto"
 	"github.com/obscuronet/go-obscuro/go/enclave/limiters"
@@ -84,10 +82,11 @@ func (re *rollupProducerImpl) createNextRollup(batches []*core.Batch, block *typ
 	rh.CompressionL1Head = block.Hash()
 	rh.Coinbase = re.sequencerID
 
-	rh.CrossChainMessages = make([]MessageBus.StructsCrossChainMessage, 0)
-	for _, b := range batches {
-		rh.CrossChainMessages = append(rh.CrossChainMessages, b.Header.CrossChainMessages...)
+	batchRoots := make([]gethcommon.Hash, len(batches))
+	for i, b := range batches {
+		batchRoots[i] = b.Header.CrossChainRoot
 	}
+	rh.CrossChainRoot = common.BatchRootsRoot(batchRoots)
 
 	rh.LastBatchSeqNo = lastBatch.SeqNo().Uint64()
 	return &core.Rollup{
@@ -15,29 +15,37 @@ import (
 
 // EnclaveConfigToml is the structure that an enclave's .toml config is parsed into.
 type EnclaveConfigToml struct {
-	HostID                    string
-	HostAddress               string
-	Address                   string
-	NodeType                  string
-	L1ChainID                 int64
-	ObscuroChainID            int64
-	WillAttest                bool
-	ValidateL1Blocks          bool
-	ManagementContractAddress string
-	LogLevel                  int
-	LogPath                   string
-	UseInMemoryDB             bool
-	GenesisJSON               string
-	EdgelessDBHost            string
-	SqliteDBPath              string
-	ProfilerEnabled           bool
-	MinGasPrice               int64
-	MessageBusAddress         string
-	SequencerID               string
-	ObscuroGenesis            string
-	DebugNamespaceEnabled     bool
-	MaxBatchSize              uint64
-	MaxRollupSize             uint64
+	HostID                          string
+	HostAddress                     string
+	Address                         string
+	NodeType                        string
+	L1ChainID                       int64
+	ObscuroChainID                  int64
+	WillAttest                      bool
+	ManagementContractAddress       string
+	LogLevel                        int
+	LogPath                         string
+	UseInMemoryDB                   bool
+	EdgelessDBHost                  string
+	SqliteDBPath                    string
+	ProfilerEnabled                 bool
+	MinGasPrice                     int64
+	MessageBusAddress               string
+	SequencerID                     string
+	ObscuroGenesis                  string
+	DebugNamespaceEnabled           bool
+	MaxBatchSize                    uint64
+	MaxRollupSize                   uint64
+	TargetGasPerBatch               uint64
+	ElasticityMultiplier            uint64
+	MaxSyntheticGasPerBatch         uint64
+	SequencerFeeRecipient           string
+	AllowLegacyViewingKeySignatures bool
+	ForcedTxInclusionWindow         uint64
+	L1StartHash                     string
+	L1MaxReorgDepth                 uint64
+	L1BridgeAddress                 string
+	L2BridgeAddress                 string
 }
 
 // ParseConfig returns a config.EnclaveConfig based on either the file identified by the `config` flag, or the flags
@@ -54,7 +62,6 @@ func ParseConfig() (*config.EnclaveConfig, error) {
 	l1ChainID := flag.Int64(l1ChainIDName, cfg.L1ChainID, flagUsageMap[l1ChainIDName])
 	obscuroChainID := flag.Int64(obscuroChainIDName, cfg.ObscuroChainID, flagUsageMap[obscuroChainIDName])
 	willAttest := flag.Bool(willAttestName, cfg.WillAttest, flagUsageMap[willAttestName])
-	validateL1Blocks := flag.Bool(validateL1BlocksName, cfg.ValidateL1Blocks, flagUsageMap[validateL1BlocksName])
 	managementContractAddress := flag.String(ManagementContractAddressName, cfg.ManagementContractAddress.Hex(), flagUsageMap[ManagementContractAddressName])
 	loglevel := flag.Int(logLevelName, cfg.LogLevel, flagUsageMap[logLevelName])
 	logPath := flag.String(logPathName, cfg.LogPath, flagUsageMap[logPathName])
@@ -69,6 +76,16 @@ func ParseConfig() (*config.EnclaveConfig, error) {
 	debugNamespaceEnabled := flag.Bool(debugNamespaceEnabledName, cfg.DebugNamespaceEnabled, flagUsageMap[debugNamespaceEnabledName])
 	maxBatchSize := flag.Uint64(maxBatchSizeName, cfg.MaxBatchSize, flagUsageMap[maxBatchSizeName])
 	maxRollupSize := flag.Uint64(maxRollupSizeName, cfg.MaxRollupSize, flagUsageMap[maxRollupSizeName])
+	targetGasPerBatch := flag.Uint64(targetGasPerBatchName, cfg.TargetGasPerBatch, flagUsageMap[targetGasPerBatchName])
+	elasticityMultiplier := flag.Uint64(elasticityMultiplierName, cfg.ElasticityMultiplier, flagUsageMap[elasticityMultiplierName])
+	maxSyntheticGasPerBatch := flag.Uint64(maxSyntheticGasPerBatchName, cfg.MaxSyntheticGasPerBatch, flagUsageMap[maxSyntheticGasPerBatchName])
+	sequencerFeeRecipient := flag.String(sequencerFeeRecipientName, cfg.SequencerFeeRecipient.Hex(), flagUsageMap[sequencerFeeRecipientName])
+	allowLegacyViewingKeySignatures := flag.Bool(allowLegacyViewingKeySignaturesName, cfg.AllowLegacyViewingKeySignatures, flagUsageMap[allowLegacyViewingKeySignaturesName])
+	forcedTxInclusionWindow := flag.Uint64(forcedTxInclusionWindowName, cfg.ForcedTxInclusionWindow, flagUsageMap[forcedTxInclusionWindowName])
+	l1StartHash := flag.String(l1StartHashName, cfg.L1StartHash.Hex(), flagUsageMap[l1StartHashName])
+	l1MaxReorgDepth := flag.Uint64(l1MaxReorgDepthName, cfg.L1MaxReorgDepth, flagUsageMap[l1MaxReorgDepthName])
+	l1BridgeAddress := flag.String(l1BridgeAddressName, cfg.L1BridgeAddress.Hex(), flagUsageMap[l1BridgeAddressName])
+	l2BridgeAddress := flag.String(l2BridgeAddressName, cfg.L2BridgeAddress.Hex(), flagUsageMap[l2BridgeAddressName])
 
 	flag.Parse()
 
@@ -88,7 +105,6 @@ func ParseConfig() (*config.EnclaveConfig, error) {
 	cfg.L1ChainID = *l1ChainID
 	cfg.ObscuroChainID = *obscuroChainID
 	cfg.WillAttest = *willAttest
-	cfg.ValidateL1Blocks = *validateL1Blocks
 	cfg.ManagementContractAddress = gethcommon.HexToAddress(*managementContractAddress)
 	cfg.LogLevel = *loglevel
 	cfg.LogPath = *logPath
@@ -103,6 +119,16 @@ func ParseConfig() (*config.EnclaveConfig, error) {
 	cfg.DebugNamespaceEnabled = *debugNamespaceEnabled
 	cfg.MaxBatchSize = *maxBatchSize
 	cfg.MaxRollupSize = *maxRollupSize
+	cfg.TargetGasPerBatch = *targetGasPerBatch
+	cfg.ElasticityMultiplier = *elasticityMultiplier
+	cfg.MaxSyntheticGasPerBatch = *maxSyntheticGasPerBatch
+	cfg.SequencerFeeRecipient = gethcommon.HexToAddress(*sequencerFeeRecipient)
+	cfg.AllowLegacyViewingKeySignatures = *allowLegacyViewingKeySignatures
+	cfg.ForcedTxInclusionWindow = *forcedTxInclusionWindow
+	cfg.L1StartHash = gethcommon.HexToHash(*l1StartHash)
+	cfg.L1MaxReorgDepth = *l1MaxReorgDepth
+	cfg.L1BridgeAddress = gethcommon.HexToAddress(*l1BridgeAddress)
+	cfg.L2BridgeAddress = gethcommon.HexToAddress(*l2BridgeAddress)
 
 	return cfg, nil
 }
@@ -126,21 +152,29 @@ func fileBasedConfig(configPath string) (*config.EnclaveConfig, error) {
 	}
 
 	return &config.EnclaveConfig{
-		HostID:                    gethcommon.HexToAddress(tomlConfig.HostID),
-		HostAddress:               tomlConfig.HostAddress,
-		Address:                   tomlConfig.Address,
-		NodeType:                  nodeType,
-		L1ChainID:                 tomlConfig.L1ChainID,
-		ObscuroChainID:            tomlConfig.ObscuroChainID,
-		WillAttest:                tomlConfig.WillAttest,
-		ValidateL1Blocks:          tomlConfig.ValidateL1Blocks,
-		ManagementContractAddress: gethcommon.HexToAddress(tomlConfig.ManagementContractAddress),
-		LogLevel:                  tomlConfig.LogLevel,
-		LogPath:                   tomlConfig.LogPath,
-		UseInMemoryDB:             tomlConfig.UseInMemoryDB,
-		GenesisJSON:               []byte(tomlConfig.GenesisJSON),
-		EdgelessDBHost:            tomlConfig.EdgelessDBHost,
-		SqliteDBPath:              tomlConfig.SqliteDBPath,
-		ProfilerEnabled:           tomlConfig.ProfilerEnabled,
+		HostID:                          gethcommon.HexToAddress(tomlConfig.HostID),
+		HostAddress:                     tomlConfig.HostAddress,
+		Address:                         tomlConfig.Address,
+		NodeType:                        nodeType,
+		L1ChainID:                       tomlConfig.L1ChainID,
+		ObscuroChainID:                  tomlConfig.ObscuroChainID,
+		WillAttest:                      tomlConfig.WillAttest,
+		ManagementContractAddress:       gethcommon.HexToAddress(tomlConfig.ManagementContractAddress),
+		LogLevel:                        tomlConfig.LogLevel,
+		LogPath:                         tomlConfig.LogPath,
+		UseInMemoryDB:                   tomlConfig.UseInMemoryDB,
+		EdgelessDBHost:                  tomlConfig.EdgelessDBHost,
+		SqliteDBPath:                    tomlConfig.SqliteDBPath,
+		ProfilerEnabled:                 tomlConfig.ProfilerEnabled,
+		TargetGasPerBatch:               tomlConfig.TargetGasPerBatch,
+		ElasticityMultiplier:            tomlConfig.ElasticityMultiplier,
+		MaxSyntheticGasPerBatch:         tomlConfig.MaxSyntheticGasPerBatch,
+		SequencerFeeRecipient:           gethcommon.HexToAddress(tomlConfig.SequencerFeeRecipient),
+		AllowLegacyViewingKeySignatures: tomlConfig.AllowLegacyViewingKeySignatures,
+		ForcedTxInclusionWindow:         tomlConfig.ForcedTxInclusionWindow,
+		L1StartHash:                     gethcommon.HexToHash(tomlConfig.L1StartHash),
+		L1MaxReorgDepth:                 tomlConfig.L1MaxReorgDepth,
+		L1BridgeAddress:                 gethcommon.HexToAddress(tomlConfig.L1BridgeAddress),
+		L2BridgeAddress:                 gethcommon.HexToAddress(tomlConfig.L2BridgeAddress),
 	}, nil
 }
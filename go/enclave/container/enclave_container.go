@@ -16,9 +16,6 @@ import (
 	obscuroGenesis "github.com/obscuronet/go-obscuro/go/enclave/genesis"
 )
 
-// todo (#1056) - replace with the genesis.json of Obscuro's L1 network.
-const hardcodedGenesisJSON = "TODO - REPLACE ME"
-
 type EnclaveContainer struct {
 	Enclave   common.Enclave
 	RPCServer *enclave.RPCServer
@@ -61,10 +58,6 @@ func NewEnclaveContainerWithLogger(config *config.EnclaveConfig, logger gethlog.
 	contractAddr := config.ManagementContractAddress
 	mgmtContractLib := mgmtcontractlib.NewMgmtContractLib(&contractAddr, logger)
 
-	if config.ValidateL1Blocks {
-		config.GenesisJSON = []byte(hardcodedGenesisJSON)
-	}
-
 	genesis, err := obscuroGenesis.New(config.ObscuroGenesis)
 	if err != nil {
 		logger.Crit("unable to parse obscuro genesis", log.ErrKey, err)
@@ -105,6 +105,8 @@ func DeterministicEmptyBatch(
 	block *types.Block,
 	time uint64,
 	sequencerNo *big.Int,
+	gasLimit uint64,
+	baseFee *big.Int,
 ) *Batch {
 	h := common.BatchHeader{
 		ParentHash:       parent.Hash(),
@@ -112,7 +114,9 @@ func DeterministicEmptyBatch(
 		Number:           big.NewInt(0).Add(parent.Number, big.NewInt(1)),
 		SequencerOrderNo: sequencerNo,
 		// todo (#1548) - Consider how this time should align with the time of the L1 block used as proof.
-		Time: time,
+		Time:     time,
+		GasLimit: gasLimit,
+		BaseFee:  baseFee,
 	}
 	b := Batch{
 		Header: &h,
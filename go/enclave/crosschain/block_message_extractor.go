@@ -10,11 +10,13 @@ import (
 	"github.com/obscuronet/go-obscuro/go/common"
 	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/common/measure"
+	"github.com/obscuronet/go-obscuro/go/enclave/rpc"
 )
 
 type blockMessageExtractor struct {
 	busAddress   *common.L1Address
 	l2MessageBus *common.L2Address // todo (@stefan) - remove this
+	bridge       BridgeWatcher
 	storage      storage.Storage
 	logger       gethlog.Logger
 }
@@ -22,12 +24,14 @@ type blockMessageExtractor struct {
 func NewBlockMessageExtractor(
 	busAddress *common.L1Address,
 	l2BusAddress *common.L2Address,
+	bridge BridgeWatcher,
 	storage storage.Storage,
 	logger gethlog.Logger,
 ) BlockMessageExtractor {
 	return &blockMessageExtractor{
 		busAddress:   busAddress,
 		l2MessageBus: l2BusAddress,
+		bridge:       bridge,
 		storage:      storage,
 		logger:       logger.New(log.CmpKey, log.CrossChainCmp),
 	}
@@ -64,6 +68,9 @@ func (m *blockMessageExtractor) StoreCrossChainMessages(block *common.L1Block, r
 			m.logger.Crit("Unable to store the messages", log.ErrKey, err)
 			return err
 		}
+		if err = m.bridge.StoreDeposits(block.Hash(), messages); err != nil {
+			return fmt.Errorf("could not store the bridge deposits. Cause: %w", err)
+		}
 	}
 
 	return nil
@@ -74,27 +81,55 @@ func (m *blockMessageExtractor) GetBusAddress() *common.L1Address {
 	return m.busAddress
 }
 
-// getCrossChainMessages - Converts the relevant logs from the appropriate message bus address to synthetic transactions and returns them
-func (m *blockMessageExtractor) getCrossChainMessages(block *common.L1Block, receipts common.L1Receipts) (common.CrossChainMessages, error) {
+// getCrossChainMessages - Converts the relevant logs from the appropriate message bus address to messages, together with
+// the L1 transactions which published them, and returns them
+func (m *blockMessageExtractor) getCrossChainMessages(block *common.L1Block, receipts common.L1Receipts) ([]common.L1Message, error) {
 	if len(receipts) == 0 {
-		return make(common.CrossChainMessages, 0), nil
+		return make([]common.L1Message, 0), nil
 	}
 
 	// Retrieves the relevant logs from the message bus.
 	logs, err := filterLogsFromReceipts(receipts, m.GetBusAddress(), &CrossChainEventID)
 	if err != nil {
 		m.logger.Error("Error encountered when filtering receipt logs.", log.ErrKey, err)
-		return make(common.CrossChainMessages, 0), err
+		return make([]common.L1Message, 0), err
 	}
 	m.logger.Trace("Extracted cross chain logs from receipts", "logCount", len(logs))
 
 	messages, err := convertLogsToMessages(logs, CrossChainEventName, MessageBusABI)
 	if err != nil {
 		m.logger.Error("Error encountered converting the extracted relevant logs to messages", log.ErrKey, err)
-		return make(common.CrossChainMessages, 0), err
+		return make([]common.L1Message, 0), err
+	}
+
+	// the messages are converted in the order of the logs
+	l1Messages := make([]common.L1Message, 0, len(messages))
+	for i, msg := range messages {
+		l1Messages = append(l1Messages, common.L1Message{
+			Message:  msg,
+			TxHash:   logs[i].TxHash,
+			TxSender: m.txSender(block, logs[i].TxHash),
+		})
 	}
 
 	m.logger.Trace(fmt.Sprintf("Found %d cross chain messages that will be submitted to L2!", len(messages)), log.BlockHashKey, block.Hash())
 
-	return messages, nil
+	return l1Messages, nil
+}
+
+// txSender - Returns the sender of the transaction of the block, which is the only account allowed to query the status
+// of the messages it published. The zero address is returned if the sender can't be recovered, so the messages are
+// still delivered.
+func (m *blockMessageExtractor) txSender(block *common.L1Block, txHash common.TxHash) common.L1Address {
+	tx := block.Transaction(txHash)
+	if tx == nil {
+		m.logger.Warn("Transaction of cross chain message not found in block", log.TxKey, txHash, log.BlockHashKey, block.Hash())
+		return common.L1Address{}
+	}
+	sender, err := rpc.GetSender(tx)
+	if err != nil {
+		m.logger.Warn("Could not recover the sender of cross chain message transaction", log.TxKey, txHash, log.ErrKey, err)
+		return common.L1Address{}
+	}
+	return sender
 }
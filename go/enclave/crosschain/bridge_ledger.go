@@ -0,0 +1,100 @@
+package crosschain
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/obscuronet/go-obscuro/go/common"
+)
+
+// bridgeLedger - the balances of the bridged tokens over a chain of L1 blocks and batches, updated as the transfers of
+// each block and batch are added
+type bridgeLedger struct {
+	l1Head        common.L1BlockHash // The last block whose deposits were added
+	l2Head        common.L2BatchHash // The last batch whose transfers were added
+	balances      map[common.L1Address]*common.BridgeTokenBalance
+	wrappedTokens map[common.L2Address]common.L1Address // The L1 token represented by each wrapped token
+}
+
+func newBridgeLedger() *bridgeLedger {
+	return &bridgeLedger{
+		balances:      make(map[common.L1Address]*common.BridgeTokenBalance),
+		wrappedTokens: make(map[common.L2Address]common.L1Address),
+	}
+}
+
+func (l *bridgeLedger) addWrappedTokens(tokens []common.WrappedToken) {
+	for _, token := range tokens {
+		wrappedToken := token.WrappedToken
+		l.wrappedTokens[wrappedToken] = token.Token
+		l.balanceOf(token.Token).WrappedToken = &wrappedToken
+	}
+}
+
+// addTransfers updates the balances with the transfers, and returns the L1 tokens whose balance changed. The mints and
+// burns of a wrapped token are counted against the L1 token it represents.
+func (l *bridgeLedger) addTransfers(transfers []common.BridgeTransfer) ([]common.L1Address, error) {
+	touched := make([]common.L1Address, 0)
+	seen := make(map[common.L1Address]bool)
+	for _, transfer := range transfers {
+		token, amount := transfer.Token, new(big.Int).Set(transfer.Amount)
+		switch transfer.Kind {
+		case common.BridgeDeposit:
+			locked := l.balanceOf(token).Locked.ToInt()
+			locked.Add(locked, amount)
+		case common.BridgeWithdrawal:
+			locked := l.balanceOf(token).Locked.ToInt()
+			locked.Sub(locked, amount)
+		case common.BridgeMint, common.BridgeBurn:
+			l1Token, found := l.wrappedTokens[transfer.Token]
+			if !found {
+				// only the transfers of the wrapped tokens are recorded, which are created by an ancestor batch
+				return nil, fmt.Errorf("transfer of token %s which is not a canonical wrapped token", transfer.Token)
+			}
+			if transfer.Kind == common.BridgeBurn {
+				amount.Neg(amount)
+			}
+			token = l1Token
+			minted := l.balanceOf(token).Minted.ToInt()
+			minted.Add(minted, amount)
+		default:
+			return nil, fmt.Errorf("unknown bridge transfer kind %d", transfer.Kind)
+		}
+		if !seen[token] {
+			seen[token] = true
+			touched = append(touched, token)
+		}
+	}
+	return touched, nil
+}
+
+// snapshot returns a copy of the balances, sorted by token address
+func (l *bridgeLedger) snapshot() []common.BridgeTokenBalance {
+	result := make([]common.BridgeTokenBalance, 0, len(l.balances))
+	for _, balance := range l.balances {
+		result = append(result, copyBalance(balance))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return bytes.Compare(result[i].Token.Bytes(), result[j].Token.Bytes()) < 0
+	})
+	return result
+}
+
+func (l *bridgeLedger) balanceOf(token common.L1Address) *common.BridgeTokenBalance {
+	if _, found := l.balances[token]; !found {
+		l.balances[token] = &common.BridgeTokenBalance{Token: token, Locked: (*hexutil.Big)(big.NewInt(0)), Minted: (*hexutil.Big)(big.NewInt(0))}
+	}
+	return l.balances[token]
+}
+
+func copyBalance(balance *common.BridgeTokenBalance) common.BridgeTokenBalance {
+	return common.BridgeTokenBalance{
+		Token:        balance.Token,
+		WrappedToken: balance.WrappedToken,
+		Locked:       (*hexutil.Big)(new(big.Int).Set(balance.Locked.ToInt())),
+		Minted:       (*hexutil.Big)(new(big.Int).Set(balance.Minted.ToInt())),
+	}
+}
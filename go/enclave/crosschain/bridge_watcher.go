@@ -0,0 +1,317 @@
+package crosschain
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/go/enclave/storage"
+	"github.com/obscuronet/go-obscuro/go/ethadapter/bridgelib"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/enclave/rpc"
+)
+
+type bridgeWatcher struct {
+	l1BridgeAddress *common.L1Address
+	l2BridgeAddress *common.L2Address
+	l2MessageBus    *common.L2Address
+	bridgeLib       bridgelib.BridgeLib
+	storage         storage.Storage
+	logger          gethlog.Logger
+
+	// the running ledger of the canonical chains, so that each batch only adds its own transfers. It is nil until
+	// loaded from the stored transfers, and after a reorg.
+	ledger      *bridgeLedger
+	ledgerMutex sync.Mutex
+}
+
+func NewBridgeWatcher(
+	l1BridgeAddress *common.L1Address,
+	l2BridgeAddress *common.L2Address,
+	l2BusAddress *common.L2Address,
+	storage storage.Storage,
+	logger gethlog.Logger,
+) BridgeWatcher {
+	return &bridgeWatcher{
+		l1BridgeAddress: l1BridgeAddress,
+		l2BridgeAddress: l2BridgeAddress,
+		l2MessageBus:    l2BusAddress,
+		bridgeLib:       bridgelib.NewBridgeLib(),
+		storage:         storage,
+		logger:          logger.New(log.CmpKey, log.CrossChainCmp),
+	}
+}
+
+func (w *bridgeWatcher) Enabled() bool {
+	return w.l1BridgeEnabled() || w.l2BridgeEnabled()
+}
+
+// StoreDeposits - decodes the transfers requested by the messages the L1 bridge published in the block. The sender of
+// a deposit is the sender of the L1 transaction.
+func (w *bridgeWatcher) StoreDeposits(blockHash common.L1BlockHash, messages []common.L1Message) error {
+	if !w.l1BridgeEnabled() {
+		return nil
+	}
+
+	deposits := make([]common.BridgeTransfer, 0)
+	for _, msg := range messages {
+		if msg.Message.Sender != *w.l1BridgeAddress {
+			continue
+		}
+		deposit, err := w.bridgeLib.DecodeTransfer(common.BridgeDeposit, msg.Message)
+		if err != nil {
+			// the message is delivered regardless, so it is only left out of the ledger
+			w.logger.Warn("Could not decode the payload of a message of the L1 bridge", log.TxKey, msg.TxHash, log.ErrKey, err)
+			continue
+		}
+		if deposit == nil {
+			continue
+		}
+		deposit.Sender = msg.TxSender
+		deposits = append(deposits, *deposit)
+	}
+
+	if len(deposits) > 0 {
+		w.logger.Info(fmt.Sprintf("Storing %d bridge deposits for block", len(deposits)), log.BlockHashKey, blockHash)
+	}
+	return w.storage.StoreBridgeTransfers(blockHash, deposits)
+}
+
+// ExtractBatchTransfers - decodes the withdrawals requested through the messages the L2 bridge published, the mints and
+// burns of the wrapped tokens and the wrapped tokens created by the L2 bridge, in the order of the receipts. Only the
+// transfers of the wrapped tokens created by the canonical batches or by these receipts are recorded.
+func (w *bridgeWatcher) ExtractBatchTransfers(transactions common.L2Transactions, receipts common.L2Receipts) ([]common.BridgeTransfer, []common.WrappedToken, error) {
+	if !w.l2BridgeEnabled() {
+		return nil, nil, nil
+	}
+
+	w.ledgerMutex.Lock()
+	defer w.ledgerMutex.Unlock()
+	ledger, err := w.runningLedger()
+	if err != nil {
+		return nil, nil, err
+	}
+	senders := make(map[common.L2TxHash]common.L2Address, len(transactions))
+	for _, tx := range transactions {
+		if sender, err := rpc.GetSender(tx); err == nil {
+			senders[tx.Hash()] = sender
+		}
+	}
+
+	transfers := make([]common.BridgeTransfer, 0)
+	wrappedTokens := make([]common.WrappedToken, 0)
+	createdTokens := make(map[common.L2Address]bool)
+	for _, receipt := range receipts {
+		if receipt.Status == types.ReceiptStatusFailed {
+			continue
+		}
+		for _, l := range receipt.Logs {
+			if len(l.Topics) == 0 {
+				continue
+			}
+			switch {
+			case l.Address == *w.l2BridgeAddress && l.Topics[0] == w.bridgeLib.CreatedWrappedTokenTopic():
+				wrappedToken, err := w.bridgeLib.DecodeCreatedWrappedToken(*l)
+				if err != nil {
+					return nil, nil, err
+				}
+				createdTokens[wrappedToken.WrappedToken] = true
+				wrappedTokens = append(wrappedTokens, *wrappedToken)
+
+			case l.Topics[0] == w.bridgeLib.TokenTransferTopic():
+				if _, found := ledger.wrappedTokens[l.Address]; !found && !createdTokens[l.Address] {
+					continue
+				}
+				transfer, err := w.bridgeLib.DecodeTokenTransfer(*l)
+				if err != nil {
+					return nil, nil, err
+				}
+				if transfer != nil {
+					transfers = append(transfers, *transfer)
+				}
+
+			case l.Address == *w.l2MessageBus && l.Topics[0] == CrossChainEventID:
+				messages, err := convertLogsToMessages([]types.Log{*l}, CrossChainEventName, MessageBusABI)
+				if err != nil {
+					return nil, nil, err
+				}
+				if messages[0].Sender != *w.l2BridgeAddress {
+					continue
+				}
+				withdrawal, err := w.bridgeLib.DecodeTransfer(common.BridgeWithdrawal, messages[0])
+				if err != nil {
+					return nil, nil, err
+				}
+				if withdrawal != nil {
+					withdrawal.Sender = senders[receipt.TxHash]
+					transfers = append(transfers, *withdrawal)
+				}
+			}
+		}
+	}
+	return transfers, wrappedTokens, nil
+}
+
+func (w *bridgeWatcher) OnBlockProcessed(block *common.L1Block) {
+	if !w.Enabled() {
+		return
+	}
+
+	w.ledgerMutex.Lock()
+	defer w.ledgerMutex.Unlock()
+	if w.ledger == nil {
+		return
+	}
+	if block.ParentHash() != w.ledger.l1Head {
+		// a reorg changes which blocks and batches are canonical
+		w.ledger = nil
+		return
+	}
+	deposits, err := w.storage.GetBridgeTransfers(block.Hash())
+	if err != nil {
+		w.logger.Warn("Could not retrieve the bridge deposits of the block", log.BlockHashKey, block.Hash(), log.ErrKey, err)
+		w.ledger = nil
+		return
+	}
+	if _, err = w.ledger.addTransfers(deposits); err != nil {
+		w.logger.Warn("Could not add the bridge deposits of the block to the ledger", log.BlockHashKey, block.Hash(), log.ErrKey, err)
+		w.ledger = nil
+		return
+	}
+	w.ledger.l1Head = block.Hash()
+}
+
+// OnBatchExecuted - the wrapped tokens are only minted for the assets locked in the L1 bridge, so their supply can never
+// exceed the locked balance, unless the bridge or the enclave are compromised
+func (w *bridgeWatcher) OnBatchExecuted(batchHash common.L2BatchHash, parentHash common.L2BatchHash) ([]common.BridgeTokenBalance, error) {
+	if !w.Enabled() {
+		return nil, nil
+	}
+
+	w.ledgerMutex.Lock()
+	defer w.ledgerMutex.Unlock()
+	if w.ledger != nil && w.ledger.l2Head == batchHash {
+		return nil, nil
+	}
+	if w.ledger == nil || w.ledger.l2Head != parentHash {
+		// the transfers of the batch are already stored, so they are part of the rebuilt ledger
+		w.ledger = nil
+		ledger, err := w.runningLedger()
+		if err != nil {
+			return nil, err
+		}
+		tokens := make([]common.L1Address, 0, len(ledger.balances))
+		for _, balance := range ledger.snapshot() {
+			tokens = append(tokens, balance.Token)
+		}
+		return w.checkInvariant(ledger, tokens), nil
+	}
+
+	wrappedTokens, err := w.storage.GetWrappedTokens(batchHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve the wrapped tokens of the batch. Cause: %w", err)
+	}
+	transfers, err := w.storage.GetBridgeTransfers(batchHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve the bridge transfers of the batch. Cause: %w", err)
+	}
+	w.ledger.addWrappedTokens(wrappedTokens)
+	touched, err := w.ledger.addTransfers(transfers)
+	if err != nil {
+		w.ledger = nil
+		return nil, fmt.Errorf("could not add the bridge transfers of the batch to the ledger. Cause: %w", err)
+	}
+	w.ledger.l2Head = batchHash
+	return w.checkInvariant(w.ledger, touched), nil
+}
+
+func (w *bridgeWatcher) GetLedger() ([]common.BridgeTokenBalance, error) {
+	w.ledgerMutex.Lock()
+	defer w.ledgerMutex.Unlock()
+	if w.ledger != nil {
+		return w.ledger.snapshot(), nil
+	}
+	// not kept as the running ledger, as the block or batch being processed may not be fully stored yet
+	ledger, err := w.loadLedger()
+	if err != nil {
+		return nil, fmt.Errorf("could not compute the bridge ledger. Cause: %w", err)
+	}
+	return ledger.snapshot(), nil
+}
+
+// checkInvariant alerts about the tokens whose supply exceeds the balance locked in the L1 bridge, and returns them
+func (w *bridgeWatcher) checkInvariant(ledger *bridgeLedger, tokens []common.L1Address) []common.BridgeTokenBalance {
+	var violations []common.BridgeTokenBalance
+	for _, token := range tokens {
+		balance := ledger.balances[token]
+		if !balance.SupplyExceedsLocked() {
+			continue
+		}
+		w.logger.Error("Supply of the wrapped token exceeds the balance locked in the L1 bridge",
+			"token", balance.Token, "wrappedToken", balance.WrappedToken, "locked", balance.Locked, "minted", balance.Minted)
+		violations = append(violations, copyBalance(balance))
+	}
+	return violations
+}
+
+// runningLedger returns the running ledger, loading it from the stored transfers of the canonical chains if needed.
+// The caller must hold the ledger mutex.
+func (w *bridgeWatcher) runningLedger() (*bridgeLedger, error) {
+	if w.ledger != nil {
+		return w.ledger, nil
+	}
+
+	ledger, err := w.loadLedger()
+	if err != nil {
+		return nil, fmt.Errorf("could not load the bridge ledger. Cause: %w", err)
+	}
+	headBlock, err := w.storage.FetchHeadBlock()
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return nil, fmt.Errorf("could not retrieve the head block. Cause: %w", err)
+	}
+	if headBlock != nil {
+		ledger.l1Head = headBlock.Hash()
+	}
+	headBatch, err := w.storage.FetchHeadBatch()
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return nil, fmt.Errorf("could not retrieve the head batch. Cause: %w", err)
+	}
+	if headBatch != nil {
+		ledger.l2Head = headBatch.Hash()
+	}
+	w.ledger = ledger
+	return ledger, nil
+}
+
+// loadLedger sums the stored transfers of the canonical blocks and batches
+func (w *bridgeWatcher) loadLedger() (*bridgeLedger, error) {
+	wrappedTokens, err := w.storage.GetCanonicalWrappedTokens()
+	if err != nil {
+		return nil, err
+	}
+	transfers, err := w.storage.GetCanonicalBridgeTransfers()
+	if err != nil {
+		return nil, err
+	}
+
+	ledger := newBridgeLedger()
+	ledger.addWrappedTokens(wrappedTokens)
+	if _, err = ledger.addTransfers(transfers); err != nil {
+		return nil, err
+	}
+	return ledger, nil
+}
+
+func (w *bridgeWatcher) l1BridgeEnabled() bool {
+	return w.l1BridgeAddress != nil && *w.l1BridgeAddress != (gethcommon.Address{})
+}
+
+func (w *bridgeWatcher) l2BridgeEnabled() bool {
+	return w.l2BridgeAddress != nil && *w.l2BridgeAddress != (gethcommon.Address{})
+}
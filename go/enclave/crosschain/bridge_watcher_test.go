@@ -0,0 +1,229 @@
+package crosschain
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/enclave/core"
+	"github.com/obscuronet/go-obscuro/go/enclave/storage"
+	"github.com/obscuronet/go-obscuro/go/enclave/storage/init/sqlite"
+	"github.com/obscuronet/go-obscuro/go/ethadapter/bridgelib"
+	"github.com/obscuronet/go-obscuro/integration/datagenerator"
+	"github.com/obscuronet/go-obscuro/integration/ethereummock"
+	"github.com/stretchr/testify/require"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+func TestBridgeWatcherKeepsLedger(t *testing.T) {
+	l1Bridge, l2Bridge, l2Bus := datagenerator.RandomAddress(), datagenerator.RandomAddress(), datagenerator.RandomAddress()
+	token, otherToken, receiver := datagenerator.RandomAddress(), datagenerator.RandomAddress(), datagenerator.RandomAddress()
+	db := newTestStorage(t)
+	watcher := NewBridgeWatcher(&l1Bridge, &l2Bridge, &l2Bus, db, testlog())
+
+	// the deposits are only recorded for the transfers of the L1 bridge
+	block := ethereummock.MockGenesisBlock
+	require.NoError(t, db.StoreBlock(block, nil))
+	depositor := datagenerator.RandomAddress()
+	require.NoError(t, watcher.StoreDeposits(block.Hash(), []common.L1Message{
+		{Message: bridgeMessage(t, l1Bridge, 0, receiveAssets(t, token, 100, receiver)), TxSender: depositor},
+		{Message: bridgeMessage(t, l1Bridge, 0, receiveAssets(t, otherToken, 2, receiver)), TxSender: depositor},
+		{Message: bridgeMessage(t, l1Bridge, 1, receiveAssets(t, token, 1000, receiver)), TxSender: depositor},
+		{Message: bridgeMessage(t, datagenerator.RandomAddress(), 0, receiveAssets(t, token, 1000, receiver)), TxSender: depositor},
+	}))
+	watcher.OnBlockProcessed(block)
+
+	// the L2 bridge wraps the token, mints 60 of it, then burns 10 of them to withdraw them
+	wrappedToken := datagenerator.RandomAddress()
+	receipts := common.L2Receipts{
+		{Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{
+			createdWrappedTokenLog(t, l2Bridge, token, wrappedToken),
+			transferLog(t, wrappedToken, gethcommon.Address{}, receiver, 60),
+			transferLog(t, datagenerator.RandomAddress(), gethcommon.Address{}, receiver, 1000),
+		}},
+		{Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{
+			transferLog(t, wrappedToken, receiver, gethcommon.Address{}, 10),
+			messagePublishedLog(t, l2Bus, l2Bridge, receiveAssets(t, token, 10, receiver)),
+		}},
+		{Status: types.ReceiptStatusFailed, Logs: []*types.Log{
+			transferLog(t, wrappedToken, gethcommon.Address{}, receiver, 1000),
+		}},
+	}
+	transfers, wrappedTokens, err := watcher.ExtractBatchTransfers(nil, receipts)
+	require.NoError(t, err)
+	require.Equal(t, []common.WrappedToken{{Token: token, WrappedToken: wrappedToken}}, wrappedTokens)
+	require.Len(t, transfers, 3)
+	require.Equal(t, []common.BridgeTransferKind{common.BridgeMint, common.BridgeBurn, common.BridgeWithdrawal},
+		[]common.BridgeTransferKind{transfers[0].Kind, transfers[1].Kind, transfers[2].Kind})
+
+	batch := storeBatch(t, db, block, gethcommon.Hash{}, 0, wrappedTokens, transfers)
+	violations, err := watcher.OnBatchExecuted(batch.Hash(), batch.Header.ParentHash)
+	require.NoError(t, err)
+	require.Empty(t, violations)
+
+	// the running ledger matches the ledger summed from the stored transfers
+	expected := func(ledger []common.BridgeTokenBalance, locked int64, minted int64) {
+		require.Len(t, ledger, 2)
+		balances := map[common.L1Address]common.BridgeTokenBalance{}
+		for _, balance := range ledger {
+			balances[balance.Token] = balance
+		}
+		require.Equal(t, wrappedToken, *balances[token].WrappedToken)
+		require.Equal(t, big.NewInt(locked), balances[token].Locked.ToInt())
+		require.Equal(t, big.NewInt(minted), balances[token].Minted.ToInt())
+		require.Nil(t, balances[otherToken].WrappedToken)
+		require.Equal(t, big.NewInt(2), balances[otherToken].Locked.ToInt())
+	}
+	ledger, err := watcher.GetLedger()
+	require.NoError(t, err)
+	expected(ledger, 90, 50)
+	ledger, err = NewBridgeWatcher(&l1Bridge, &l2Bridge, &l2Bus, db, testlog()).GetLedger()
+	require.NoError(t, err)
+	expected(ledger, 90, 50)
+
+	// the deposits of the next block are added to the running ledger
+	nextBlock := types.NewBlockWithHeader(&types.Header{ParentHash: block.Hash(), Number: big.NewInt(1)})
+	require.NoError(t, db.StoreBlock(nextBlock, nil))
+	require.NoError(t, watcher.StoreDeposits(nextBlock.Hash(), []common.L1Message{
+		{Message: bridgeMessage(t, l1Bridge, 0, receiveAssets(t, token, 1, receiver)), TxSender: depositor},
+	}))
+	watcher.OnBlockProcessed(nextBlock)
+	ledger, err = watcher.GetLedger()
+	require.NoError(t, err)
+	expected(ledger, 91, 50)
+
+	// minting more than the L1 bridge holds breaks the invariant
+	transfers, _, err = watcher.ExtractBatchTransfers(nil, common.L2Receipts{
+		{Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{transferLog(t, wrappedToken, gethcommon.Address{}, receiver, 42)}},
+	})
+	require.NoError(t, err)
+	nextBatch := storeBatch(t, db, nextBlock, batch.Hash(), 1, nil, transfers)
+	violations, err = watcher.OnBatchExecuted(nextBatch.Hash(), nextBatch.Header.ParentHash)
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	require.Equal(t, token, violations[0].Token)
+	require.Equal(t, big.NewInt(92), violations[0].Minted.ToInt())
+
+	// after a reorg, the ledger is rebuilt from the transfers of the new canonical chains
+	forkBlock := types.NewBlockWithHeader(&types.Header{ParentHash: block.Hash(), Number: big.NewInt(1), Extra: []byte{1}})
+	ancestor, err := db.FetchBlock(block.Hash())
+	require.NoError(t, err)
+	require.NoError(t, db.StoreBlock(forkBlock, &common.ChainFork{
+		NewCanonical:     forkBlock,
+		OldCanonical:     nextBlock,
+		CommonAncestor:   ancestor,
+		CanonicalPath:    []common.L1BlockHash{forkBlock.Hash()},
+		NonCanonicalPath: []common.L1BlockHash{nextBlock.Hash()},
+	}))
+	watcher.OnBlockProcessed(forkBlock)
+	ledger, err = watcher.GetLedger()
+	require.NoError(t, err)
+	expected(ledger, 90, 50)
+}
+
+func TestBridgeWatcherIsDisabledWithoutBridges(t *testing.T) {
+	db := newTestStorage(t)
+	watcher := NewBridgeWatcher(&gethcommon.Address{}, &gethcommon.Address{}, &gethcommon.Address{}, db, testlog())
+	require.False(t, watcher.Enabled())
+
+	transfers, wrappedTokens, err := watcher.ExtractBatchTransfers(nil, common.L2Receipts{
+		{Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{
+			createdWrappedTokenLog(t, gethcommon.Address{}, datagenerator.RandomAddress(), datagenerator.RandomAddress()),
+		}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, transfers)
+	require.Empty(t, wrappedTokens)
+}
+
+// storeBatch stores a batch of the block with the bridge transfers it executed
+func storeBatch(t *testing.T, db storage.Storage, block *common.L1Block, parentHash common.L2BatchHash, number int64, wrappedTokens []common.WrappedToken, transfers []common.BridgeTransfer) *core.Batch {
+	batch := &core.Batch{
+		Header: &common.BatchHeader{
+			ParentHash:       parentHash,
+			L1Proof:          block.Hash(),
+			Number:           big.NewInt(number),
+			SequencerOrderNo: big.NewInt(number + 1),
+			Root:             types.EmptyRootHash,
+			ReceiptHash:      types.EmptyRootHash,
+		},
+	}
+	require.NoError(t, db.StoreBatch(batch))
+	require.NoError(t, db.StoreWrappedTokens(batch.Hash(), wrappedTokens))
+	require.NoError(t, db.StoreBridgeTransfers(batch.Hash(), transfers))
+	return batch
+}
+
+func newTestStorage(t *testing.T) storage.Storage {
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", testlog())
+	require.NoError(t, err)
+	return storage.NewStorage(backingDB, nil, testlog())
+}
+
+func testlog() gethlog.Logger {
+	return log.New(log.TestLogCmp, int(gethlog.LvlError), log.SysOut)
+}
+
+func bridgeMessage(t *testing.T, sender gethcommon.Address, topic uint32, data []byte) common.CrossChainMessage {
+	return common.CrossChainMessage{Sender: sender, Topic: topic, Payload: crossChainCall(t, data)}
+}
+
+// crossChainCall encodes the payload the bridges queue to have the data called on the other layer
+func crossChainCall(t *testing.T, data []byte) []byte {
+	callType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "target", Type: "address"},
+		{Name: "data", Type: "bytes"},
+		{Name: "gas", Type: "uint256"},
+	})
+	require.NoError(t, err)
+	payload, err := abi.Arguments{{Type: callType}}.Pack(struct {
+		Target gethcommon.Address
+		Data   []byte
+		Gas    *big.Int
+	}{datagenerator.RandomAddress(), data, big.NewInt(0)})
+	require.NoError(t, err)
+	return payload
+}
+
+func receiveAssets(t *testing.T, asset gethcommon.Address, amount int64, receiver gethcommon.Address) []byte {
+	data, err := parseABI(t, bridgelib.BridgeABI).Pack(bridgelib.ReceiveAssetsMethod, asset, big.NewInt(amount), receiver)
+	require.NoError(t, err)
+	return data
+}
+
+func createdWrappedTokenLog(t *testing.T, l2Bridge, token, wrappedToken gethcommon.Address) *types.Log {
+	event := parseABI(t, bridgelib.BridgeABI).Events[bridgelib.CreatedWrappedTokenEvent]
+	data, err := event.Inputs.NonIndexed().Pack(token, wrappedToken, "Wrapped", "WRP")
+	require.NoError(t, err)
+	return &types.Log{Address: l2Bridge, Topics: []gethcommon.Hash{event.ID}, Data: data}
+}
+
+func transferLog(t *testing.T, token, from, to gethcommon.Address, amount int64) *types.Log {
+	event := parseABI(t, bridgelib.WrappedTokenABI).Events[bridgelib.TransferEvent]
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(amount))
+	require.NoError(t, err)
+	return &types.Log{
+		Address: token,
+		Topics:  []gethcommon.Hash{event.ID, gethcommon.BytesToHash(from.Bytes()), gethcommon.BytesToHash(to.Bytes())},
+		Data:    data,
+	}
+}
+
+func messagePublishedLog(t *testing.T, bus, sender gethcommon.Address, data []byte) *types.Log {
+	event := MessageBusABI.Events[CrossChainEventName]
+	logData, err := event.Inputs.NonIndexed().Pack(sender, uint64(0), uint32(0), uint32(0), crossChainCall(t, data), uint8(0))
+	require.NoError(t, err)
+	return &types.Log{Address: bus, Topics: []gethcommon.Hash{CrossChainEventID}, Data: logData}
+}
+
+func parseABI(t *testing.T, contractABI string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(contractABI))
+	require.NoError(t, err)
+	return parsed
+}
@@ -144,5 +144,7 @@ func createCrossChainMessage(event MessageBus.MessageBusLogMessagePublished) Mes
 		Nonce:    event.Nonce,
 		Topic:    event.Topic,
 		Payload:  event.Payload,
+
+		ConsistencyLevel: event.ConsistencyLevel,
 	}
 }
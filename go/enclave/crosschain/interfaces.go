@@ -43,7 +43,42 @@ type Manager interface {
 	// ExtractOutboundMessages - Finds relevant logs in the receipts and converts them to cross chain messages.
 	ExtractOutboundMessages(receipts common.L2Receipts) (common.CrossChainMessages, error)
 
-	CreateSyntheticTransactions(messages common.CrossChainMessages, rollupState *state.StateDB) common.L2Transactions
+	// CreateSyntheticTransactions - Returns the transactions storing the inbound messages in the L2 message bus.
+	CreateSyntheticTransactions(messages common.CrossChainMessages, rollupState *state.StateDB) (common.L2Transactions, error)
 
-	RetrieveInboundMessages(fromBlock *common.L1Block, toBlock *common.L1Block, rollupState *state.StateDB) common.CrossChainMessages
+	// RetrieveInboundMessages - Returns the inbound messages that become deliverable when moving from the L1 block
+	// fromBlock to its descendant toBlock, in the order they were published.
+	RetrieveInboundMessages(fromBlock *common.L1Block, toBlock *common.L1Block, rollupState *state.StateDB) (common.CrossChainMessages, error)
+
+	// GetCrossChainProof - Returns the proof that the outbound message published by the log of the transaction is
+	// included in the cross chain root of the rollup which published its batch. Returns errutil.ErrNotFound if the
+	// log is not a message of the message bus, or if its batch has not been published in a rollup yet.
+	GetCrossChainProof(txHash common.L2TxHash, logIndex uint64) (*common.CrossChainProof, error)
+
+	// GetOutboundMessages - Returns the outbound messages published by the transactions of the sender in the canonical
+	// batches between the heights, in the order they were published.
+	GetOutboundMessages(sender common.L2Address, fromHeight uint64, toHeight uint64) ([]common.OutboundMessage, error)
+}
+
+type BridgeWatcher interface {
+	// Enabled - Returns true if the address of one of the bridges is configured, otherwise no ledger is kept
+	Enabled() bool
+
+	// StoreDeposits - Records the transfers requested through the L1 bridge by the inbound messages of the block
+	StoreDeposits(blockHash common.L1BlockHash, messages []common.L1Message) error
+
+	// ExtractBatchTransfers - Returns the withdrawals requested through the L2 bridge, the mints and burns of the wrapped
+	// tokens and the wrapped tokens created by the transactions of a batch
+	ExtractBatchTransfers(transactions common.L2Transactions, receipts common.L2Receipts) ([]common.BridgeTransfer, []common.WrappedToken, error)
+
+	// OnBlockProcessed - Adds the deposits of the block to the running ledger if the block extends its L1 chain.
+	// Otherwise the canonical chains changed, and the ledger is rebuilt from the stored transfers when next needed.
+	OnBlockProcessed(block *common.L1Block)
+
+	// OnBatchExecuted - Adds the transfers of the stored batch to the running ledger, then alerts about the tokens they
+	// touched whose L2 supply exceeds the balance locked in the L1 bridge and returns them
+	OnBatchExecuted(batchHash common.L2BatchHash, parentHash common.L2BatchHash) ([]common.BridgeTokenBalance, error)
+
+	// GetLedger - Returns the locked and minted balance of each bridged token over the canonical chains
+	GetLedger() ([]common.BridgeTokenBalance, error)
 }
@@ -2,18 +2,23 @@ package crosschain
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/obscuronet/go-obscuro/go/enclave/storage"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/obscuronet/go-obscuro/contracts/generated/MessageBus"
 	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
 	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/enclave/rpc"
 	"github.com/obscuronet/go-obscuro/go/wallet"
@@ -24,6 +29,18 @@ const ( // DO NOT USE OR CHANGE THIS KEY IN THE REST OF THE CODEBASE
 	ownerKeyHex = "6e384a07a01263518a18a5424c7b6bbfc3604ba7d93f47e3a455cbdd7f9f0682"
 )
 
+// maxConsistencyLevel - the consistency level of the messages is a uint8, so a message waits at most this many blocks
+const maxConsistencyLevel = math.MaxUint8
+
+const (
+	// syntheticTxBaseGas - the gas of the synthetic transaction storing a message with an empty payload: the transaction
+	// with its calldata, the finality timestamp of the message and the slots of the message queued in the bus
+	syntheticTxBaseGas = 200_000
+	// syntheticTxGasPerPayloadWord - the gas of each 32 bytes of payload, which are passed in the calldata, hashed and
+	// stored in a new slot
+	syntheticTxGasPerPayloadWord = 32*params.TxDataNonZeroGasEIP2028 + params.SstoreSetGasEIP2200 + params.ColdSloadCostEIP2929 + 1_000
+)
+
 type MessageBusManager struct {
 	messageBusAddress *gethcommon.Address
 	storage           storage.Storage
@@ -120,82 +137,218 @@ func (m *MessageBusManager) ExtractOutboundMessages(receipts common.L2Receipts)
 	return messages, nil
 }
 
-// RetrieveInboundMessages - Retrieves the cross chain messages between two blocks.
-// todo (@stefan) - fix ordering of messages, currently it is irrelevant.
-// todo (@stefan) - do not extract messages below their consistency level. Irrelevant security wise.
-// todo (@stefan) - surface errors
-func (m *MessageBusManager) RetrieveInboundMessages(fromBlock *common.L1Block, toBlock *common.L1Block, _ *state.StateDB) common.CrossChainMessages {
-	messages := make(common.CrossChainMessages, 0)
+// GetOutboundMessages - Converts the message bus logs of the transactions of the sender to outbound messages, with the
+// transaction and log index identifying them when requesting their proof.
+func (m *MessageBusManager) GetOutboundMessages(sender common.L2Address, fromHeight uint64, toHeight uint64) ([]common.OutboundMessage, error) {
+	logs, err := m.storage.FilterLogsOfSender(sender, *m.messageBusAddress, CrossChainEventID, fromHeight, toHeight)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve the message bus logs of the sender. Cause: %w", err)
+	}
 
-	from := fromBlock.Hash()
-	height := fromBlock.NumberU64()
-	if !m.storage.IsAncestor(toBlock, fromBlock) {
-		m.logger.Crit("Synthetic transactions can't be processed because the rollups are not on the same Ethereum fork. This should not happen.")
+	outboundMessages := make([]common.OutboundMessage, 0, len(logs))
+	for _, l := range logs {
+		messages, err := convertLogsToMessages([]types.Log{*l}, CrossChainEventName, MessageBusABI)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert the log to a cross chain message. Cause: %w", err)
+		}
+		outboundMessages = append(outboundMessages, common.OutboundMessage{
+			Message:     messages[0],
+			TxHash:      l.TxHash,
+			LogIndex:    hexutil.Uint64(l.Index),
+			BatchHash:   l.BlockHash,
+			BatchHeight: hexutil.Uint64(l.BlockNumber),
+		})
 	}
-	// Iterate through the blocks.
-	b := toBlock
-	for {
-		if bytes.Equal(b.Hash().Bytes(), from.Bytes()) {
+	return outboundMessages, nil
+}
+
+// GetCrossChainProof - Builds the proof of an outbound message from the batch tree of the message and the rollup tree
+// of the batch, as the L1 message bus verifies it against the root stored by the management contract.
+func (m *MessageBusManager) GetCrossChainProof(txHash common.L2TxHash, logIndex uint64) (*common.CrossChainProof, error) {
+	receipt, err := m.storage.GetTransactionReceipt(txHash)
+	if err != nil {
+		return nil, err
+	}
+	var messageLog *types.Log
+	for _, l := range receipt.Logs {
+		if uint64(l.Index) == logIndex {
+			messageLog = l
+		}
+	}
+	if messageLog == nil {
+		return nil, errutil.ErrNotFound
+	}
+	logs, err := filterLogsFromReceipt(&types.Receipt{Logs: []*types.Log{messageLog}}, m.messageBusAddress, &CrossChainEventID)
+	if err != nil {
+		return nil, err
+	}
+	if len(logs) == 0 {
+		return nil, errutil.ErrNotFound
+	}
+	messages, err := convertLogsToMessages(logs, CrossChainEventName, MessageBusABI)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert the log to a cross chain message. Cause: %w", err)
+	}
+	msg := messages[0]
+
+	batch, err := m.storage.FetchBatch(receipt.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve the batch of the message. Cause: %w", err)
+	}
+	msgHash, err := common.CrossChainMessageHash(msg)
+	if err != nil {
+		return nil, err
+	}
+	msgIndex := -1
+	for i, batchMsg := range batch.Header.CrossChainMessages {
+		batchMsgHash, err := common.CrossChainMessageHash(batchMsg)
+		if err != nil {
+			return nil, err
+		}
+		if batchMsgHash == msgHash {
+			msgIndex = i
 			break
 		}
+	}
+	if msgIndex < 0 {
+		return nil, fmt.Errorf("message %s is not in the cross chain messages of batch %s", msgHash, batch.Hash())
+	}
+	batchProof, err := common.CrossChainMessageProof(batch.Header.CrossChainMessages, msgIndex)
+	if err != nil {
+		return nil, err
+	}
 
+	rollup, firstSeqNo, err := m.storage.FetchRollupBySeqNo(batch.SeqNo().Uint64())
+	if err != nil {
+		return nil, err
+	}
+	batchRoots := make([]gethcommon.Hash, 0, rollup.LastBatchSeqNo-firstSeqNo+1)
+	for seqNo := firstSeqNo; seqNo <= rollup.LastBatchSeqNo; seqNo++ {
+		rollupBatch, err := m.storage.FetchBatchBySeqNo(seqNo)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve the batch with sequence number %d of the rollup. Cause: %w", seqNo, err)
+		}
+		batchRoots = append(batchRoots, rollupBatch.Header.CrossChainRoot)
+	}
+	rollupProof, err := common.BatchRootProof(batchRoots, batch.Header.CrossChainRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.CrossChainProof{
+		Message:    msg,
+		Proof:      append(batchProof, rollupProof...),
+		Root:       rollup.CrossChainRoot,
+		RollupHash: rollup.Hash(),
+		BatchHash:  batch.Hash(),
+	}, nil
+}
+
+// RetrieveInboundMessages - Retrieves the cross chain messages that become deliverable when moving the L2 chain from
+// the L1 block fromBlock to its descendant toBlock, in the order they were published on the L1 (by block, then by log
+// index). A message published in block h with consistency level c is only delivered once the L1 block of the batch is
+// at height h+c, so it is carried over the batches until it has enough confirmations. This only depends on the two
+// blocks, so the sequencer and the validators recomputing a batch derive the same messages.
+func (m *MessageBusManager) RetrieveInboundMessages(fromBlock *common.L1Block, toBlock *common.L1Block, _ *state.StateDB) (common.CrossChainMessages, error) {
+	messages := make(common.CrossChainMessages, 0)
+	fromHeight, toHeight := fromBlock.NumberU64(), toBlock.NumberU64()
+	if !m.storage.IsAncestor(toBlock, fromBlock) {
+		return nil, fmt.Errorf("block %s is not an ancestor of block %s, the messages between them can't be retrieved", fromBlock.Hash(), toBlock.Hash())
+	}
+	if toHeight <= fromHeight {
+		return messages, nil
+	}
+
+	// the messages maturing in this range were published at most maxConsistencyLevel blocks before it
+	lowestHeight := uint64(0)
+	if fromHeight+1 > maxConsistencyLevel {
+		lowestHeight = fromHeight + 1 - maxConsistencyLevel
+	}
+	blocks, err := m.canonicalBlocks(toBlock, lowestHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range blocks {
 		m.logger.Trace(fmt.Sprintf("Looking for cross chain messages at block %s", b.Hash().Hex()))
 		messagesForBlock, err := m.storage.GetL1Messages(b.Hash())
-		if err != nil {
-			m.logger.Crit("Reading the key for the block failed with uncommon reason.", log.ErrKey, err)
+		if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+			return nil, fmt.Errorf("could not retrieve the cross chain messages of block %s. Cause: %w", b.Hash(), err)
 		}
+		for _, msg := range messagesForBlock {
+			maturityHeight := b.NumberU64() + uint64(msg.ConsistencyLevel)
+			if maturityHeight > fromHeight && maturityHeight <= toHeight {
+				messages = append(messages, msg)
+			}
+		}
+	}
 
-		messages = append(messages, messagesForBlock...) // Ordering here might work in POBI, but might be weird for fast finality
+	m.logger.Info(fmt.Sprintf("Extracted cross chain messages for block height %d ->%d: %d.", fromHeight, toHeight, len(messages)))
+
+	return messages, nil
+}
 
-		// No deposits before genesis.
-		if b.NumberU64() < height {
-			m.logger.Crit("block height is less than genesis height")
+// canonicalBlocks returns the ancestors of the block down to the given height, in ascending order. It stops at the
+// first block the enclave has not processed, as there are no messages stored before it.
+func (m *MessageBusManager) canonicalBlocks(block *common.L1Block, lowestHeight uint64) ([]*common.L1Block, error) {
+	blocks := make([]*common.L1Block, 0)
+	for b := block; ; {
+		blocks = append(blocks, b)
+		if b.NumberU64() <= lowestHeight {
+			break
 		}
 		p, err := m.storage.FetchBlock(b.ParentHash())
+		if errors.Is(err, errutil.ErrNotFound) {
+			break
+		}
 		if err != nil {
-			m.logger.Crit("Synthetic transactions can't be processed because the rollups are not on the same Ethereum fork")
+			return nil, fmt.Errorf("could not retrieve the parent of block %s. Cause: %w", b.Hash(), err)
 		}
 		b = p
 	}
 
-	m.logger.Info(fmt.Sprintf("Extracted cross chain messages for block height %d ->%d: %d.", fromBlock.NumberU64(), toBlock.NumberU64(), len(messages)))
-
-	return messages
+	for i, j := 0, len(blocks)-1; i < j; i, j = i+1, j-1 {
+		blocks[i], blocks[j] = blocks[j], blocks[i]
+	}
+	return blocks, nil
 }
 
 // CreateSyntheticTransactions - generates transactions that the enclave should execute internally for the messages.
-func (m *MessageBusManager) CreateSyntheticTransactions(messages common.CrossChainMessages, rollupState *state.StateDB) common.L2Transactions {
+func (m *MessageBusManager) CreateSyntheticTransactions(messages common.CrossChainMessages, rollupState *state.StateDB) (common.L2Transactions, error) {
 	// Get current nonce for this stateDB.
 	// There can be forks thus we cannot trust the wallet.
 	startingNonce := rollupState.GetNonce(m.GetOwner())
 
 	signedTransactions := make(types.Transactions, 0)
 	for idx, message := range messages {
-		delayInBlocks := big.NewInt(int64(message.ConsistencyLevel))
-		data, err := MessageBusABI.Pack("storeCrossChainMessage", message, delayInBlocks)
+		// the message has already waited for the confirmations of its consistency level before being retrieved
+		data, err := MessageBusABI.Pack("storeCrossChainMessage", message, gethcommon.Big0)
 		if err != nil {
-			m.logger.Crit("Failed packing submitOutOfNetwork message!")
-			return signedTransactions
-
-			// todo (@stefan) - return error
-			// return nil, fmt.Errorf("failed packing submitOutOfNetworkMessage %w", err)
+			return nil, fmt.Errorf("failed packing storeCrossChainMessage. Cause: %w", err)
 		}
 
 		tx := &types.LegacyTx{
 			Nonce:    startingNonce + uint64(idx),
 			Value:    gethcommon.Big0,
-			Gas:      5_000_000,
-			GasPrice: gethcommon.Big0, // Synthetic transactions are on the house. Or the house.
+			Gas:      SyntheticTxGas(message),
+			GasPrice: gethcommon.Big0, // The sender of the message paid the fee of the L1 message bus.
 			Data:     data,
 			To:       m.messageBusAddress,
 		}
 
 		stx, err := m.wallet.SignTransaction(tx)
 		if err != nil {
-			panic(err)
+			return nil, fmt.Errorf("could not sign synthetic transaction. Cause: %w", err)
 		}
 		signedTransactions = append(signedTransactions, stx)
 	}
 
-	return signedTransactions
+	return signedTransactions, nil
+}
+
+// SyntheticTxGas - returns the gas limit of the synthetic transaction storing the message in the L2 message bus, which
+// grows with the size of the payload. It only depends on the message, so all the nodes derive the same limit.
+func SyntheticTxGas(message common.CrossChainMessage) uint64 {
+	words := (uint64(len(message.Payload)) + 31) / 32
+	return syntheticTxBaseGas + words*syntheticTxGasPerPayloadWord
 }
@@ -0,0 +1,286 @@
+package crosschain
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	gethcore "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/obscuronet/go-obscuro/contracts/generated/MessageBus"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/go/enclave/storage"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	testRuns           = 50
+	testChainLength    = 40
+	testForks          = 8
+	testMaxConsistency = 6
+)
+
+// blockStorage holds the L1 blocks and messages a node has seen. It implements the methods of the storage used by
+// the message bus manager.
+type blockStorage struct {
+	storage.Storage
+	blocks   map[common.L1BlockHash]*types.Block
+	messages map[common.L1BlockHash]common.CrossChainMessages
+}
+
+func newBlockStorage() *blockStorage {
+	return &blockStorage{
+		blocks:   map[common.L1BlockHash]*types.Block{},
+		messages: map[common.L1BlockHash]common.CrossChainMessages{},
+	}
+}
+
+func (s *blockStorage) add(block *types.Block, messages common.CrossChainMessages) {
+	s.blocks[block.Hash()] = block
+	s.messages[block.Hash()] = messages
+}
+
+func (s *blockStorage) FetchBlock(blockHash common.L1BlockHash) (*types.Block, error) {
+	block, found := s.blocks[blockHash]
+	if !found {
+		return nil, errutil.ErrNotFound
+	}
+	return block, nil
+}
+
+func (s *blockStorage) IsAncestor(block *types.Block, maybeAncestor *types.Block) bool {
+	for b := block; b != nil; b = s.blocks[b.ParentHash()] {
+		if b.Hash() == maybeAncestor.Hash() {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *blockStorage) GetL1Messages(blockHash common.L1BlockHash) (common.CrossChainMessages, error) {
+	messages, found := s.messages[blockHash]
+	if !found {
+		return nil, errutil.ErrNotFound
+	}
+	return messages, nil
+}
+
+// l1Tree is a random L1 block tree, with a canonical chain and forks branching off it
+type l1Tree struct {
+	canonical []*types.Block
+	forks     [][]*types.Block
+	messages  map[common.L1BlockHash]common.CrossChainMessages
+}
+
+func newL1Tree(rnd *rand.Rand) *l1Tree {
+	tree := &l1Tree{messages: map[common.L1BlockHash]common.CrossChainMessages{}}
+	tree.canonical = tree.extend(rnd, nil, testChainLength)
+	for i := 0; i < testForks; i++ {
+		forkPoint := tree.canonical[rnd.Intn(len(tree.canonical)-1)]
+		tree.forks = append(tree.forks, tree.extend(rnd, forkPoint, 1+rnd.Intn(5)))
+	}
+	return tree
+}
+
+// extend creates a chain of blocks with random messages on top of the parent
+func (t *l1Tree) extend(rnd *rand.Rand, parent *types.Block, length int) []*types.Block {
+	chain := make([]*types.Block, 0, length)
+	for i := 0; i < length; i++ {
+		header := &types.Header{Number: big.NewInt(0), Extra: big.NewInt(rnd.Int63()).Bytes()}
+		if parent != nil {
+			header.ParentHash = parent.Hash()
+			header.Number = new(big.Int).Add(parent.Number(), big.NewInt(1))
+		}
+		block := types.NewBlock(header, nil, nil, nil, trie.NewStackTrie(nil))
+
+		messages := make(common.CrossChainMessages, rnd.Intn(4))
+		for j := range messages {
+			messages[j] = common.CrossChainMessage{
+				Sender:           gethcommon.BigToAddress(big.NewInt(rnd.Int63())),
+				Sequence:         rnd.Uint64(),
+				Payload:          []byte{byte(j)},
+				ConsistencyLevel: uint8(rnd.Intn(testMaxConsistency + 1)),
+			}
+		}
+		t.messages[block.Hash()] = messages
+
+		chain = append(chain, block)
+		parent = block
+	}
+	return chain
+}
+
+// storageWith returns the storage of a node that saw the canonical chain and the given forks
+func (t *l1Tree) storageWith(forks [][]*types.Block) *blockStorage {
+	s := newBlockStorage()
+	for _, block := range t.canonical {
+		s.add(block, t.messages[block.Hash()])
+	}
+	for _, fork := range forks {
+		for _, block := range fork {
+			s.add(block, t.messages[block.Hash()])
+		}
+	}
+	return s
+}
+
+// randomSteps returns the L1 blocks of a random sequence of batches along the canonical chain, which can stay on the
+// same block or skip blocks
+func (t *l1Tree) randomSteps(rnd *rand.Rand) []*types.Block {
+	steps := []*types.Block{t.canonical[0]}
+	for height := 0; height < len(t.canonical)-1; {
+		height += rnd.Intn(4)
+		if height >= len(t.canonical) {
+			height = len(t.canonical) - 1
+		}
+		steps = append(steps, t.canonical[height])
+	}
+	return steps
+}
+
+func newTestStateDB(t *testing.T) *state.StateDB {
+	stateDB, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stateDB
+}
+
+func TestInboundMessagesAreDeliveredOnceInCanonicalOrder(t *testing.T) {
+	for run := 0; run < testRuns; run++ {
+		rnd := rand.New(rand.NewSource(int64(run))) //nolint:gosec
+		tree := newL1Tree(rnd)
+		manager := NewObscuroMessageBusManager(tree.storageWith(tree.forks), big.NewInt(777), gethlog.New())
+
+		// each batch delivers the canonical messages that reached their consistency level since its parent, in
+		// publication order, so every message is delivered exactly once
+		steps := tree.randomSteps(rnd)
+		for i := 1; i < len(steps); i++ {
+			delivered, err := manager.RetrieveInboundMessages(steps[i-1], steps[i], nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var expected common.CrossChainMessages
+			for _, block := range tree.canonical {
+				for _, msg := range tree.messages[block.Hash()] {
+					deliverableAt := block.NumberU64() + uint64(msg.ConsistencyLevel)
+					if deliverableAt > steps[i-1].NumberU64() && deliverableAt <= steps[i].NumberU64() {
+						expected = append(expected, msg)
+					}
+				}
+			}
+
+			if len(delivered) != len(expected) {
+				t.Fatalf("run %d: expected %d messages to be delivered, got %d", run, len(expected), len(delivered))
+			}
+			for j := range expected {
+				if expected[j].Sequence != delivered[j].Sequence || expected[j].Sender != delivered[j].Sender {
+					t.Fatalf("run %d: message %d delivered out of order", run, j)
+				}
+			}
+		}
+	}
+}
+
+func TestSequencerAndValidatorsDeriveSameSyntheticTxsUnderReorgs(t *testing.T) {
+	for run := 0; run < testRuns; run++ {
+		rnd := rand.New(rand.NewSource(int64(run))) //nolint:gosec
+		tree := newL1Tree(rnd)
+
+		// the sequencer followed every fork before the reorg to the canonical chain, the validators saw some or none
+		sequencer := NewObscuroMessageBusManager(tree.storageWith(tree.forks), big.NewInt(777), gethlog.New())
+		validators := []Manager{
+			NewObscuroMessageBusManager(tree.storageWith(nil), big.NewInt(777), gethlog.New()),
+			NewObscuroMessageBusManager(tree.storageWith(tree.forks[:testForks/2]), big.NewInt(777), gethlog.New()),
+		}
+
+		steps := tree.randomSteps(rnd)
+		for i := 1; i < len(steps); i++ {
+			expectedTxs := syntheticTxs(t, sequencer, steps[i-1], steps[i])
+			for _, validator := range validators {
+				txs := syntheticTxs(t, validator, steps[i-1], steps[i])
+				if len(txs) != len(expectedTxs) {
+					t.Fatalf("run %d: expected %d synthetic txs, got %d", run, len(expectedTxs), len(txs))
+				}
+				for j := range txs {
+					if txs[j].Hash() != expectedTxs[j].Hash() {
+						t.Fatalf("run %d: synthetic tx %d differs between the sequencer and a validator", run, j)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestCannotRetrieveMessagesAcrossForks(t *testing.T) {
+	tree := newL1Tree(rand.New(rand.NewSource(0))) //nolint:gosec
+	manager := NewObscuroMessageBusManager(tree.storageWith(tree.forks), big.NewInt(777), gethlog.New())
+
+	fork := tree.forks[0]
+	if _, err := manager.RetrieveInboundMessages(fork[len(fork)-1], tree.canonical[len(tree.canonical)-1], nil); err == nil {
+		t.Fatal("expected an error when the blocks are not on the same fork")
+	}
+}
+
+func syntheticTxs(t *testing.T, manager Manager, fromBlock *types.Block, toBlock *types.Block) common.L2Transactions {
+	messages, err := manager.RetrieveInboundMessages(fromBlock, toBlock, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txs, err := manager.CreateSyntheticTransactions(messages, newTestStateDB(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return txs
+}
+
+func TestSyntheticTxGasCoversStoringMessage(t *testing.T) {
+	// the enclave owns the L2 message bus it deploys
+	cfg := &runtime.Config{Origin: gethcommon.HexToAddress("0x1"), State: newTestStateDB(t), GasLimit: 10_000_000}
+	_, busAddress, _, err := runtime.Create(gethcommon.FromHex(MessageBus.MessageBusMetaData.Bin), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rnd := rand.New(rand.NewSource(0)) //nolint:gosec
+	for _, payloadSize := range []int{0, 31, 100, 1_000, 10_000} {
+		payload := make([]byte, payloadSize)
+		rnd.Read(payload)
+		// each message has a new sender, so its queue is created as well
+		msg := common.CrossChainMessage{
+			Sender:           gethcommon.BigToAddress(big.NewInt(int64(payloadSize + 2))),
+			Sequence:         rnd.Uint64(),
+			Nonce:            rnd.Uint32(),
+			Topic:            rnd.Uint32(),
+			Payload:          payload,
+			ConsistencyLevel: uint8(rnd.Intn(255)) + 1,
+		}
+		data, err := MessageBusABI.Pack("storeCrossChainMessage", msg, gethcommon.Big0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		intrinsicGas, err := gethcore.IntrinsicGas(data, nil, false, true, true, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		gasLimit := SyntheticTxGas(msg)
+		cfg.GasLimit = gasLimit - intrinsicGas
+		_, gasLeft, err := runtime.Call(busAddress, data, cfg)
+		if err != nil {
+			t.Fatalf("message with a payload of %d bytes could not be stored. Cause: %s", payloadSize, err)
+		}
+		// the limit stays close to the gas used, so that the cap on the synthetic gas of a batch is meaningful
+		if gasUsed := gasLimit - gasLeft; gasUsed < gasLimit/2 {
+			t.Fatalf("message with a payload of %d bytes used %d gas out of %d", payloadSize, gasUsed, gasLimit)
+		}
+	}
+}
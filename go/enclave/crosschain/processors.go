@@ -14,17 +14,21 @@ import (
 type Processors struct {
 	Local  Manager
 	Remote BlockMessageExtractor
+	Bridge BridgeWatcher
 }
 
 func New(
 	l1BusAddress *gethcommon.Address,
+	l1BridgeAddress *gethcommon.Address,
+	l2BridgeAddress *gethcommon.Address,
 	storage storage.Storage,
 	chainID *big.Int,
 	logger gethlog.Logger,
 ) *Processors {
 	processors := Processors{}
 	processors.Local = NewObscuroMessageBusManager(storage, chainID, logger)
-	processors.Remote = NewBlockMessageExtractor(l1BusAddress, processors.Local.GetBusAddress(), storage, logger)
+	processors.Bridge = NewBridgeWatcher(l1BridgeAddress, l2BridgeAddress, processors.Local.GetBusAddress(), storage, logger)
+	processors.Remote = NewBlockMessageExtractor(l1BusAddress, processors.Local.GetBusAddress(), processors.Bridge, storage, logger)
 	return &processors
 }
 
@@ -70,7 +70,6 @@ type enclaveImpl struct {
 	blockResolver         storage.BlockResolver
 	l1BlockProcessor      components.L1BlockProcessor
 	rollupConsumer        components.RollupConsumer
-	l1Blockchain          *gethcore.BlockChain
 	rpcEncryptionManager  rpc.EncryptionManager
 	subscriptionManager   *events.SubscriptionManager
 	crossChainProcessors  *crosschain.Processors
@@ -148,18 +147,6 @@ func NewEnclave(
 	}
 	storage := storage.NewStorageFromConfig(config, &chainConfig, logger)
 
-	// Initialise the Ethereum "Blockchain" structure that will allow us to validate incoming blocks
-	// todo (#1056) - valid block
-	var l1Blockchain *gethcore.BlockChain
-	if config.ValidateL1Blocks {
-		if config.GenesisJSON == nil {
-			logger.Crit("enclave is configured to validate blocks, but genesis JSON is nil")
-		}
-		l1Blockchain = l2chain.NewL1Blockchain(config.GenesisJSON, logger)
-	} else {
-		logger.Info("validateBlocks is set to false. L1 blocks will not be validated.")
-	}
-
 	// todo (#1474) - make sure the enclave cannot be started in production with WillAttest=false
 	var attestationProvider components.AttestationProvider
 	if config.WillAttest {
@@ -199,14 +186,32 @@ func NewEnclave(
 
 	memp := mempool.New(config.ObscuroChainID, logger)
 
-	crossChainProcessors := crosschain.New(&config.MessageBusAddress, storage, big.NewInt(config.ObscuroChainID), logger)
+	crossChainProcessors := crosschain.New(&config.MessageBusAddress, &config.L1BridgeAddress, &config.L2BridgeAddress, storage, big.NewInt(config.ObscuroChainID), logger)
 
-	subscriptionManager := events.NewSubscriptionManager(&rpcEncryptionManager, storage, logger)
+	subscriptionManager := events.NewSubscriptionManager(&rpcEncryptionManager, storage, config.ObscuroChainID, config.AllowLegacyViewingKeySignatures, logger)
 
-	blockProcessor := components.NewBlockProcessor(storage, crossChainProcessors, logger)
-	batchExecutor := components.NewBatchExecutor(storage, crossChainProcessors, genesis, &chainConfig, logger)
+	blockProcessor := components.NewBlockProcessor(storage, crossChainProcessors, mgmtContractLib, &rpcEncryptionManager, config.L1StartHash, config.L1MaxReorgDepth, logger)
+	if err = blockProcessor.VerifyL1Start(); err != nil {
+		logger.Crit("The stored L1 chain does not match the configured L1 start block", log.ErrKey, err)
+	}
+	// the fees are credited to the sequencer unless another recipient is configured, so that they are never burned
+	feeRecipient := config.SequencerFeeRecipient
+	if feeRecipient == (gethcommon.Address{}) {
+		feeRecipient = config.SequencerID
+	}
+	if feeRecipient == (gethcommon.Address{}) && config.NodeType == common.Sequencer {
+		logger.Crit("The sequencer has no fee recipient. Configure the sequencer ID or the sequencer fee recipient")
+	}
+	gasConfig := &components.GasConfig{
+		TargetGasPerBatch:       config.TargetGasPerBatch,
+		ElasticityMultiplier:    config.ElasticityMultiplier,
+		MinBaseFee:              config.MinGasPrice,
+		FeeRecipient:            feeRecipient,
+		MaxSyntheticGasPerBatch: config.MaxSyntheticGasPerBatch,
+	}
+	batchExecutor := components.NewBatchExecutor(storage, crossChainProcessors, genesis, &chainConfig, gasConfig, config.ForcedTxInclusionWindow, logger)
 	sigVerifier, err := components.NewSignatureValidator(config.SequencerID, storage)
-	registry := components.NewBatchRegistry(storage, logger)
+	registry := components.NewBatchRegistry(storage, crossChainProcessors.Bridge, logger)
 	rProducer := components.NewRollupProducer(config.SequencerID, dataEncryptionService, config.ObscuroChainID, config.L1ChainID, storage, registry, blockProcessor, logger)
 	if err != nil {
 		logger.Crit("Could not initialise the signature validator", log.ErrKey, err)
@@ -265,7 +270,6 @@ func NewEnclave(
 		blockResolver:          storage,
 		l1BlockProcessor:       blockProcessor,
 		rollupConsumer:         rConsumer,
-		l1Blockchain:           l1Blockchain,
 		rpcEncryptionManager:   rpcEncryptionManager,
 		subscriptionManager:    subscriptionManager,
 		crossChainProcessors:   crossChainProcessors,
@@ -318,6 +322,101 @@ func (e *enclaveImpl) GetBatchBySeqNo(seqNo uint64) (*common.ExtBatch, common.Sy
 	return b, nil
 }
 
+func (e *enclaveImpl) GetCrossChainProof(txHash gethcommon.Hash, logIndex uint64) (*common.CrossChainProof, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetCrossChainProof with the enclave stopping"))
+	}
+
+	proof, err := e.crossChainProcessors.Local.GetCrossChainProof(txHash, logIndex)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, responses.ToInternalError(fmt.Errorf("could not build the cross chain proof. Cause: %w", err))
+	}
+	return proof, nil
+}
+
+// GetInboundMessageStatus returns the status of the messages published by the L1 transaction. Only the sender of the
+// transaction can follow their delivery, as their payloads can be private.
+func (e *enclaveImpl) GetInboundMessageStatus(encryptedParams common.EncryptedParamsInboundMessages) (*responses.InboundMessageStatus, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetInboundMessageStatus with the enclave stopping"))
+	}
+
+	// decode the received request into a []interface
+	paramList, err := e.decodeRequest(encryptedParams)
+	if err != nil {
+		return responses.AsPlaintextError(fmt.Errorf("unable to decode obscuro_getInboundMessageStatus params - %w", err)), nil
+	}
+
+	// Parameters are [ViewingKey, L1TxHash]
+	if len(paramList) != 2 {
+		return responses.AsPlaintextError(fmt.Errorf("unexpected number of parameters")), nil
+	}
+	txHashStr, ok := paramList[1].(string)
+	if !ok {
+		return responses.AsPlaintextError(fmt.Errorf("unexpected tx hash parameter")), nil
+	}
+	txHash := gethcommon.HexToHash(txHashStr)
+
+	statuses, err := e.storage.GetInboundMessageStatus(txHash)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return responses.AsEmptyResponse(), nil
+		}
+		return responses.AsPlaintextError(err), nil
+	}
+
+	// all the messages were published by the same transaction
+	vkHandler, err := e.createVKHandler(&statuses[0].L1TxSender, paramList[0])
+	if err != nil {
+		return responses.AsPlaintextError(fmt.Errorf("unable to create VK encryptor - %w", err)), nil
+	}
+
+	return responses.AsEncryptedResponse(&statuses, vkHandler), nil
+}
+
+// GetOutboundMessages returns the messages published by the transactions of the account in the canonical batches of
+// the range, which can then be relayed with their cross chain proofs.
+func (e *enclaveImpl) GetOutboundMessages(encryptedParams common.EncryptedParamsOutboundMessages) (*responses.OutboundMessages, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetOutboundMessages with the enclave stopping"))
+	}
+
+	// decode the received request into a []interface
+	paramList, err := e.decodeRequest(encryptedParams)
+	if err != nil {
+		return responses.AsPlaintextError(fmt.Errorf("unable to decode obscuro_getOutboundMessages params - %w", err)), nil
+	}
+
+	// Parameters are [ViewingKey, Address, BatchRange]
+	if len(paramList) != 3 {
+		return responses.AsPlaintextError(fmt.Errorf("unexpected number of parameters")), nil
+	}
+	address, err := gethencoding.ExtractAddress(paramList[1])
+	if err != nil {
+		return responses.AsPlaintextError(fmt.Errorf("unexpected address parameter - %w", err)), nil
+	}
+	batchRange, err := gethencoding.ExtractBatchRange(paramList[2])
+	if err != nil {
+		return responses.AsPlaintextError(fmt.Errorf("unexpected batch range parameter - %w", err)), nil
+	}
+
+	// extract, create and validate the VK encryption handler
+	vkHandler, err := e.createVKHandler(address, paramList[0])
+	if err != nil {
+		return responses.AsPlaintextError(fmt.Errorf("unable to create VK encryptor - %w", err)), nil
+	}
+
+	messages, err := e.crossChainProcessors.Local.GetOutboundMessages(*address, batchRange.From, batchRange.To)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not retrieve the outbound messages. Cause: %w", err))
+	}
+
+	return responses.AsEncryptedResponse(&messages, vkHandler), nil
+}
+
 // Status is only implemented by the RPC wrapper
 func (e *enclaveImpl) Status() (common.Status, common.SystemError) {
 	if e.stopControl.IsStopping() {
@@ -499,7 +598,7 @@ func (e *enclaveImpl) SubmitTx(tx common.EncryptedTx) (*responses.RawTx, common.
 	}
 
 	// extract, create and validate the VK encryption handler
-	vkHandler, err := createVKHandler(&viewingKeyAddress, paramList[0])
+	vkHandler, err := e.createVKHandler(&viewingKeyAddress, paramList[0])
 	if err != nil {
 		return responses.AsPlaintextError(fmt.Errorf("unable to create VK encryptor - %w", err)), nil
 	}
@@ -507,6 +606,9 @@ func (e *enclaveImpl) SubmitTx(tx common.EncryptedTx) (*responses.RawTx, common.
 	if e.crossChainProcessors.Local.IsSyntheticTransaction(*decryptedTx) {
 		return responses.AsPlaintextError(responses.ToInternalError(fmt.Errorf("synthetic transaction coming from external rpc"))), nil
 	}
+	if vkhandler.IsRevocationTx(decryptedTx) {
+		return responses.AsPlaintextError(fmt.Errorf("viewing key revocations must be submitted with obscuro_revokeViewingKey")), nil
+	}
 	if err = e.checkGas(decryptedTx); err != nil {
 		e.logger.Info("gas check failed", log.ErrKey, err.Error())
 		return responses.AsEncryptedError(err, vkHandler), nil
@@ -631,8 +733,8 @@ func (e *enclaveImpl) ObsCall(encryptedParams common.EncryptedParamsCall) (*resp
 		return responses.AsPlaintextError(fmt.Errorf("unable to decode eth_call params - %w", err)), nil
 	}
 
-	// Parameters are [ViewingKey, TransactionArgs, BlockNumber]
-	if len(paramList) != 3 {
+	// Parameters are [ViewingKey, TransactionArgs, BlockNumber, StateOverride (optional), BlockOverrides (optional)]
+	if len(paramList) < 3 || len(paramList) > 5 {
 		return responses.AsPlaintextError(fmt.Errorf("unexpected number of parameters")), nil
 	}
 
@@ -649,7 +751,7 @@ func (e *enclaveImpl) ObsCall(encryptedParams common.EncryptedParamsCall) (*resp
 	}
 
 	// extract, create and validate the VK encryption handler
-	vkHandler, err := createVKHandler(apiArgs.From, paramList[0])
+	vkHandler, err := e.createVKHandler(apiArgs.From, paramList[0])
 	if err != nil {
 		return responses.AsPlaintextError(fmt.Errorf("unable to create VK encryptor - %w", err)), nil
 	}
@@ -660,7 +762,12 @@ func (e *enclaveImpl) ObsCall(encryptedParams common.EncryptedParamsCall) (*resp
 		return responses.AsEncryptedError(err, vkHandler), nil
 	}
 
-	execResult, err := e.chain.ObsCall(apiArgs, blkNumber)
+	stateOverrides, blockOverrides, err := extractCallOverrides(paramList, 3)
+	if err != nil {
+		return responses.AsEncryptedError(err, vkHandler), nil
+	}
+
+	execResult, err := e.chain.ObsCall(apiArgs, blkNumber, stateOverrides, blockOverrides)
 	if err != nil {
 		e.logger.Debug("Failed eth_call.", log.ErrKey, err)
 
@@ -709,7 +816,7 @@ func (e *enclaveImpl) GetTransactionCount(encryptedParams common.EncryptedParams
 	address := gethcommon.HexToAddress(addressStr)
 
 	// extract, create and validate the VK encryption handler
-	vkHandler, err := createVKHandler(&address, paramList[0])
+	vkHandler, err := e.createVKHandler(&address, paramList[0])
 	if err != nil {
 		return responses.AsPlaintextError(fmt.Errorf("unable to create VK encryptor - %w", err)), nil
 	}
@@ -768,7 +875,7 @@ func (e *enclaveImpl) GetTransaction(encryptedParams common.EncryptedParamsGetTx
 	}
 
 	// extract, create and validate the VK encryption handler
-	vkHandler, err := createVKHandler(&viewingKeyAddress, paramList[0])
+	vkHandler, err := e.createVKHandler(&viewingKeyAddress, paramList[0])
 	if err != nil {
 		return responses.AsPlaintextError(fmt.Errorf("unable to create VK encryptor - %w", err)), nil
 	}
@@ -823,7 +930,7 @@ func (e *enclaveImpl) GetTransactionReceipt(encryptedParams common.EncryptedPara
 	}
 
 	// extract, create and validate the VK encryption handler
-	vkHandler, err := createVKHandler(&sender, paramList[0])
+	vkHandler, err := e.createVKHandler(&sender, paramList[0])
 	if err != nil {
 		e.logger.Trace("error getting the vk ", "txHash", txHash, log.ErrKey, err)
 		return responses.AsPlaintextError(fmt.Errorf("unable to create VK encryptor - %w", err)), nil
@@ -850,7 +957,12 @@ func (e *enclaveImpl) GetTransactionReceipt(encryptedParams common.EncryptedPara
 
 	e.logger.Trace("Successfully retreived receipt for ", "txHash", txHash, "rec", txReceipt)
 
-	return responses.AsEncryptedResponse(txReceipt, vkHandler), nil
+	receiptWithL1Fee, err := e.addL1DataFee(tx, txReceipt)
+	if err != nil {
+		return nil, responses.ToInternalError(err)
+	}
+
+	return responses.AsEncryptedResponse(&receiptWithL1Fee, vkHandler), nil
 }
 
 func (e *enclaveImpl) Attestation() (*common.AttestationReport, common.SystemError) {
@@ -940,7 +1052,7 @@ func (e *enclaveImpl) GetBalance(encryptedParams common.EncryptedParamsGetBalanc
 	}
 
 	// extract, create and validate the VK encryption handler
-	vkHandler, err := createVKHandler(encryptAddress, paramList[0])
+	vkHandler, err := e.createVKHandler(encryptAddress, paramList[0])
 	if err != nil {
 		return responses.AsPlaintextError(fmt.Errorf("unable to create VK encryptor - %w", err)), nil
 	}
@@ -1015,8 +1127,8 @@ func (e *enclaveImpl) EstimateGas(encryptedParams common.EncryptedParamsEstimate
 		return responses.AsPlaintextError(fmt.Errorf("unable to decode eth_estimateGas params - %w", err)), nil
 	}
 
-	// Parameters are [ViewingKey, callMsg, block number (optional) ]
-	if len(paramList) < 2 {
+	// Parameters are [ViewingKey, callMsg, block number (optional), StateOverride (optional), BlockOverrides (optional)]
+	if len(paramList) < 2 || len(paramList) > 5 {
 		return responses.AsPlaintextError(fmt.Errorf("unexpected number of parameters")), nil
 	}
 
@@ -1033,7 +1145,7 @@ func (e *enclaveImpl) EstimateGas(encryptedParams common.EncryptedParamsEstimate
 	}
 
 	// extract, create and validate the VK encryption handler
-	vkHandler, err := createVKHandler(callMsg.From, paramList[0])
+	vkHandler, err := e.createVKHandler(callMsg.From, paramList[0])
 	if err != nil {
 		return responses.AsPlaintextError(fmt.Errorf("unable to create VK encryptor - %w", err)), nil
 	}
@@ -1045,7 +1157,12 @@ func (e *enclaveImpl) EstimateGas(encryptedParams common.EncryptedParamsEstimate
 		return responses.AsEncryptedError(err, vkHandler), nil
 	}
 
-	gasEstimate, err := e.DoEstimateGas(callMsg, blockNumber, e.GlobalGasCap)
+	stateOverrides, blockOverrides, err := extractCallOverrides(paramList, 3)
+	if err != nil {
+		return responses.AsEncryptedError(err, vkHandler), nil
+	}
+
+	gasEstimate, err := e.DoEstimateGas(callMsg, blockNumber, stateOverrides, blockOverrides, e.GlobalGasCap)
 	if err != nil {
 		err = fmt.Errorf("unable to estimate transaction - %w", err)
 
@@ -1065,6 +1182,190 @@ func (e *enclaveImpl) EstimateGas(encryptedParams common.EncryptedParamsEstimate
 	return responses.AsEncryptedResponse(&gasEstimate, vkHandler), nil
 }
 
+// SimulateCalls decrypts the list of calls, executes them in order on top of the same state, and returns the per-call
+// results encrypted with the viewing key of the account sending the calls
+func (e *enclaveImpl) SimulateCalls(encryptedParams common.EncryptedParamsSimulateCalls) (*responses.SimulatedCalls, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested SimulateCalls with the enclave stopping"))
+	}
+
+	// decode the received request into a []interface
+	paramList, err := e.decodeRequest(encryptedParams)
+	if err != nil {
+		return responses.AsPlaintextError(fmt.Errorf("unable to decode simulateCalls params - %w", err)), nil
+	}
+
+	// Parameters are [ViewingKey, []TransactionArgs, BlockNumber (optional), StateOverride (optional)]
+	if len(paramList) < 2 || len(paramList) > 4 {
+		return responses.AsPlaintextError(fmt.Errorf("unexpected number of parameters")), nil
+	}
+
+	calls, err := gethencoding.ExtractEthCalls(paramList[1])
+	if err != nil {
+		return responses.AsPlaintextError(fmt.Errorf("unable to decode calls - %w", err)), nil
+	}
+	if len(calls) == 0 {
+		return responses.AsPlaintextError(fmt.Errorf("no calls to simulate")), nil
+	}
+
+	// all the calls are sent from the viewing key account, so the results can be encrypted for it
+	account := calls[0].From
+	if account == nil {
+		return responses.AsPlaintextError(fmt.Errorf("no from address provided")), nil
+	}
+	for _, call := range calls {
+		if call.From == nil {
+			call.From = account
+		}
+		if *call.From != *account {
+			return responses.AsPlaintextError(fmt.Errorf("all the simulated calls must be sent from %s", account)), nil
+		}
+	}
+
+	// extract, create and validate the VK encryption handler
+	vkHandler, err := e.createVKHandler(account, paramList[0])
+	if err != nil {
+		return responses.AsPlaintextError(fmt.Errorf("unable to create VK encryptor - %w", err)), nil
+	}
+
+	// extract optional block number - defaults to the latest block if not avail
+	blockNumber, err := gethencoding.ExtractOptionalBlockNumber(paramList, 2)
+	if err != nil {
+		err = fmt.Errorf("unable to extract requested block number - %w", err)
+		return responses.AsEncryptedError(err, vkHandler), nil
+	}
+
+	stateOverrides, err := gethencoding.ExtractOptionalStateOverride(paramList, 3)
+	if err != nil {
+		return responses.AsEncryptedError(fmt.Errorf("unable to extract state overrides - %w", err), vkHandler), nil
+	}
+
+	results, err := e.chain.SimulateCalls(account, calls, blockNumber, stateOverrides)
+	if err != nil {
+		// make sure it's not some internal error
+		if errors.Is(err, syserr.InternalError{}) {
+			return nil, responses.ToInternalError(err)
+		}
+		return responses.AsEncryptedError(fmt.Errorf("unable to simulate calls - %w", err), vkHandler), nil
+	}
+
+	return responses.AsEncryptedResponse(&results, vkHandler), nil
+}
+
+// CreateAccessList handles param decryption, validation and encryption
+// and requests the Rollup chain to trace the payload and return its access list (eth_createAccessList)
+func (e *enclaveImpl) CreateAccessList(encryptedParams common.EncryptedParamsAccessList) (*responses.AccessList, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested CreateAccessList with the enclave stopping"))
+	}
+
+	// decode the received request into a []interface
+	paramList, err := e.decodeRequest(encryptedParams)
+	if err != nil {
+		return responses.AsPlaintextError(fmt.Errorf("unable to decode eth_createAccessList params - %w", err)), nil
+	}
+
+	// Parameters are [ViewingKey, TransactionArgs, BlockNumber (optional)]
+	if len(paramList) < 2 || len(paramList) > 3 {
+		return responses.AsPlaintextError(fmt.Errorf("unexpected number of parameters")), nil
+	}
+
+	apiArgs, err := gethencoding.ExtractEthCall(paramList[1])
+	if err != nil {
+		return responses.AsPlaintextError(fmt.Errorf("unable to decode EthCall Params - %w", err)), nil
+	}
+
+	// encryption will fail if no From address is provided
+	if apiArgs.From == nil {
+		return responses.AsPlaintextError(fmt.Errorf("no from address provided")), nil
+	}
+
+	// extract, create and validate the VK encryption handler
+	vkHandler, err := e.createVKHandler(apiArgs.From, paramList[0])
+	if err != nil {
+		return responses.AsPlaintextError(fmt.Errorf("unable to create VK encryptor - %w", err)), nil
+	}
+
+	// extract optional block number - defaults to the latest block if not avail
+	blkNumber, err := gethencoding.ExtractOptionalBlockNumber(paramList, 2)
+	if err != nil {
+		return responses.AsEncryptedError(fmt.Errorf("unable to extract requested block number - %w", err), vkHandler), nil
+	}
+
+	accessListResult, err := e.chain.CreateAccessList(apiArgs, blkNumber)
+	if err != nil {
+		// make sure it's not some internal error
+		if errors.Is(err, syserr.InternalError{}) {
+			return nil, responses.ToInternalError(err)
+		}
+		return responses.AsEncryptedError(fmt.Errorf("unable to create access list - %w", err), vkHandler), nil
+	}
+
+	return responses.AsEncryptedResponse(accessListResult, vkHandler), nil
+}
+
+// RevokeViewingKey handles param decryption and validation, records the revocation of the viewing key, and submits the
+// system transaction sharing it with the other enclaves. Validators are a no-op for the transaction, so the host
+// forwards the request to the sequencer.
+func (e *enclaveImpl) RevokeViewingKey(encryptedParams common.EncryptedParamsRevokeViewingKey) (*responses.ViewingKeyRevocation, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested RevokeViewingKey with the enclave stopping"))
+	}
+
+	// decode the received request into a []interface
+	paramList, err := e.decodeRequest(encryptedParams)
+	if err != nil {
+		return responses.AsPlaintextError(fmt.Errorf("unable to decode obscuro_revokeViewingKey params - %w", err)), nil
+	}
+
+	// Parameters are [ViewingKey, Address]
+	if len(paramList) != 2 {
+		return responses.AsPlaintextError(fmt.Errorf("unexpected number of parameters")), nil
+	}
+
+	account, err := gethencoding.ExtractAddress(paramList[1])
+	if err != nil {
+		return responses.AsPlaintextError(fmt.Errorf("unable to extract address - %w", err)), nil
+	}
+
+	vkPubKeyBytes, accountSignatureBytes, err := gethencoding.ExtractViewingKey(paramList[0])
+	if err != nil {
+		return responses.AsPlaintextError(fmt.Errorf("unable to decode viewing key - %w", err)), nil
+	}
+
+	// the confirmation is the last response encrypted with the viewing key
+	revocation := &vkhandler.Revocation{Account: *account, ViewingKey: vkPubKeyBytes, Signature: accountSignatureBytes}
+	vkHandler, err := vkhandler.NewForRevocation(revocation, e.config.ObscuroChainID)
+	if err != nil {
+		return responses.AsPlaintextError(fmt.Errorf("unable to create VK encryptor - %w", err)), nil
+	}
+
+	// revoking a viewing key again is confirmed without sharing the revocation again
+	revoked := true
+	alreadyRevoked, err := e.storage.IsViewingKeyRevoked(vkPubKeyBytes)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not check viewing key revocation. Cause: %w", err))
+	}
+	if alreadyRevoked {
+		return responses.AsEncryptedResponse(&revoked, vkHandler), nil
+	}
+
+	// the revocation is only recorded once it is submitted, so the request can be retried if the submission fails
+	revocationTx, err := vkhandler.NewRevocationTx(revocation, e.config.ObscuroChainID)
+	if err != nil {
+		return nil, responses.ToInternalError(err)
+	}
+	if err = e.service.SubmitTransaction(revocationTx); err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not submit viewing key revocation. Cause: %w", err))
+	}
+	if err = e.storage.StoreViewingKeyRevocation(vkPubKeyBytes, *account); err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not store viewing key revocation. Cause: %w", err))
+	}
+	e.subscriptionManager.RemoveSubscriptionsForViewingKey(vkPubKeyBytes)
+
+	return responses.AsEncryptedResponse(&revoked, vkHandler), nil
+}
+
 func (e *enclaveImpl) GetLogs(encryptedParams common.EncryptedParamsGetLogs) (*responses.Logs, common.SystemError) { //nolint
 	if e.stopControl.IsStopping() {
 		return nil, responses.ToInternalError(fmt.Errorf("requested GetLogs with the enclave stopping"))
@@ -1087,7 +1388,7 @@ func (e *enclaveImpl) GetLogs(encryptedParams common.EncryptedParamsGetLogs) (*r
 	}
 
 	// extract, create and validate the VK encryption handler
-	vkHandler, err := createVKHandler(forAddress, paramList[0])
+	vkHandler, err := e.createVKHandler(forAddress, paramList[0])
 	if err != nil {
 		return responses.AsPlaintextError(fmt.Errorf("unable to create VK encryptor - %w", err)), nil
 	}
@@ -1145,7 +1446,7 @@ func (e *enclaveImpl) GetLogs(encryptedParams common.EncryptedParamsGetLogs) (*r
 // This is a copy of https://github.com/ethereum/go-ethereum/blob/master/internal/ethapi/api.go#L1055
 // there's a high complexity to the method due to geth business rules (which is mimic'd here)
 // once the work of obscuro gas mechanics is established this method should be simplified
-func (e *enclaveImpl) DoEstimateGas(args *gethapi.TransactionArgs, blkNumber *gethrpc.BlockNumber, gasCap uint64) (hexutil.Uint64, common.SystemError) { //nolint: gocognit
+func (e *enclaveImpl) DoEstimateGas(args *gethapi.TransactionArgs, blkNumber *gethrpc.BlockNumber, overrides *gethapi.StateOverride, blockOverrides *gethapi.BlockOverrides, gasCap uint64) (hexutil.Uint64, common.SystemError) { //nolint: gocognit
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var ( //nolint: revive
 		lo  = params.TxGas - 1
@@ -1191,6 +1492,10 @@ func (e *enclaveImpl) DoEstimateGas(args *gethapi.TransactionArgs, blkNumber *ge
 		if err != nil {
 			return 0, fmt.Errorf("unable to fetch account balance - %w", err)
 		}
+		// the funds of the sender might have been overridden for this estimation
+		if overriddenBalance := overrides.BalanceOverride(*args.From); overriddenBalance != nil {
+			balance = (*hexutil.Big)(overriddenBalance)
+		}
 
 		available := new(big.Int).Set(balance.ToInt())
 		if args.Value != nil {
@@ -1222,7 +1527,7 @@ func (e *enclaveImpl) DoEstimateGas(args *gethapi.TransactionArgs, blkNumber *ge
 	// Execute the binary search and hone in on an isGasEnough gas limit
 	for lo+1 < hi {
 		mid := (hi + lo) / 2
-		failed, _, err := e.isGasEnough(args, mid, blkNumber)
+		failed, _, err := e.isGasEnough(args, mid, blkNumber, overrides, blockOverrides)
 		// If the error is not nil(consensus error), it means the provided message
 		// call or transaction will never be accepted no matter how much gas it is
 		// assigned. Return the error directly, don't struggle any more.
@@ -1237,7 +1542,7 @@ func (e *enclaveImpl) DoEstimateGas(args *gethapi.TransactionArgs, blkNumber *ge
 	}
 	// Reject the transaction as invalid if it still fails at the highest allowance
 	if hi == cap { //nolint:nestif
-		failed, result, err := e.isGasEnough(args, hi, blkNumber)
+		failed, result, err := e.isGasEnough(args, hi, blkNumber, overrides, blockOverrides)
 		if err != nil {
 			return 0, err
 		}
@@ -1351,7 +1656,7 @@ func (e *enclaveImpl) GetCustomQuery(encryptedParams common.EncryptedParamsGetSt
 	}
 
 	// extract, create and validate the VK encryption handler
-	vkHandler, err := createVKHandler(&privateCustomQuery.Address, paramList[0])
+	vkHandler, err := e.createVKHandler(&privateCustomQuery.Address, paramList[0])
 	if err != nil {
 		return responses.AsPlaintextError(fmt.Errorf("unable to create VK encryptor - %w", err)), nil
 	}
@@ -1396,11 +1701,24 @@ func (e *enclaveImpl) GetPublicTransactionData(pagination *common.QueryPaginatio
 	}, nil
 }
 
+func (e *enclaveImpl) GetBridgeLedger() ([]common.BridgeTokenBalance, common.SystemError) {
+	// ensure the enclave is running
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetBridgeLedger with the enclave stopping"))
+	}
+
+	ledger, err := e.crossChainProcessors.Bridge.GetLedger()
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("unable to compute the bridge ledger - %w", err))
+	}
+	return ledger, nil
+}
+
 // Create a helper to check if a gas allowance results in an executable transaction
 // isGasEnough returns whether the gaslimit should be raised, lowered, or if it was impossible to execute the message
-func (e *enclaveImpl) isGasEnough(args *gethapi.TransactionArgs, gas uint64, blkNumber *gethrpc.BlockNumber) (bool, *gethcore.ExecutionResult, error) {
+func (e *enclaveImpl) isGasEnough(args *gethapi.TransactionArgs, gas uint64, blkNumber *gethrpc.BlockNumber, overrides *gethapi.StateOverride, blockOverrides *gethapi.BlockOverrides) (bool, *gethcore.ExecutionResult, error) {
 	args.Gas = (*hexutil.Uint64)(&gas)
-	result, err := e.chain.ObsCallAtBlock(args, blkNumber)
+	result, err := e.chain.ObsCallAtBlock(args, blkNumber, overrides, blockOverrides)
 	if err != nil {
 		if errors.Is(err, gethcore.ErrIntrinsicGas) {
 			return true, nil, nil // Special case, raise gas limit
@@ -1452,6 +1770,44 @@ func (e *enclaveImpl) checkGas(tx *types.Transaction) error {
 	return nil
 }
 
+// Returns the fields of the receipt together with the L1 data fee paid by the transaction, which geth's receipt has no
+// fields for. The fee is not stored, as it is recalculated from the transaction and the L1 block of the batch.
+func (e *enclaveImpl) addL1DataFee(tx *common.L2Tx, receipt *types.Receipt) (map[string]interface{}, error) {
+	l1BaseFee, err := e.l1BaseFee(receipt.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	l1DataFee, l1DataGas, err := common.L1DataFee(tx, l1BaseFee)
+	if err != nil {
+		return nil, err
+	}
+
+	receiptJSON, err := receipt.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal receipt to JSON. Cause: %w", err)
+	}
+	fields := map[string]interface{}{}
+	if err = json.Unmarshal(receiptJSON, &fields); err != nil {
+		return nil, fmt.Errorf("could not unmarshal receipt fields. Cause: %w", err)
+	}
+	fields["l1Fee"] = (*hexutil.Big)(l1DataFee)
+	fields["l1GasUsed"] = hexutil.Uint64(l1DataGas)
+	return fields, nil
+}
+
+// Returns the base fee of the L1 block the batch was built on, which prices the L1 data fee of its transactions
+func (e *enclaveImpl) l1BaseFee(batchHash common.L2BatchHash) (*big.Int, error) {
+	batch, err := e.storage.FetchBatch(batchHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve batch %s. Cause: %w", batchHash, err)
+	}
+	block, err := e.storage.FetchBlock(batch.Header.L1Proof)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve block %s. Cause: %w", batch.Header.L1Proof, err)
+	}
+	return block.BaseFee(), nil
+}
+
 // Returns the params extracted from an eth_getLogs request.
 func extractGetLogsParams(paramList []interface{}) (*filters.FilterCriteria, *gethcommon.Address, error) {
 	// We extract the first param, the filter for the logs.
@@ -1477,6 +1833,20 @@ func extractGetLogsParams(paramList []interface{}) (*filters.FilterCriteria, *ge
 	return &filter, &forAddress, nil
 }
 
+// Returns the optional state and block overrides of an eth_call or eth_estimateGas request, starting at the given index.
+func extractCallOverrides(paramList []interface{}, idx int) (*gethapi.StateOverride, *gethapi.BlockOverrides, error) {
+	stateOverrides, err := gethencoding.ExtractOptionalStateOverride(paramList, idx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to extract state overrides - %w", err)
+	}
+
+	blockOverrides, err := gethencoding.ExtractOptionalBlockOverrides(paramList, idx+1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to extract block overrides - %w", err)
+	}
+	return stateOverrides, blockOverrides, nil
+}
+
 func (e *enclaveImpl) rejectBlockErr(cause error) *errutil.BlockRejectError {
 	var hash common.L1BlockHash
 	l1Head, err := e.storage.FetchHeadBlock()
@@ -1606,15 +1976,23 @@ func replayBatchesToValidState(storage storage.Storage, batchExecutor components
 	return nil
 }
 
-func createVKHandler(address *gethcommon.Address, vkIntf interface{}) (*vkhandler.VKHandler, error) {
+func (e *enclaveImpl) createVKHandler(address *gethcommon.Address, vkIntf interface{}) (*vkhandler.VKHandler, error) {
 	vkPubKeyHexBytes, accountSignatureHexBytes, err := gethencoding.ExtractViewingKey(vkIntf)
 	if err != nil {
 		return nil, fmt.Errorf("unable to decode viewing key - %w", err)
 	}
 
-	encryptor, err := vkhandler.New(address, vkPubKeyHexBytes, accountSignatureHexBytes)
+	encryptor, err := vkhandler.New(address, vkPubKeyHexBytes, accountSignatureHexBytes, e.config.ObscuroChainID, e.config.AllowLegacyViewingKeySignatures)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create vk encryption for request - %w", err)
 	}
+
+	revoked, err := e.storage.IsViewingKeyRevoked(vkPubKeyHexBytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to check if the viewing key was revoked - %w", err)
+	}
+	if revoked {
+		return nil, vkhandler.ErrViewingKeyRevoked
+	}
 	return encryptor, nil
 }
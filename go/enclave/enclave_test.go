@@ -17,11 +17,13 @@ import (
 	"github.com/ethereum/go-ethereum/trie"
 	"github.com/obscuronet/go-obscuro/contracts/generated/ManagementContract"
 	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/gethapi"
 	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/common/viewingkey"
 	"github.com/obscuronet/go-obscuro/go/config"
 	"github.com/obscuronet/go-obscuro/go/enclave/core"
 	"github.com/obscuronet/go-obscuro/go/enclave/genesis"
+	"github.com/obscuronet/go-obscuro/go/enclave/vkhandler"
 	"github.com/obscuronet/go-obscuro/go/obsclient"
 	"github.com/obscuronet/go-obscuro/go/responses"
 	"github.com/obscuronet/go-obscuro/go/wallet"
@@ -259,6 +261,270 @@ func gasEstimateInvalidParamParsing(t *testing.T, w wallet.Wallet, enclave commo
 	}
 }
 
+// TestObsCallWithOverrides checks that the state and block overrides are applied to the call
+func TestObsCallWithOverrides(t *testing.T) {
+	testEnclave, err := createTestEnclave(nil, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := datagenerator.RandomWallet(integration.ObscuroChainID)
+	vk, err := viewingkey.GenerateViewingKeyForWallet(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the contract returns the current block number: NUMBER PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	code := hexutil.Bytes(gethcommon.FromHex("0x4360005260206000f3"))
+	to := datagenerator.RandomAddress()
+	overriddenNumber := hexutil.Big(*big.NewInt(0x1234))
+
+	req := []interface{}{
+		[]interface{}{
+			hexutil.Encode(vk.PublicKey),
+			hexutil.Encode(vk.Signature),
+		},
+		obsclient.ToCallArg(ethereum.CallMsg{From: w.Address(), To: &to}),
+		"latest",
+		gethapi.StateOverride{to: gethapi.OverrideAccount{Code: &code}},
+		gethapi.BlockOverrides{Number: &overriddenNumber},
+	}
+	reqBytes, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encryptedParams, err := ecies.Encrypt(rand.Reader, _enclavePubKey, reqBytes, nil, nil)
+	if err != nil {
+		t.Fatalf("could not encrypt the following request params with enclave public key - %s", err)
+	}
+
+	resp, _ := testEnclave.ObsCall(encryptedParams)
+	if resp.Error() != nil {
+		t.Fatal(resp.Error())
+	}
+	decryptedResult, err := vk.PrivateKey.Decrypt(resp.EncUserResponse, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := responses.DecodeResponse[string](decryptedResult)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	returnedNumber := new(big.Int).SetBytes(gethcommon.FromHex(*result))
+	assert.Equal(t, overriddenNumber.ToInt(), returnedNumber)
+
+	// the overrides must not have leaked into the persisted state
+	headBatch, err := testEnclave.(*enclaveImpl).storage.FetchHeadBatch()
+	if err != nil {
+		t.Fatal(err)
+	}
+	headBatchHash := headBatch.Hash()
+	persistedCode, sysErr := testEnclave.GetCode(to, &headBatchHash)
+	assert.Nil(t, sysErr)
+	assert.Empty(t, persistedCode)
+}
+
+func TestSimulateCalls(t *testing.T) {
+	testEnclave, err := createTestEnclave(nil, 201)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := datagenerator.RandomWallet(integration.ObscuroChainID)
+	vk, err := viewingkey.GenerateViewingKeyForWallet(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the counter increments storage slot 0 and returns the new value:
+	// PUSH1 0 SLOAD PUSH1 1 ADD DUP1 PUSH1 0 SSTORE PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	counterCode := hexutil.Bytes(gethcommon.FromHex("0x6000546001018060005560005260206000f3"))
+	counter := datagenerator.RandomAddress()
+	// the reverter always reverts: PUSH1 0 PUSH1 0 REVERT
+	reverterCode := hexutil.Bytes(gethcommon.FromHex("0x60006000fd"))
+	reverter := datagenerator.RandomAddress()
+
+	req := []interface{}{
+		[]interface{}{
+			hexutil.Encode(vk.PublicKey),
+			hexutil.Encode(vk.Signature),
+		},
+		[]interface{}{
+			obsclient.ToCallArg(ethereum.CallMsg{From: w.Address(), To: &counter}),
+			obsclient.ToCallArg(ethereum.CallMsg{From: w.Address(), To: &counter}),
+			obsclient.ToCallArg(ethereum.CallMsg{From: w.Address(), To: &reverter}),
+			obsclient.ToCallArg(ethereum.CallMsg{From: w.Address(), To: &counter}),
+		},
+		"latest",
+		gethapi.StateOverride{
+			counter:  gethapi.OverrideAccount{Code: &counterCode},
+			reverter: gethapi.OverrideAccount{Code: &reverterCode},
+		},
+	}
+	reqBytes, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encryptedParams, err := ecies.Encrypt(rand.Reader, _enclavePubKey, reqBytes, nil, nil)
+	if err != nil {
+		t.Fatalf("could not encrypt the following request params with enclave public key - %s", err)
+	}
+
+	resp, _ := testEnclave.SimulateCalls(encryptedParams)
+	if resp.Error() != nil {
+		t.Fatal(resp.Error())
+	}
+	decryptedResult, err := vk.PrivateKey.Decrypt(resp.EncUserResponse, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := responses.DecodeResponse[[]common.SimulatedCallResult](decryptedResult)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*results) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(*results))
+	}
+
+	// each call sees the state changes of the previous ones, and the failed call does not abort the simulation
+	for i, expectedCount := range map[int]int64{0: 1, 1: 2, 3: 3} {
+		result := (*results)[i]
+		assert.Equal(t, hexutil.Uint64(types.ReceiptStatusSuccessful), result.Status)
+		assert.Nil(t, result.Error)
+		assert.Equal(t, big.NewInt(expectedCount), new(big.Int).SetBytes(result.ReturnData))
+	}
+	assert.Equal(t, hexutil.Uint64(types.ReceiptStatusFailed), (*results)[2].Status)
+	assert.NotNil(t, (*results)[2].Error)
+}
+
+func TestCreateAccessList(t *testing.T) {
+	w := datagenerator.RandomWallet(integration.ObscuroChainID)
+	vk, err := viewingkey.GenerateViewingKeyForWallet(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the contract reads storage slot 1, and the balance of another account:
+	// PUSH1 1 SLOAD POP PUSH20 <account> BALANCE POP STOP
+	account := datagenerator.RandomAddress()
+	code := append(append(gethcommon.FromHex("0x6001545073"), account.Bytes()...), gethcommon.FromHex("0x315000")...)
+	contract := datagenerator.RandomAddress()
+
+	testEnclave, err := createTestEnclaveWithContracts(nil, map[gethcommon.Address][]byte{contract: code}, 202)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := []interface{}{
+		[]interface{}{
+			hexutil.Encode(vk.PublicKey),
+			hexutil.Encode(vk.Signature),
+		},
+		obsclient.ToCallArg(ethereum.CallMsg{From: w.Address(), To: &contract}),
+		"latest",
+	}
+	reqBytes, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encryptedParams, err := ecies.Encrypt(rand.Reader, _enclavePubKey, reqBytes, nil, nil)
+	if err != nil {
+		t.Fatalf("could not encrypt the following request params with enclave public key - %s", err)
+	}
+
+	resp, _ := testEnclave.CreateAccessList(encryptedParams)
+	if resp.Error() != nil {
+		t.Fatal(resp.Error())
+	}
+	decryptedResult, err := vk.PrivateKey.Decrypt(resp.EncUserResponse, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := responses.DecodeResponse[common.AccessListResult](decryptedResult)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the sender and the called contract are warm by default, so only the accessed slot and account are listed
+	expectedAccessList := types.AccessList{
+		{Address: contract, StorageKeys: []gethcommon.Hash{gethcommon.BigToHash(big.NewInt(1))}},
+		{Address: account, StorageKeys: []gethcommon.Hash{}},
+	}
+	assert.ElementsMatch(t, expectedAccessList, *result.Accesslist)
+	assert.Empty(t, result.Error)
+	assert.NotZero(t, result.GasUsed)
+}
+
+func TestRevokeViewingKey(t *testing.T) {
+	w := datagenerator.RandomWallet(integration.ObscuroChainID)
+	vk, err := viewingkey.GenerateViewingKeyForWallet(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefundedAddresses := []genesis.Account{{Address: w.Address(), Amount: big.NewInt(123_000_000)}}
+
+	testEnclave, err := createTestEnclave(prefundedAddresses, 203)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encryptRequest := func(req []interface{}) []byte {
+		reqBytes, err := json.Marshal(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		encryptedParams, err := ecies.Encrypt(rand.Reader, _enclavePubKey, reqBytes, nil, nil)
+		if err != nil {
+			t.Fatalf("could not encrypt the following request params with enclave public key - %s", err)
+		}
+		return encryptedParams
+	}
+	vkParam := []interface{}{hexutil.Encode(vk.PublicKey), hexutil.Encode(vk.Signature)}
+	getBalanceParams := encryptRequest([]interface{}{vkParam, w.Address().Hex(), "latest"})
+
+	// the viewing key can be used before it is revoked
+	resp, _ := testEnclave.GetBalance(getBalanceParams)
+	if resp.Error() != nil {
+		t.Fatal(resp.Error())
+	}
+
+	// the revocation must be signed by the account which authorised the viewing key
+	resp, _ = testEnclave.RevokeViewingKey(encryptRequest([]interface{}{vkParam, datagenerator.RandomAddress().Hex()}))
+	assert.ErrorContains(t, resp.Error(), vkhandler.ErrInvalidAddressSignature.Error())
+
+	resp, _ = testEnclave.RevokeViewingKey(encryptRequest([]interface{}{vkParam, w.Address().Hex()}))
+	if resp.Error() != nil {
+		t.Fatal(resp.Error())
+	}
+	decryptedResult, err := vk.PrivateKey.Decrypt(resp.EncUserResponse, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	revoked, err := responses.DecodeResponse[bool](decryptedResult)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, *revoked)
+
+	// the revoked viewing key can no longer be used
+	resp, _ = testEnclave.GetBalance(getBalanceParams)
+	assert.ErrorContains(t, resp.Error(), vkhandler.ErrViewingKeyRevoked.Error())
+
+	// revoking it again is confirmed
+	resp, _ = testEnclave.RevokeViewingKey(encryptRequest([]interface{}{vkParam, w.Address().Hex()}))
+	if resp.Error() != nil {
+		t.Fatal(resp.Error())
+	}
+	decryptedResult, err = vk.PrivateKey.Decrypt(resp.EncUserResponse, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	revoked, err = responses.DecodeResponse[bool](decryptedResult)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, *revoked)
+}
+
 // TestGetBalance runs the GetBalance tests
 func TestGetBalance(t *testing.T) {
 	tests := map[string]func(t *testing.T, prefund []genesis.Account, enclave common.Enclave, vk *viewingkey.ViewingKey){
@@ -407,13 +673,24 @@ func getBalanceRequestUnsuccessful(t *testing.T, prefund []genesis.Account, encl
 
 // createTestEnclave returns a test instance of the enclave
 func createTestEnclave(prefundedAddresses []genesis.Account, idx int) (common.Enclave, error) {
+	return createTestEnclaveWithContracts(prefundedAddresses, nil, idx)
+}
+
+// createTestEnclaveWithContracts returns a test instance of the enclave, with the given code deployed in the genesis state
+func createTestEnclaveWithContracts(prefundedAddresses []genesis.Account, contracts map[gethcommon.Address][]byte, idx int) (common.Enclave, error) {
 	enclaveConfig := &config.EnclaveConfig{
-		HostID:         gethcommon.BigToAddress(big.NewInt(int64(idx))),
-		L1ChainID:      integration.EthereumChainID,
-		ObscuroChainID: integration.ObscuroChainID,
-		WillAttest:     false,
-		UseInMemoryDB:  true,
-		MinGasPrice:    big.NewInt(1),
+		HostID:                          gethcommon.BigToAddress(big.NewInt(int64(idx))),
+		L1ChainID:                       integration.EthereumChainID,
+		ObscuroChainID:                  integration.ObscuroChainID,
+		WillAttest:                      false,
+		UseInMemoryDB:                   true,
+		MinGasPrice:                     big.NewInt(1),
+		TargetGasPerBatch:               1_000_000_000,
+		ElasticityMultiplier:            2,
+		SequencerFeeRecipient:           gethcommon.BigToAddress(big.NewInt(1)),
+		AllowLegacyViewingKeySignatures: true,
+		ForcedTxInclusionWindow:         config.DefaultEnclaveConfig().ForcedTxInclusionWindow,
+		L1MaxReorgDepth:                 config.DefaultEnclaveConfig().L1MaxReorgDepth,
 	}
 	logger := log.New(log.TestLogCmp, int(gethlog.LvlError), log.SysOut)
 
@@ -428,7 +705,7 @@ func createTestEnclave(prefundedAddresses []genesis.Account, idx int) (common.En
 		return nil, err
 	}
 
-	err = createFakeGenesis(enclave, prefundedAddresses)
+	err = createFakeGenesis(enclave, prefundedAddresses, contracts)
 	if err != nil {
 		return nil, err
 	}
@@ -436,7 +713,7 @@ func createTestEnclave(prefundedAddresses []genesis.Account, idx int) (common.En
 	return enclave, nil
 }
 
-func createFakeGenesis(enclave common.Enclave, addresses []genesis.Account) error {
+func createFakeGenesis(enclave common.Enclave, addresses []genesis.Account, contracts map[gethcommon.Address][]byte) error {
 	// Random Layer 1 block where the genesis rollup is set
 	blk := types.NewBlock(&types.Header{}, nil, nil, nil, &trie.StackTrie{})
 	_, err := enclave.SubmitL1Block(*blk, make(types.Receipts, 0), true)
@@ -454,6 +731,10 @@ func createFakeGenesis(enclave common.Enclave, addresses []genesis.Account) erro
 		genesisPreallocStateDB.SetBalance(prefundedAddr.Address, prefundedAddr.Amount)
 	}
 
+	for address, code := range contracts {
+		genesisPreallocStateDB.SetCode(address, code)
+	}
+
 	_, err = genesisPreallocStateDB.Commit(0, false)
 	if err != nil {
 		return err
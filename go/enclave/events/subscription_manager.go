@@ -1,10 +1,12 @@
 package events
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/obscuronet/go-obscuro/go/enclave/core"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/enclave/rpc"
 	"github.com/obscuronet/go-obscuro/go/enclave/vkhandler"
 
@@ -36,14 +39,20 @@ type SubscriptionManager struct {
 	subscriptions     map[gethrpc.ID]*common.LogSubscription
 	subscriptionMutex *sync.RWMutex // the mutex guards the subscriptions/lastHead pair
 
+	obscuroChainID                  int64 // The chain ID the EIP-712 viewing key signatures are bound to
+	allowLegacyViewingKeySignatures bool
+
 	logger gethlog.Logger
 }
 
-func NewSubscriptionManager(rpcEncryptionManager *rpc.EncryptionManager, storage storage.Storage, logger gethlog.Logger) *SubscriptionManager {
+func NewSubscriptionManager(rpcEncryptionManager *rpc.EncryptionManager, storage storage.Storage, obscuroChainID int64, allowLegacyViewingKeySignatures bool, logger gethlog.Logger) *SubscriptionManager {
 	return &SubscriptionManager{
 		rpcEncryptionManager: rpcEncryptionManager,
 		storage:              storage,
 
+		obscuroChainID:                  obscuroChainID,
+		allowLegacyViewingKeySignatures: allowLegacyViewingKeySignatures,
+
 		subscriptions:     map[gethrpc.ID]*common.LogSubscription{},
 		subscriptionMutex: &sync.RWMutex{},
 		logger:            logger,
@@ -64,12 +73,20 @@ func (s *SubscriptionManager) AddSubscription(id gethrpc.ID, encryptedSubscripti
 	}
 
 	// create viewing key encryption handler for pushing future logs
-	encryptor, err := vkhandler.New(subscription.Account, subscription.PublicViewingKey, subscription.Signature)
+	encryptor, err := vkhandler.New(subscription.Account, subscription.PublicViewingKey, subscription.Signature, s.obscuroChainID, s.allowLegacyViewingKeySignatures)
 	if err != nil {
 		return fmt.Errorf("unable to create vk encryption for request - %w", err)
 	}
 	subscription.VkHandler = encryptor
 
+	revoked, err := s.storage.IsViewingKeyRevoked(subscription.PublicViewingKey)
+	if err != nil {
+		return fmt.Errorf("unable to check if the viewing key was revoked - %w", err)
+	}
+	if revoked {
+		return vkhandler.ErrViewingKeyRevoked
+	}
+
 	s.subscriptionMutex.Lock()
 	defer s.subscriptionMutex.Unlock()
 	s.subscriptions[id] = subscription
@@ -85,6 +102,18 @@ func (s *SubscriptionManager) RemoveSubscription(id gethrpc.ID) {
 	delete(s.subscriptions, id)
 }
 
+// RemoveSubscriptionsForViewingKey removes the log subscriptions authenticated with the given viewing key, once it
+// has been revoked.
+func (s *SubscriptionManager) RemoveSubscriptionsForViewingKey(viewingKey []byte) {
+	s.subscriptionMutex.Lock()
+	defer s.subscriptionMutex.Unlock()
+	for id, sub := range s.subscriptions {
+		if bytes.Equal(sub.PublicViewingKey, viewingKey) {
+			delete(s.subscriptions, id)
+		}
+	}
+}
+
 // FilterLogsForReceipt removes the logs that the sender of a transaction is not allowed to view
 func (s *SubscriptionManager) FilterLogsForReceipt(receipt *types.Receipt, account *gethcommon.Address) ([]*types.Log, error) {
 	filteredLogs := []*types.Log{}
@@ -137,6 +166,11 @@ func (s *SubscriptionManager) GetSubscribedLogsForBatch(batch *core.Batch, recei
 	userAddrsForLog := map[*types.Log][]*gethcommon.Address{}
 
 	for id, sub := range s.subscriptions {
+		// the viewing key may have expired, or been revoked by another enclave, since the subscription was created
+		if !s.isViewingKeyValid(sub) {
+			continue
+		}
+
 		// first filter the logs
 		filteredLogs := filterLogs(allLogs, sub.Filter.FromBlock, sub.Filter.ToBlock, sub.Filter.Addresses, sub.Filter.Topics, s.logger)
 
@@ -162,6 +196,41 @@ func (s *SubscriptionManager) GetSubscribedLogsForBatch(batch *core.Batch, recei
 	return s.encryptLogs(relevantLogsPerSubscription)
 }
 
+// FilterRelevantLogs removes the logs that the account is not allowed to view, using the same relevancy rules as the
+// ones applied when the logs are stored: an event is relevant to the end-user accounts referenced in its topics,
+// or to everyone if it doesn't reference any (a "lifecycle event").
+// It is used for logs that are never persisted, like the ones produced by simulated calls.
+func FilterRelevantLogs(logs []*types.Log, account *gethcommon.Address, stateDB *state.StateDB) []*types.Log {
+	relevantLogs := []*types.Log{}
+	for _, logItem := range logs {
+		var userAddrs []*gethcommon.Address
+		// We skip over the first topic, which is always the hash of the event.
+		for i := 1; i < len(logItem.Topics); i++ {
+			potentialAddr := common.ExtractPotentialAddress(logItem.Topics[i])
+			// If the address has code, it's a smart contract address instead.
+			if potentialAddr != nil && stateDB.GetCode(*potentialAddr) == nil {
+				userAddrs = append(userAddrs, potentialAddr)
+			}
+		}
+		if isRelevant(account, userAddrs) {
+			relevantLogs = append(relevantLogs, logItem)
+		}
+	}
+	return relevantLogs
+}
+
+func (s *SubscriptionManager) isViewingKeyValid(sub *common.LogSubscription) bool {
+	if err := vkhandler.CheckExpiry(sub.Signature, time.Now()); err != nil {
+		return false
+	}
+	revoked, err := s.storage.IsViewingKeyRevoked(sub.PublicViewingKey)
+	if err != nil {
+		s.logger.Error("Could not check if the viewing key of a subscription was revoked", log.ErrKey, err)
+		return false
+	}
+	return !revoked
+}
+
 func isRelevant(sub *gethcommon.Address, userAddrs []*gethcommon.Address) bool {
 	// If there are no user addresses, this is a lifecycle event, and is therefore relevant to everyone.
 	if len(userAddrs) == 0 {
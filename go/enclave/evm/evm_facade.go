@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/obscuronet/go-obscuro/go/common"
 	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/go/common/gethapi"
 	"github.com/obscuronet/go-obscuro/go/common/gethencoding"
 	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/enclave/crypto"
@@ -28,6 +29,8 @@ import (
 // ExecuteTransactions
 // header - the header of the rollup where this transaction will be included
 // fromTxIndex - for the receipts and events, the evm needs to know for each transaction the order in which it was executed in the block.
+// feeRecipient - the address receiving the priority fees and the L1 data fees paid by the transactions
+// l1BaseFee - the base fee of the L1 block the batch is built on, used to charge the transactions for publishing them to the L1
 func ExecuteTransactions(
 	txs []*common.L2Tx,
 	s *state.StateDB,
@@ -35,9 +38,14 @@ func ExecuteTransactions(
 	storage storage.Storage,
 	chainConfig *params.ChainConfig,
 	fromTxIndex int,
+	feeRecipient gethcommon.Address,
+	l1BaseFee *big.Int,
 	logger gethlog.Logger,
 ) map[common.TxHash]interface{} {
-	chain, vmCfg, gp := initParams(storage, true, logger)
+	// the base fee is enforced for all the transactions which set a gas price, while the ones that don't (e.g. the synthetic transactions) are free
+	chain, vmCfg, _ := initParams(storage, true, logger)
+	// the transactions that don't fit in the gas limit of the batch are excluded
+	gp := new(gethcore.GasPool).AddGas(header.GasLimit)
 	zero := uint64(0)
 	usedGas := &zero
 	result := map[common.TxHash]interface{}{}
@@ -59,6 +67,8 @@ func ExecuteTransactions(
 			t,
 			usedGas,
 			vmCfg,
+			feeRecipient,
+			l1BaseFee,
 			fromTxIndex+i,
 			hash,
 			header.Number.Uint64(),
@@ -84,6 +94,8 @@ func executeTransaction(
 	t *common.L2Tx,
 	usedGas *uint64,
 	vmCfg vm.Config,
+	feeRecipient gethcommon.Address,
+	l1BaseFee *big.Int,
 	tCount int,
 	batchHash common.L2BatchHash,
 	batchHeight uint64,
@@ -97,10 +109,23 @@ func executeTransaction(
 	snap := s.Snapshot()
 	s.SetTxContext(t.Hash(), tCount)
 
+	// the L1 data fee is charged upfront, and it is kept even if the execution reverts
+	l1DataFee, _, err := common.L1DataFee(t, l1BaseFee)
+	if err != nil {
+		return nil, err
+	}
+	if l1DataFee.Sign() > 0 {
+		if have := s.GetBalance(from); have.Cmp(l1DataFee) < 0 {
+			return nil, fmt.Errorf("%w: address %v have %v want %v for the l1 data fee", gethcore.ErrInsufficientFunds, from.Hex(), have, l1DataFee)
+		}
+		s.SubBalance(from, l1DataFee)
+		s.AddBalance(feeRecipient, l1DataFee)
+	}
+
 	before := header.MixDigest
 	// calculate a random value per transaction
 	header.MixDigest = crypto.CalculateTxRnd(before.Bytes(), tCount)
-	receipt, err := gethcore.ApplyTransaction(cc, chain, nil, gp, s, header, t, usedGas, vmCfg)
+	receipt, err := gethcore.ApplyTransaction(cc, chain, &feeRecipient, gp, s, header, t, usedGas, vmCfg)
 
 	// adjust the receipt to point to the right batch hash
 	if receipt != nil {
@@ -138,20 +163,26 @@ func logReceipt(r *types.Receipt, logger gethlog.Logger) {
 }
 
 // ExecuteObsCall - executes the eth_call call
+// The tracer is optional, and is used by the calls that need to inspect the execution (e.g. eth_createAccessList).
 func ExecuteObsCall(
 	msg *gethcore.Message,
 	s *state.StateDB,
 	header *common.BatchHeader,
+	blockOverrides *gethapi.BlockOverrides,
+	tracer vm.EVMLogger,
 	storage storage.Storage,
 	chainConfig *params.ChainConfig,
 	logger gethlog.Logger,
 ) (*gethcore.ExecutionResult, error) {
 	chain, vmCfg, gp := initParams(storage, true, nil)
+	vmCfg.Tracer = tracer
 	ethHeader, err := gethencoding.CreateEthHeaderForBatch(header, secret(storage))
 	if err != nil {
 		return nil, err
 	}
 	blockContext := gethcore.NewEVMBlockContext(ethHeader, chain, nil)
+	// the overrides only apply to the context of this call
+	blockOverrides.Apply(&blockContext)
 
 	// sets TxKey.origin
 	txContext := gethcore.NewEVMTxContext(msg)
@@ -169,8 +200,9 @@ func ExecuteObsCall(
 	}
 
 	// If the result contains a revert reason, try to unpack and return it.
+	// The result is returned as well, as the gas used is still relevant to the callers.
 	if result != nil && len(result.Revert()) > 0 {
-		return nil, newRevertError(result)
+		return result, newRevertError(result)
 	}
 
 	if err != nil {
@@ -0,0 +1,145 @@
+package l1chain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/enclave/storage"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+// HeaderChain tracks the L1 chain ingested by the enclave, in the style of geth's light chain: only the headers are
+// validated against their parent, and the canonical index is kept in the block table of the storage.
+//
+// After the merge, the fork choice is made by the consensus layer, so there is no total difficulty to compare. The host
+// feeds the blocks in the order the L1 node made them canonical, so each inserted block becomes the new head, as long as
+// the reorg it causes is not deeper than the maximum reorg depth. The non-canonical blocks deeper than that can never
+// become canonical again, so they are pruned.
+type HeaderChain struct {
+	storage       storage.Storage
+	maxReorgDepth uint64
+	logger        gethlog.Logger
+}
+
+func NewHeaderChain(storage storage.Storage, maxReorgDepth uint64, logger gethlog.Logger) *HeaderChain {
+	return &HeaderChain{
+		storage:       storage,
+		maxReorgDepth: maxReorgDepth,
+		logger:        logger,
+	}
+}
+
+// ChainFork validates the header of the block against its parent, and returns the fork caused by the block becoming
+// the head of the chain. It returns nil if the block extends the current head.
+func (hc *HeaderChain) ChainFork(block *types.Block) (*common.ChainFork, error) {
+	head, err := hc.storage.FetchHeadBlock()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head block. Cause: %w", err)
+	}
+
+	parent, err := hc.storage.FetchBlock(block.ParentHash())
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			hc.logger.Trace("parent not found",
+				"blkHeight", block.NumberU64(), log.BlockHashKey, block.Hash(),
+				"l1HeadHeight", head.NumberU64(), "l1HeadHash", head.Hash(),
+			)
+			return nil, errutil.ErrBlockAncestorNotFound
+		}
+		return nil, fmt.Errorf("could not retrieve parent block. Cause: %w", err)
+	}
+	if err = validateHeader(block.Header(), parent.Header()); err != nil {
+		return nil, err
+	}
+
+	if parent.Hash() == head.Hash() {
+		return nil, nil
+	}
+	return ComputeChainFork(block, head, hc.storage, hc.maxReorgDepth)
+}
+
+// Prune deletes the non-canonical blocks which are deeper than the maximum reorg depth below the head
+func (hc *HeaderChain) Prune(head *types.Block) error {
+	if head.NumberU64() <= hc.maxReorgDepth {
+		return nil
+	}
+	pruned, err := hc.storage.DeleteNonCanonicalBlocks(head.NumberU64() - hc.maxReorgDepth)
+	if err != nil {
+		return fmt.Errorf("could not prune non-canonical blocks. Cause: %w", err)
+	}
+	if len(pruned) > 0 {
+		hc.logger.Info("Pruned non-canonical blocks", log.BlockHeightKey, head.NumberU64(), "count", len(pruned))
+	}
+	return nil
+}
+
+// ComputeChainFork returns the fork caused by newHead replacing oldHead as the head of the chain. The canonical path
+// contains newHead and its ancestors down to the common ancestor, and the non-canonical path contains oldHead and its
+// ancestors down to the common ancestor, both excluding it. It returns errutil.ErrReorgTooDeep if more than maxDepth
+// blocks become non-canonical.
+func ComputeChainFork(newHead *types.Block, oldHead *types.Block, resolver storage.BlockResolver, maxDepth uint64) (*common.ChainFork, error) {
+	var canonicalPath, nonCanonicalPath []common.L1BlockHash
+	newBlock, oldBlock := newHead, oldHead
+	var err error
+	for newBlock.Hash() != oldBlock.Hash() {
+		// the higher branch is walked back first, both branches are walked back once they are at the same height
+		if newBlock.NumberU64() >= oldBlock.NumberU64() {
+			canonicalPath = append(canonicalPath, newBlock.Hash())
+			if newBlock, err = fetchParent(newBlock, resolver); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		nonCanonicalPath = append(nonCanonicalPath, oldBlock.Hash())
+		if uint64(len(nonCanonicalPath)) > maxDepth {
+			return nil, fmt.Errorf("new head %s replaces more than %d blocks of head %s. Cause: %w", newHead.Hash(), maxDepth, oldHead.Hash(), errutil.ErrReorgTooDeep)
+		}
+		if oldBlock, err = fetchParent(oldBlock, resolver); err != nil {
+			return nil, err
+		}
+	}
+
+	return &common.ChainFork{
+		NewCanonical:     newHead,
+		OldCanonical:     oldHead,
+		CommonAncestor:   newBlock,
+		CanonicalPath:    canonicalPath,
+		NonCanonicalPath: nonCanonicalPath,
+	}, nil
+}
+
+func fetchParent(block *types.Block, resolver storage.BlockResolver) (*types.Block, error) {
+	parent, err := resolver.FetchBlock(block.ParentHash())
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, fmt.Errorf("no common ancestor found for block %s. Cause: %w", block.Hash(), errutil.ErrBlockAncestorNotFound)
+		}
+		return nil, fmt.Errorf("could not retrieve parent block. Cause: %w", err)
+	}
+	return parent, nil
+}
+
+// validateHeader checks the header fields which don't depend on the consensus engine, the way geth's header chain does
+// after the merge
+func validateHeader(header *types.Header, parent *types.Header) error {
+	if header.Number.Uint64() != parent.Number.Uint64()+1 {
+		return fmt.Errorf("block %s has height %d but its parent has height %d. Cause: %w", header.Hash(), header.Number, parent.Number, errutil.ErrInvalidBlockHeader)
+	}
+	if header.Time < parent.Time {
+		return fmt.Errorf("block %s has a timestamp older than its parent. Cause: %w", header.Hash(), errutil.ErrInvalidBlockHeader)
+	}
+	if header.GasUsed > header.GasLimit {
+		return fmt.Errorf("block %s used more gas than its limit. Cause: %w", header.Hash(), errutil.ErrInvalidBlockHeader)
+	}
+	if uint64(len(header.Extra)) > params.MaximumExtraDataSize {
+		return fmt.Errorf("block %s has too much extra data. Cause: %w", header.Hash(), errutil.ErrInvalidBlockHeader)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package l1chain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/enclave/core"
+	"github.com/obscuronet/go-obscuro/go/enclave/storage"
+	"github.com/obscuronet/go-obscuro/go/enclave/storage/init/sqlite"
+	"github.com/obscuronet/go-obscuro/integration/datagenerator"
+	"github.com/obscuronet/go-obscuro/integration/ethereummock"
+	"github.com/stretchr/testify/require"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+func TestChainForkFollowsDeepReorg(t *testing.T) {
+	hc := newTestHeaderChain(t, 64)
+	chain := mockL1Chain(10)
+	for _, block := range chain {
+		require.Nil(t, insertBlock(t, hc, block))
+	}
+
+	// a branch forking from the 4th block replaces the rest of the chain as soon as its first block is inserted, even if
+	// it is shorter, since there is no total difficulty to compare
+	branch := mockBranch(chain[3], 9)
+	fork := insertBlock(t, hc, branch[0])
+	require.NotNil(t, fork)
+	require.True(t, fork.IsFork())
+	require.Equal(t, chain[3].Hash(), fork.CommonAncestor.Hash())
+	require.Equal(t, []common.L1BlockHash{branch[0].Hash()}, fork.CanonicalPath)
+	require.Equal(t, hashes(reverse(chain[4:])), fork.NonCanonicalPath)
+
+	head, err := hc.storage.FetchHeadBlock()
+	require.NoError(t, err)
+	require.Equal(t, branch[0].Hash(), head.Hash())
+
+	for _, block := range branch[1:] {
+		require.Nil(t, insertBlock(t, hc, block))
+	}
+
+	// the canonical index follows the branch from the common ancestor
+	canonical := append(chain[:4:4], branch...)
+	for height, block := range canonical {
+		canonicalBlock, err := hc.storage.FetchCanonicaBlockByHeight(big.NewInt(int64(height)))
+		require.NoError(t, err)
+		require.Equal(t, block.Hash(), canonicalBlock.Hash())
+	}
+
+	// the original chain becomes canonical again as soon as one of its blocks is inserted, although it is shorter
+	next := ethereummock.NewBlock(chain[len(chain)-1], datagenerator.RandomAddress(), []*types.Transaction{})
+	fork = insertBlock(t, hc, next)
+	require.Equal(t, chain[3].Hash(), fork.CommonAncestor.Hash())
+	require.Equal(t, hashes(reverse(append(chain[4:], next))), fork.CanonicalPath)
+	require.Equal(t, hashes(reverse(branch)), fork.NonCanonicalPath)
+}
+
+func TestChainForkRefusesReorgsDeeperThanLimit(t *testing.T) {
+	hc := newTestHeaderChain(t, 3)
+	chain := mockL1Chain(7)
+	for _, block := range chain {
+		require.Nil(t, insertBlock(t, hc, block))
+	}
+
+	_, err := hc.ChainFork(ethereummock.NewBlock(chain[2], datagenerator.RandomAddress(), []*types.Transaction{}))
+	require.ErrorIs(t, err, errutil.ErrReorgTooDeep)
+
+	fork, err := hc.ChainFork(ethereummock.NewBlock(chain[3], datagenerator.RandomAddress(), []*types.Transaction{}))
+	require.NoError(t, err)
+	require.Len(t, fork.NonCanonicalPath, 3)
+}
+
+func TestChainForkValidatesHeaders(t *testing.T) {
+	hc := newTestHeaderChain(t, 64)
+	chain := mockL1Chain(3)
+	for _, block := range chain {
+		require.Nil(t, insertBlock(t, hc, block))
+	}
+	head := chain[len(chain)-1]
+
+	_, err := hc.ChainFork(ethereummock.NewBlock(ethereummock.NewBlock(head, datagenerator.RandomAddress(), nil), datagenerator.RandomAddress(), nil))
+	require.ErrorIs(t, err, errutil.ErrBlockAncestorNotFound)
+
+	invalidHeaders := map[string]func(header *types.Header){
+		"wrong height":        func(header *types.Header) { header.Number = big.NewInt(int64(head.NumberU64() + 2)) },
+		"too much gas used":   func(header *types.Header) { header.GasUsed = header.GasLimit + 1 },
+		"too much extra data": func(header *types.Header) { header.Extra = make([]byte, 33) },
+	}
+	for name, invalidate := range invalidHeaders {
+		header := ethereummock.NewBlock(head, datagenerator.RandomAddress(), nil).Header()
+		invalidate(header)
+		_, err = hc.ChainFork(types.NewBlockWithHeader(header))
+		require.ErrorIs(t, err, errutil.ErrInvalidBlockHeader, name)
+	}
+
+	// the timestamp can only be checked once the parent has one
+	parent := ethereummock.NewBlock(head, datagenerator.RandomAddress(), nil).Header()
+	parent.Time = 10
+	require.Nil(t, insertBlock(t, hc, types.NewBlockWithHeader(parent)))
+	header := ethereummock.NewBlock(types.NewBlockWithHeader(parent), datagenerator.RandomAddress(), nil).Header()
+	header.Time = 9
+	_, err = hc.ChainFork(types.NewBlockWithHeader(header))
+	require.ErrorIs(t, err, errutil.ErrInvalidBlockHeader)
+}
+
+func TestPruneDeletesOldNonCanonicalBlocks(t *testing.T) {
+	hc := newTestHeaderChain(t, 2)
+	chain := mockL1Chain(4)
+	for _, block := range chain {
+		require.Nil(t, insertBlock(t, hc, block))
+	}
+	// a batch built on the third block keeps it from being pruned
+	batch := &core.Batch{
+		Header: &common.BatchHeader{
+			L1Proof:          chain[2].Hash(),
+			Number:           big.NewInt(0),
+			SequencerOrderNo: big.NewInt(1),
+			Root:             types.EmptyRootHash,
+			ReceiptHash:      types.EmptyRootHash,
+		},
+	}
+	require.NoError(t, hc.storage.StoreBatch(batch))
+
+	branch := mockBranch(chain[1], 6)
+	for _, block := range branch {
+		insertBlock(t, hc, block)
+		require.NoError(t, hc.Prune(block))
+	}
+
+	// the blocks of the branch and the block with a batch are kept, the other replaced block is pruned
+	_, err := hc.storage.FetchBlock(chain[3].Hash())
+	require.ErrorIs(t, err, errutil.ErrNotFound)
+	_, err = hc.storage.FetchBlock(chain[2].Hash())
+	require.NoError(t, err)
+	for _, block := range append(chain[:2:2], branch...) {
+		_, err = hc.storage.FetchBlock(block.Hash())
+		require.NoError(t, err)
+	}
+}
+
+func newTestHeaderChain(t *testing.T, maxReorgDepth uint64) *HeaderChain {
+	logger := log.New(log.TestLogCmp, int(gethlog.LvlError), log.SysOut)
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	require.NoError(t, err)
+	return NewHeaderChain(storage.NewStorage(backingDB, nil, logger), maxReorgDepth, logger)
+}
+
+// insertBlock computes the fork caused by the block and stores it, the way the block processor does
+func insertBlock(t *testing.T, hc *HeaderChain, block *types.Block) *common.ChainFork {
+	var fork *common.ChainFork
+	if block.NumberU64() != common.L1GenesisHeight {
+		var err error
+		fork, err = hc.ChainFork(block)
+		require.NoError(t, err)
+	}
+	require.NoError(t, hc.storage.StoreBlock(block, fork))
+	return fork
+}
+
+// mockL1Chain returns a chain of blocks produced by the mock L1 miner, starting with its genesis block
+func mockL1Chain(length int) []*types.Block {
+	return append([]*types.Block{ethereummock.MockGenesisBlock}, mockBranch(ethereummock.MockGenesisBlock, length-1)...)
+}
+
+// mockBranch returns a chain of blocks produced by the mock L1 miner on top of the parent
+func mockBranch(parent *types.Block, length int) []*types.Block {
+	branch := make([]*types.Block, 0, length)
+	for len(branch) < length {
+		parent = ethereummock.NewBlock(parent, datagenerator.RandomAddress(), []*types.Transaction{})
+		branch = append(branch, parent)
+	}
+	return branch
+}
+
+func reverse(blocks []*types.Block) []*types.Block {
+	reversed := make([]*types.Block, len(blocks))
+	for i, block := range blocks {
+		reversed[len(blocks)-1-i] = block
+	}
+	return reversed
+}
+
+func hashes(blocks []*types.Block) []common.L1BlockHash {
+	result := make([]common.L1BlockHash, len(blocks))
+	for i, block := range blocks {
+		result[i] = block.Hash()
+	}
+	return result
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/vm"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/obscuronet/go-obscuro/go/common"
 	"github.com/obscuronet/go-obscuro/go/common/gethapi"
 	"github.com/obscuronet/go-obscuro/go/enclave/core"
 )
@@ -22,10 +23,19 @@ type ObscuroChain interface {
 	GetBalanceAtBlock(accountAddr gethcommon.Address, blockNumber *gethrpc.BlockNumber) (*hexutil.Big, error)
 
 	// ObsCall - The interface for executing eth_call RPC commands against obscuro.
-	ObsCall(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber) (*gethcore.ExecutionResult, error)
+	// The state and block overrides are optional and only apply to the ephemeral state of this call.
+	ObsCall(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber, overrides *gethapi.StateOverride, blockOverrides *gethapi.BlockOverrides) (*gethcore.ExecutionResult, error)
 
 	// ObsCallAtBlock - Execute eth_call RPC against obscuro for a specific block (batch) number.
-	ObsCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber) (*gethcore.ExecutionResult, error)
+	ObsCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber, overrides *gethapi.StateOverride, blockOverrides *gethapi.BlockOverrides) (*gethcore.ExecutionResult, error)
+
+	// SimulateCalls - Executes the calls in order on top of the state of the given block (batch) number, carrying the
+	// state changes between them. The logs of each result are filtered with the relevancy rules for the account.
+	SimulateCalls(account *gethcommon.Address, calls []*gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber, overrides *gethapi.StateOverride) ([]*common.SimulatedCallResult, error)
+
+	// CreateAccessList - Returns the access list of the call executed against the given block (batch) number, together
+	// with the gas it used when the access list is attached.
+	CreateAccessList(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber) (*common.AccessListResult, error)
 
 	// GetChainStateAtTransaction - returns the stateDB after applying all the transactions in the batch leading to the desired transaction.
 	GetChainStateAtTransaction(batch *core.Batch, txIndex int, reexec uint64) (*gethcore.Message, vm.BlockContext, *state.StateDB, error)
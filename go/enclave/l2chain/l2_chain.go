@@ -13,14 +13,19 @@ import (
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	tracerlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
 	"github.com/obscuronet/go-obscuro/go/common/gethapi"
 	"github.com/obscuronet/go-obscuro/go/common/gethencoding"
 	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/enclave/components"
 	"github.com/obscuronet/go-obscuro/go/enclave/core"
+	"github.com/obscuronet/go-obscuro/go/enclave/events"
 	"github.com/obscuronet/go-obscuro/go/enclave/evm"
 	"github.com/obscuronet/go-obscuro/go/enclave/genesis"
 	"github.com/status-im/keycard-go/hexutils"
@@ -105,8 +110,8 @@ func (oc *obscuroChain) GetBalanceAtBlock(accountAddr gethcommon.Address, blockN
 	return (*hexutil.Big)(chainState.GetBalance(accountAddr)), nil
 }
 
-func (oc *obscuroChain) ObsCall(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber) (*gethcore.ExecutionResult, error) {
-	result, err := oc.ObsCallAtBlock(apiArgs, blockNumber)
+func (oc *obscuroChain) ObsCall(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber, overrides *gethapi.StateOverride, blockOverrides *gethapi.BlockOverrides) (*gethcore.ExecutionResult, error) {
+	result, err := oc.ObsCallAtBlock(apiArgs, blockNumber, overrides, blockOverrides)
 	if err != nil {
 		oc.logger.Info(fmt.Sprintf("Obs_Call: failed to execute contract %s.", apiArgs.To), log.CtrErrKey, err.Error())
 		return nil, err
@@ -124,7 +129,7 @@ func (oc *obscuroChain) ObsCall(apiArgs *gethapi.TransactionArgs, blockNumber *g
 	return result, nil
 }
 
-func (oc *obscuroChain) ObsCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber) (*gethcore.ExecutionResult, error) {
+func (oc *obscuroChain) ObsCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber, overrides *gethapi.StateOverride, blockOverrides *gethapi.BlockOverrides) (*gethcore.ExecutionResult, error) {
 	// todo (#627) - review this during gas mechanics implementation
 	callMsg, err := apiArgs.ToMessage(oc.GlobalGasCap, oc.BaseFee)
 	if err != nil {
@@ -137,6 +142,11 @@ func (oc *obscuroChain) ObsCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNu
 		return nil, err
 	}
 
+	// the state is created for this call only, so the overrides are discarded together with it
+	if err = overrides.Apply(blockState); err != nil {
+		return nil, fmt.Errorf("unable to apply state overrides - %w", err)
+	}
+
 	batch, err := oc.Registry.GetBatchAtHeight(*blockNumber)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch head state batch. Cause: %w", err)
@@ -151,7 +161,7 @@ func (oc *obscuroChain) ObsCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNu
 			batch.Header.Root.Hex())
 	}})
 
-	result, err := evm.ExecuteObsCall(callMsg, blockState, batch.Header, oc.storage, oc.chainConfig, oc.logger)
+	result, err := evm.ExecuteObsCall(callMsg, blockState, batch.Header, blockOverrides, nil, oc.storage, oc.chainConfig, oc.logger)
 	if err != nil {
 		// also return the result as the result can be evaluated on some errors like ErrIntrinsicGas
 		return result, err
@@ -160,6 +170,127 @@ func (oc *obscuroChain) ObsCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNu
 	return result, nil
 }
 
+func (oc *obscuroChain) SimulateCalls(account *gethcommon.Address, calls []*gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber, overrides *gethapi.StateOverride) ([]*common.SimulatedCallResult, error) {
+	// all the calls are executed against the same ephemeral state, so each call observes the changes of the previous ones
+	blockState, err := oc.Registry.GetBatchStateAtHeight(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = overrides.Apply(blockState); err != nil {
+		return nil, fmt.Errorf("unable to apply state overrides - %w", err)
+	}
+
+	batch, err := oc.Registry.GetBatchAtHeight(*blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch head state batch. Cause: %w", err)
+	}
+	batchHash := batch.Hash()
+
+	results := make([]*common.SimulatedCallResult, len(calls))
+	logCount := 0
+	for i, call := range calls {
+		callMsg, err := call.ToMessage(oc.GlobalGasCap, oc.BaseFee)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert call %d to Message - %w", i, err)
+		}
+
+		// the simulated calls are not transactions, so their logs are all collected under the empty hash
+		blockState.SetTxContext(gethcommon.Hash{}, i)
+		execResult, err := evm.ExecuteObsCall(callMsg, blockState, batch.Header, nil, nil, oc.storage, oc.chainConfig, oc.logger)
+
+		callResult := &common.SimulatedCallResult{Status: hexutil.Uint64(types.ReceiptStatusSuccessful)}
+		if execResult != nil {
+			callResult.ReturnData = execResult.ReturnData
+			callResult.GasUsed = hexutil.Uint64(execResult.UsedGas)
+		}
+		// a failed call does not abort the simulation, the following calls are executed without its state changes
+		switch {
+		case err != nil:
+			callResult.Status = hexutil.Uint64(types.ReceiptStatusFailed)
+			callResult.Error = toSerialisableError(err)
+		case execResult.Failed():
+			callResult.Status = hexutil.Uint64(types.ReceiptStatusFailed)
+			callResult.Error = &errutil.EVMSerialisableError{Err: execResult.Err.Error()}
+		}
+		blockState.Finalise(true)
+
+		allLogs := blockState.GetLogs(gethcommon.Hash{}, batch.NumberU64(), batchHash)
+		callResult.Logs = events.FilterRelevantLogs(allLogs[logCount:], account, blockState)
+		logCount = len(allLogs)
+
+		results[i] = callResult
+	}
+
+	return results, nil
+}
+
+// CreateAccessList follows the approach of geth's `eth_createAccessList`: the call is traced repeatedly with the access
+// list produced by the previous run, until the access list doesn't change anymore.
+func (oc *obscuroChain) CreateAccessList(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber) (*common.AccessListResult, error) {
+	blockState, err := oc.Registry.GetBatchStateAtHeight(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := oc.Registry.GetBatchAtHeight(*blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch head state batch. Cause: %w", err)
+	}
+
+	from := gethcommon.Address{}
+	if apiArgs.From != nil {
+		from = *apiArgs.From
+	}
+	// for contract creations, the access list is relative to the address of the new contract
+	var to gethcommon.Address
+	if apiArgs.To != nil {
+		to = *apiArgs.To
+	} else {
+		to = crypto.CreateAddress(from, blockState.GetNonce(from))
+	}
+
+	// the precompiles are always warm, so they don't need to be added to the access list
+	precompiles := vm.ActivePrecompiles(oc.chainConfig.Rules(batch.Number(), true, batch.Header.Time))
+
+	var prevTracer *tracerlogger.AccessListTracer
+	if apiArgs.AccessList != nil {
+		prevTracer = tracerlogger.NewAccessListTracer(*apiArgs.AccessList, from, to, precompiles)
+	} else {
+		prevTracer = tracerlogger.NewAccessListTracer(nil, from, to, precompiles)
+	}
+
+	for {
+		accessList := prevTracer.AccessList()
+		apiArgs.AccessList = &accessList
+
+		callMsg, err := apiArgs.ToMessage(oc.GlobalGasCap, oc.BaseFee)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert TransactionArgs to Message - %w", err)
+		}
+
+		// each run starts from the original state, so the previous runs are not visible
+		tracer := tracerlogger.NewAccessListTracer(accessList, from, to, precompiles)
+		result, err := evm.ExecuteObsCall(callMsg, blockState.Copy(), batch.Header, nil, tracer, oc.storage, oc.chainConfig, oc.logger)
+		// a reverted call still produces an access list, so it is reported in the result, like in geth
+		if err != nil && result == nil {
+			return nil, fmt.Errorf("failed to apply transaction - %w", err)
+		}
+
+		if tracer.Equal(prevTracer) {
+			accessListResult := &common.AccessListResult{
+				Accesslist: &accessList,
+				GasUsed:    hexutil.Uint64(result.UsedGas),
+			}
+			if result.Err != nil {
+				accessListResult.Error = result.Err.Error()
+			}
+			return accessListResult, nil
+		}
+		prevTracer = tracer
+	}
+}
+
 // GetChainStateAtTransaction Returns the state of the chain at certain block height after executing transactions up to the selected transaction
 // TODO make this cacheable
 func (oc *obscuroChain) GetChainStateAtTransaction(batch *core.Batch, txIndex int, _ uint64) (*gethcore.Message, vm.BlockContext, *state.StateDB, error) {
@@ -188,24 +319,26 @@ func (oc *obscuroChain) GetChainStateAtTransaction(batch *core.Batch, txIndex in
 	rules := oc.chainConfig.Rules(big.NewInt(0), true, 0)
 	signer := types.LatestSigner(oc.chainConfig)
 	for idx, tx := range batch.Transactions {
+		chain := evm.NewObscuroChainContext(oc.storage, oc.logger)
+		blockHeader, err := gethencoding.CreateEthHeaderForBatch(batch.Header, nil)
+		if err != nil {
+			return nil, vm.BlockContext{}, nil, fmt.Errorf("unable to convert batch header to eth header - %w", err)
+		}
+
 		// Assemble the transaction call message and return if the requested offset
-		msg, err := gethcore.TransactionToMessage(tx, signer, big.NewInt(0))
+		msg, err := gethcore.TransactionToMessage(tx, signer, blockHeader.BaseFee)
 		if err != nil {
 			return nil, vm.BlockContext{}, nil, fmt.Errorf("unable to convert tx to message - %w", err)
 		}
 		txContext := gethcore.NewEVMTxContext(msg)
 
-		chain := evm.NewObscuroChainContext(oc.storage, oc.logger)
-		blockHeader, err := gethencoding.CreateEthHeaderForBatch(batch.Header, nil)
-		if err != nil {
-			return nil, vm.BlockContext{}, nil, fmt.Errorf("unable to convert batch header to eth header - %w", err)
-		}
 		context := gethcore.NewEVMBlockContext(blockHeader, chain, nil)
 		if idx == txIndex {
 			return msg, context, statedb, nil
 		}
 		// Not yet the searched for transaction, execute on top of the current state
-		vmenv := vm.NewEVM(context, txContext, statedb, oc.chainConfig, vm.Config{})
+		// mirrors the batch execution, where the transactions without a gas price are exempted from the base fee
+		vmenv := vm.NewEVM(context, txContext, statedb, oc.chainConfig, vm.Config{NoBaseFee: true})
 		statedb.Prepare(rules, msg.From, gethcommon.Address{}, tx.To(), nil, nil)
 		if _, err := gethcore.ApplyMessage(vmenv, msg, new(gethcore.GasPool).AddGas(tx.Gas())); err != nil {
 			return nil, vm.BlockContext{}, nil, fmt.Errorf("transaction %#x failed: %w", tx.Hash(), err)
@@ -226,3 +359,11 @@ func (oc *obscuroChain) isAccountContractAtBlock(accountAddr gethcommon.Address,
 
 	return len(chainState.GetCode(accountAddr)) > 0, nil
 }
+
+func toSerialisableError(err error) *errutil.EVMSerialisableError {
+	var evmErr *errutil.EVMSerialisableError
+	if errors.As(err, &evmErr) {
+		return evmErr
+	}
+	return &errutil.EVMSerialisableError{Err: err.Error()}
+}
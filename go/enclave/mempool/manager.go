@@ -13,6 +13,7 @@ import (
 
 	"github.com/obscuronet/go-obscuro/go/enclave/core"
 	"github.com/obscuronet/go-obscuro/go/enclave/limiters"
+	"github.com/obscuronet/go-obscuro/go/enclave/vkhandler"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/obscuronet/go-obscuro/go/common"
@@ -33,11 +34,13 @@ type mempoolManager struct {
 	obscuroChainID int64
 	logger         gethlog.Logger
 	mempool        map[gethcommon.Hash]*common.L2Tx
+	revocations    map[string]gethcommon.Hash // the pending revocation tx of each viewing key, so each is only included once
 }
 
 func New(chainID int64, logger gethlog.Logger) Manager {
 	return &mempoolManager{
 		mempool:        make(map[gethcommon.Hash]*common.L2Tx),
+		revocations:    make(map[string]gethcommon.Hash),
 		obscuroChainID: chainID,
 		mpMutex:        sync.RWMutex{},
 		logger:         logger,
@@ -52,12 +55,36 @@ func (db *mempoolManager) AddMempoolTx(tx *common.L2Tx) error {
 		return err
 	}
 
+	if vkhandler.IsRevocationTx(tx) {
+		return db.addRevocationTx(tx)
+	}
+
 	db.mpMutex.Lock()
 	defer db.mpMutex.Unlock()
 	db.mempool[tx.Hash()] = tx
 	return nil
 }
 
+// addRevocationTx adds the revocation tx, unless a revocation of the same viewing key is already pending.
+// The revocation txs are signed with throwaway keys, so they can't be deduplicated by hash.
+func (db *mempoolManager) addRevocationTx(tx *common.L2Tx) error {
+	revocation, err := vkhandler.DecodeRevocationTx(tx, db.obscuroChainID)
+	if err != nil {
+		return err
+	}
+
+	db.mpMutex.Lock()
+	defer db.mpMutex.Unlock()
+	viewingKey := string(revocation.ViewingKey)
+	if pendingTxHash, found := db.revocations[viewingKey]; found {
+		db.logger.Debug("Viewing key revocation already pending", log.TxKey, tx.Hash(), "pendingTx", pendingTxHash)
+		return nil
+	}
+	db.revocations[viewingKey] = tx.Hash()
+	db.mempool[tx.Hash()] = tx
+	return nil
+}
+
 func (db *mempoolManager) FetchMempoolTxs() []*common.L2Tx {
 	db.mpMutex.RLock()
 	defer db.mpMutex.RUnlock()
@@ -78,6 +105,11 @@ func (db *mempoolManager) RemoveTxs(transactions types.Transactions) error {
 	for _, tx := range transactions {
 		delete(db.mempool, tx.Hash())
 	}
+	for viewingKey, txHash := range db.revocations {
+		if _, found := db.mempool[txHash]; !found {
+			delete(db.revocations, viewingKey)
+		}
+	}
 
 	return nil
 }
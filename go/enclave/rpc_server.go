@@ -241,6 +241,51 @@ func (s *RPCServer) EstimateGas(_ context.Context, req *generated.EstimateGasReq
 	return &generated.EstimateGasResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
 }
 
+func (s *RPCServer) SimulateCalls(_ context.Context, req *generated.SimulateCallsRequest) (*generated.SimulateCallsResponse, error) {
+	enclaveResp, sysError := s.enclave.SimulateCalls(req.EncryptedParams)
+	if sysError != nil {
+		s.logger.Error("Error simulating calls", log.ErrKey, sysError)
+		return &generated.SimulateCallsResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.SimulateCallsResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
+}
+
+func (s *RPCServer) CreateAccessList(_ context.Context, req *generated.CreateAccessListRequest) (*generated.CreateAccessListResponse, error) {
+	enclaveResp, sysError := s.enclave.CreateAccessList(req.EncryptedParams)
+	if sysError != nil {
+		s.logger.Error("Error creating access list", log.ErrKey, sysError)
+		return &generated.CreateAccessListResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.CreateAccessListResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
+}
+
+func (s *RPCServer) GetInboundMessageStatus(_ context.Context, req *generated.GetInboundMessageStatusRequest) (*generated.GetInboundMessageStatusResponse, error) {
+	enclaveResp, sysError := s.enclave.GetInboundMessageStatus(req.EncryptedParams)
+	if sysError != nil {
+		s.logger.Error("Error getting inbound message status", log.ErrKey, sysError)
+		return &generated.GetInboundMessageStatusResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.GetInboundMessageStatusResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
+}
+
+func (s *RPCServer) GetOutboundMessages(_ context.Context, req *generated.GetOutboundMessagesRequest) (*generated.GetOutboundMessagesResponse, error) {
+	enclaveResp, sysError := s.enclave.GetOutboundMessages(req.EncryptedParams)
+	if sysError != nil {
+		s.logger.Error("Error getting outbound messages", log.ErrKey, sysError)
+		return &generated.GetOutboundMessagesResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.GetOutboundMessagesResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
+}
+
+func (s *RPCServer) RevokeViewingKey(_ context.Context, req *generated.RevokeViewingKeyRequest) (*generated.RevokeViewingKeyResponse, error) {
+	enclaveResp, sysError := s.enclave.RevokeViewingKey(req.EncryptedParams)
+	if sysError != nil {
+		s.logger.Error("Error revoking viewing key", log.ErrKey, sysError)
+		return &generated.RevokeViewingKeyResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.RevokeViewingKeyResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
+}
+
 func (s *RPCServer) GetLogs(_ context.Context, req *generated.GetLogsRequest) (*generated.GetLogsResponse, error) {
 	enclaveResp, sysError := s.enclave.GetLogs(req.EncryptedParams)
 	if sysError != nil {
@@ -338,6 +383,24 @@ func (s *RPCServer) GetBatchBySeqNo(_ context.Context, request *generated.GetBat
 	}, err
 }
 
+func (s *RPCServer) GetCrossChainProof(_ context.Context, request *generated.GetCrossChainProofRequest) (*generated.GetCrossChainProofResponse, error) {
+	proof, sysError := s.enclave.GetCrossChainProof(gethcommon.BytesToHash(request.TxHash), request.LogIndex)
+	if sysError != nil {
+		s.logger.Error("Error getting cross chain proof", log.ErrKey, sysError)
+		return &generated.GetCrossChainProofResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	if proof == nil {
+		return &generated.GetCrossChainProofResponse{}, nil
+	}
+
+	marshal, err := json.Marshal(proof)
+	if err != nil {
+		s.logger.Error("Error encoding cross chain proof", log.ErrKey, err)
+		return &generated.GetCrossChainProofResponse{SystemError: toRPCError(err)}, nil
+	}
+	return &generated.GetCrossChainProofResponse{Proof: marshal}, nil
+}
+
 func (s *RPCServer) StreamL2Updates(_ *generated.StreamL2UpdatesRequest, stream generated.EnclaveProto_StreamL2UpdatesServer) error {
 	batchChan, stop := s.enclave.StreamL2Updates()
 	defer stop()
@@ -422,6 +485,22 @@ func (s *RPCServer) GetPublicTransactionData(_ context.Context, req *generated.G
 	return &generated.GetPublicTransactionDataResponse{PublicTransactionData: marshal}, nil
 }
 
+func (s *RPCServer) GetBridgeLedger(_ context.Context, _ *generated.GetBridgeLedgerRequest) (*generated.GetBridgeLedgerResponse, error) {
+	ledger, sysError := s.enclave.GetBridgeLedger()
+	if sysError != nil {
+		s.logger.Error("Error getting the bridge ledger", log.ErrKey, sysError)
+		return &generated.GetBridgeLedgerResponse{SystemError: toRPCError(sysError)}, nil
+	}
+
+	marshal, err := json.Marshal(ledger)
+	if err != nil {
+		s.logger.Error("Error marshalling the bridge ledger", log.ErrKey, err)
+		return &generated.GetBridgeLedgerResponse{SystemError: toRPCError(err)}, nil
+	}
+
+	return &generated.GetBridgeLedgerResponse{Ledger: marshal}, nil
+}
+
 func (s *RPCServer) decodeBlock(encodedBlock []byte) types.Block {
 	block := types.Block{}
 	err := rlp.DecodeBytes(encodedBlock, &block)
@@ -8,25 +8,51 @@ import (
 	"math/big"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/obscuronet/go-obscuro/go/common"
 	"github.com/obscuronet/go-obscuro/go/common/errutil"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 const (
 	blockInsert       = "insert into block values (?,?,?,?,?)"
 	selectBlockHeader = "select header from block"
 
-	l1msgInsert = "insert into l1_msg (message, block) values "
-	l1msgValue  = "(?,?)"
+	l1msgInsert = "insert into l1_msg (message, block, hash, tx, tx_sender) values "
+	l1msgValue  = "(?,?,?,?,?)"
 	selectL1Msg = "select message from l1_msg "
 
+	selectL1MsgByTx = "select m.message, m.hash, m.tx_sender, b.hash, b.height from l1_msg m join block b on m.block=b.hash where b.is_canonical=true and m.tx=? order by m.id"
+
+	l1msgDeliveryInsert = "replace into l1_msg_delivery (msg_hash, tx, batch) values "
+	l1msgDeliveryValue  = "(?,?,?)"
+	selectL1MsgDelivery = "select d.tx, b.hash, b.height from l1_msg_delivery d join batch b on d.batch=b.hash where b.is_canonical=true and d.msg_hash=?"
+
+	l1msgDeferredInsert = "replace into l1_msg_deferred (batch, idx, message) values "
+	l1msgDeferredValue  = "(?,?,?)"
+	selectL1MsgDeferred = "select message from l1_msg_deferred where batch = ? order by idx"
+
+	forcedTxInsert = "insert into forced_tx (hash, content, block) values "
+	forcedTxValue  = "(?,?,?)"
+	selectForcedTx = "select content from forced_tx where block = ? order by id"
+
 	rollupInsert = "replace into rollup values (?,?,?,?,?)"
 	rollupSelect = "select hash from rollup where compression_block in "
 
+	rollupBySeqNoSelect = "select r.start_seq, r.header from rollup r join block b on r.compression_block=b.hash where b.is_canonical=true and r.start_seq<=? and r.end_seq>=?"
+
 	updateCanonicalBlock = "update block set is_canonical=? where hash in "
 
+	// the blocks a batch was built on are kept, as the batches are not pruned
+	selectPrunableBlocks = "select hash from block where is_canonical=false and height<? and hash not in (select l1_proof from batch)"
+	deleteBlockL1Msgs    = "delete from l1_msg where block in "
+	deleteBlockForcedTxs = "delete from forced_tx where block in "
+	deleteBlockRollups   = "delete from rollup where compression_block in "
+	deleteBlocks         = "delete from block where hash in "
+
 	// todo - do we need the is_canonical field?
 	updateCanonicalBatches = "update batch set is_canonical=? where l1_proof in "
 )
@@ -76,6 +102,48 @@ func updateCanonicalValue(dbtx DBTransaction, isCanonical bool, values []common.
 	dbtx.ExecuteSQL(updateBatches, args...)
 }
 
+// SelectPrunableBlocks returns the non-canonical blocks below the height which no batch was built on
+func SelectPrunableBlocks(db *sql.DB, belowHeight uint64) ([]common.L1BlockHash, error) {
+	rows, err := db.Query(selectPrunableBlocks, belowHeight)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hashes []common.L1BlockHash
+	for rows.Next() {
+		var hash []byte
+		if err := rows.Scan(&hash); err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, gethcommon.BytesToHash(hash))
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return hashes, nil
+}
+
+// DeleteBlocks deletes the blocks and the cross chain messages, bridge deposits, forced transactions and rollups
+// extracted from them
+func DeleteBlocks(dbtx DBTransaction, hashes []common.L1BlockHash) {
+	if len(hashes) == 0 {
+		return
+	}
+	argPlaceholders := strings.Repeat("?,", len(hashes))
+	argPlaceholders = "(" + argPlaceholders[0:len(argPlaceholders)-1] + ")" // remove trailing comma
+
+	args := make([]any, 0)
+	for _, hash := range hashes {
+		args = append(args, hash.Bytes())
+	}
+	dbtx.ExecuteSQL(deleteBlockL1Msgs+argPlaceholders, args...)
+	dbtx.ExecuteSQL(deleteBlockBridgeTransfers+argPlaceholders, args...)
+	dbtx.ExecuteSQL(deleteBlockForcedTxs+argPlaceholders, args...)
+	dbtx.ExecuteSQL(deleteBlockRollups+argPlaceholders, args...)
+	dbtx.ExecuteSQL(deleteBlocks+argPlaceholders, args...)
+}
+
 func FetchBlockHeader(db *sql.DB, hash common.L2BatchHash) (*types.Header, error) {
 	return fetchBlockHeader(db, " where hash=?", hash.Bytes())
 }
@@ -93,19 +161,26 @@ func FetchBlockHeaderByHeight(db *sql.DB, height *big.Int) (*types.Header, error
 	return fetchBlockHeader(db, "where is_canonical=true and height=?", height.Int64())
 }
 
-func WriteL1Messages(db *sql.DB, blockHash common.L1BlockHash, messages common.CrossChainMessages) error {
+func WriteL1Messages(db *sql.DB, blockHash common.L1BlockHash, messages []common.L1Message) error {
 	insert := l1msgInsert + strings.Repeat(l1msgValue+",", len(messages))
 	insert = insert[0 : len(insert)-1] // remove trailing comma
 
 	args := make([]any, 0)
 
 	for _, msg := range messages {
-		data, err := rlp.EncodeToBytes(msg)
+		data, err := rlp.EncodeToBytes(msg.Message)
+		if err != nil {
+			return err
+		}
+		msgHash, err := common.CrossChainMessageHash(msg.Message)
 		if err != nil {
 			return err
 		}
 		args = append(args, data)
 		args = append(args, blockHash.Bytes())
+		args = append(args, msgHash.Bytes())
+		args = append(args, msg.TxHash.Bytes())
+		args = append(args, msg.TxSender.Bytes())
 	}
 	if len(messages) > 0 {
 		_, err := db.Exec(insert, args...)
@@ -116,7 +191,8 @@ func WriteL1Messages(db *sql.DB, blockHash common.L1BlockHash, messages common.C
 
 func FetchL1Messages(db *sql.DB, blockHash common.L1BlockHash) (common.CrossChainMessages, error) {
 	var result common.CrossChainMessages
-	query := selectL1Msg + " where block = ?"
+	// the messages are returned in the order they were published in the block
+	query := selectL1Msg + " where block = ? order by id"
 	rows, err := db.Query(query, blockHash.Bytes())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -145,6 +221,171 @@ func FetchL1Messages(db *sql.DB, blockHash common.L1BlockHash) (common.CrossChai
 	return result, nil
 }
 
+func WriteForcedTransactions(db *sql.DB, blockHash common.L1BlockHash, transactions common.L2Transactions) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+	insert := forcedTxInsert + strings.Repeat(forcedTxValue+",", len(transactions))
+	insert = insert[0 : len(insert)-1] // remove trailing comma
+
+	args := make([]any, 0)
+	for _, tx := range transactions {
+		txBytes, err := rlp.EncodeToBytes(tx)
+		if err != nil {
+			return fmt.Errorf("failed to encode forced transaction. Cause: %w", err)
+		}
+		args = append(args, tx.Hash().Bytes())
+		args = append(args, txBytes)
+		args = append(args, blockHash.Bytes())
+	}
+	_, err := db.Exec(insert, args...)
+	return err
+}
+
+// FetchForcedTransactions returns the transactions forced through the block, in the order they were submitted
+func FetchForcedTransactions(db *sql.DB, blockHash common.L1BlockHash) (common.L2Transactions, error) {
+	rows, err := db.Query(selectForcedTx, blockHash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(common.L2Transactions, 0)
+	for rows.Next() {
+		var txBytes []byte
+		if err := rows.Scan(&txBytes); err != nil {
+			return nil, err
+		}
+		tx := new(common.L2Tx)
+		if err := rlp.DecodeBytes(txBytes, tx); err != nil {
+			return nil, fmt.Errorf("could not decode forced transaction. Cause: %w", err)
+		}
+		result = append(result, tx)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return result, nil
+}
+
+// FetchInboundMessageStatus returns the status of the messages published by the L1 transaction in a canonical block, in
+// the order they were published. The messages are delivered once a synthetic transaction of a canonical batch stored
+// them in the L2 message bus.
+func FetchInboundMessageStatus(db *sql.DB, l1TxHash common.TxHash) ([]*common.InboundMessageStatus, error) {
+	rows, err := db.Query(selectL1MsgByTx, l1TxHash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*common.InboundMessageStatus
+	var msgHashes [][]byte
+	for rows.Next() {
+		var msg, msgHash, sender, blockHash []byte
+		var height uint64
+		if err := rows.Scan(&msg, &msgHash, &sender, &blockHash, &height); err != nil {
+			return nil, err
+		}
+		status := &common.InboundMessageStatus{
+			L1TxHash:      l1TxHash,
+			L1TxSender:    gethcommon.BytesToAddress(sender),
+			L1BlockHash:   gethcommon.BytesToHash(blockHash),
+			L1BlockHeight: hexutil.Uint64(height),
+			Status:        common.InboundMessagePending,
+		}
+		if err := rlp.Decode(bytes.NewReader(msg), &status.Message); err != nil {
+			return nil, fmt.Errorf("could not decode cross chain message. Cause: %w", err)
+		}
+		result = append(result, status)
+		msgHashes = append(msgHashes, msgHash)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	if len(result) == 0 {
+		return nil, errutil.ErrNotFound
+	}
+
+	for i, status := range result {
+		var txHash, batchHash []byte
+		var batchHeight uint64
+		err := db.QueryRow(selectL1MsgDelivery, msgHashes[i]).Scan(&txHash, &batchHash, &batchHeight)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		l2TxHash, l2BatchHash, l2BatchHeight := gethcommon.BytesToHash(txHash), gethcommon.BytesToHash(batchHash), hexutil.Uint64(batchHeight)
+		status.Status = common.InboundMessageDelivered
+		status.L2TxHash, status.BatchHash, status.BatchHeight = &l2TxHash, &l2BatchHash, &l2BatchHeight
+	}
+	return result, nil
+}
+
+// WriteL1MessageDeliveries records the synthetic transactions of the batch which stored the inbound messages in the L2
+// message bus. A batch executed again records the same deliveries.
+func WriteL1MessageDeliveries(db *sql.DB, batchHash common.L2BatchHash, deliveries []common.L1MessageDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	insert := l1msgDeliveryInsert + strings.Repeat(l1msgDeliveryValue+",", len(deliveries))
+	insert = insert[0 : len(insert)-1] // remove trailing comma
+
+	args := make([]any, 0)
+	for _, delivery := range deliveries {
+		args = append(args, delivery.MessageHash.Bytes(), delivery.TxHash.Bytes(), batchHash.Bytes())
+	}
+	_, err := db.Exec(insert, args...)
+	return err
+}
+
+// WriteDeferredL1Messages records the inbound messages left out of the batch, in the order they are to be delivered
+func WriteDeferredL1Messages(db *sql.DB, batchHash common.L2BatchHash, messages common.CrossChainMessages) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	insert := l1msgDeferredInsert + strings.Repeat(l1msgDeferredValue+",", len(messages))
+	insert = insert[0 : len(insert)-1] // remove trailing comma
+
+	args := make([]any, 0)
+	for i, msg := range messages {
+		data, err := rlp.EncodeToBytes(msg)
+		if err != nil {
+			return err
+		}
+		args = append(args, batchHash.Bytes(), i, data)
+	}
+	_, err := db.Exec(insert, args...)
+	return err
+}
+
+func FetchDeferredL1Messages(db *sql.DB, batchHash common.L2BatchHash) (common.CrossChainMessages, error) {
+	rows, err := db.Query(selectL1MsgDeferred, batchHash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(common.CrossChainMessages, 0)
+	for rows.Next() {
+		var msg []byte
+		if err := rows.Scan(&msg); err != nil {
+			return nil, err
+		}
+		ccm := new(common.CrossChainMessage)
+		if err := rlp.Decode(bytes.NewReader(msg), ccm); err != nil {
+			return nil, fmt.Errorf("could not decode cross chain message. Cause: %w", err)
+		}
+		result = append(result, *ccm)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return result, nil
+}
+
 func WriteRollup(dbtx DBTransaction, rollup *common.RollupHeader, internalHeader *common.CalldataRollupHeader) error {
 	// Write the encoded header
 	data, err := rlp.EncodeToBytes(rollup)
@@ -183,6 +424,26 @@ func FetchReorgedRollup(db *sql.DB, reorgedBlocks []common.L1BlockHash) (*common
 	return rollup, nil
 }
 
+// FetchRollupBySeqNo returns the header and the first batch sequence number of the canonical rollup which published the
+// batch with the given sequence number.
+func FetchRollupBySeqNo(db *sql.DB, seqNo uint64) (*common.RollupHeader, uint64, error) {
+	var startSeq uint64
+	var header []byte
+	err := db.QueryRow(rollupBySeqNoSelect, seqNo, seqNo).Scan(&startSeq, &header)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// make sure the error is converted to obscuro-wide not found error
+			return nil, 0, errutil.ErrNotFound
+		}
+		return nil, 0, err
+	}
+	h := new(common.RollupHeader)
+	if err := rlp.Decode(bytes.NewReader(header), h); err != nil {
+		return nil, 0, fmt.Errorf("could not decode rollup header. Cause: %w", err)
+	}
+	return h, startSeq, nil
+}
+
 func fetchBlockHeader(db *sql.DB, whereQuery string, args ...any) (*types.Header, error) {
 	var header string
 	query := selectBlockHeader + " " + whereQuery
@@ -0,0 +1,136 @@
+package enclavedb
+
+import (
+	"database/sql"
+	"math/big"
+	"strings"
+
+	"github.com/obscuronet/go-obscuro/go/common"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// `replace` makes recording the transfers of a re-executed batch again a no-op
+	bridgeTransferInsert = "replace into bridge_transfer (source, idx, kind, token, amount, sender, receiver) values "
+	bridgeTransferValue  = "(?,?,?,?,?,?,?)"
+	wrappedTokenInsert   = "replace into wrapped_token (l2_token, token, batch) values "
+	wrappedTokenValue    = "(?,?,?)"
+
+	bridgeTransferColumns = "select t.kind, t.token, t.amount, t.sender, t.receiver from bridge_transfer t "
+	selectBridgeTransfers = bridgeTransferColumns + "where t.source=? order by t.idx"
+	// the deposits are recorded against the L1 block which published them, the other transfers against their batch
+	selectCanonicalBridgeTransfers = bridgeTransferColumns + "join block b on t.source=b.hash where b.is_canonical=true " +
+		"union all " + bridgeTransferColumns + "join batch b on t.source=b.hash where b.is_canonical=true"
+
+	selectWrappedTokens          = "select w.l2_token, w.token from wrapped_token w where w.batch=?"
+	selectCanonicalWrappedTokens = "select w.l2_token, w.token from wrapped_token w join batch b on w.batch=b.hash where b.is_canonical=true"
+
+	deleteBlockBridgeTransfers = "delete from bridge_transfer where source in "
+)
+
+// WriteBridgeTransfers records the transfers of the L1 block or of the batch with the hash, in the order they happened
+func WriteBridgeTransfers(db *sql.DB, source gethcommon.Hash, transfers []common.BridgeTransfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+	insert := bridgeTransferInsert + strings.Repeat(bridgeTransferValue+",", len(transfers))
+	insert = insert[0 : len(insert)-1] // remove trailing comma
+
+	args := make([]any, 0)
+	for i, transfer := range transfers {
+		args = append(args, source.Bytes(), i, int(transfer.Kind), transfer.Token.Bytes(), transfer.Amount.Bytes(),
+			transfer.Sender.Bytes(), transfer.Receiver.Bytes())
+	}
+	_, err := db.Exec(insert, args...)
+	return err
+}
+
+// WriteWrappedTokens records the tokens created by the L2 bridge in the batch
+func WriteWrappedTokens(db *sql.DB, batchHash common.L2BatchHash, tokens []common.WrappedToken) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	insert := wrappedTokenInsert + strings.Repeat(wrappedTokenValue+",", len(tokens))
+	insert = insert[0 : len(insert)-1] // remove trailing comma
+
+	args := make([]any, 0)
+	for _, token := range tokens {
+		args = append(args, token.WrappedToken.Bytes(), token.Token.Bytes(), batchHash.Bytes())
+	}
+	_, err := db.Exec(insert, args...)
+	return err
+}
+
+// FetchBridgeTransfers returns the transfers recorded for the L1 block or the batch with the hash, in the order they
+// happened
+func FetchBridgeTransfers(db *sql.DB, source gethcommon.Hash) ([]common.BridgeTransfer, error) {
+	return fetchBridgeTransfers(db, selectBridgeTransfers, source.Bytes())
+}
+
+// FetchCanonicalBridgeTransfers returns the transfers of the canonical blocks and batches
+func FetchCanonicalBridgeTransfers(db *sql.DB) ([]common.BridgeTransfer, error) {
+	return fetchBridgeTransfers(db, selectCanonicalBridgeTransfers)
+}
+
+// FetchWrappedTokens returns the tokens created by the L2 bridge in the batch
+func FetchWrappedTokens(db *sql.DB, batchHash common.L2BatchHash) ([]common.WrappedToken, error) {
+	return fetchWrappedTokens(db, selectWrappedTokens, batchHash.Bytes())
+}
+
+// FetchCanonicalWrappedTokens returns the tokens created by the L2 bridge in the canonical batches
+func FetchCanonicalWrappedTokens(db *sql.DB) ([]common.WrappedToken, error) {
+	return fetchWrappedTokens(db, selectCanonicalWrappedTokens)
+}
+
+func fetchBridgeTransfers(db *sql.DB, query string, args ...any) ([]common.BridgeTransfer, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transfers := make([]common.BridgeTransfer, 0)
+	for rows.Next() {
+		var kind int
+		var token, amount, sender, receiver []byte
+		if err := rows.Scan(&kind, &token, &amount, &sender, &receiver); err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, common.BridgeTransfer{
+			Kind:     common.BridgeTransferKind(kind),
+			Token:    gethcommon.BytesToAddress(token),
+			Amount:   new(big.Int).SetBytes(amount),
+			Sender:   gethcommon.BytesToAddress(sender),
+			Receiver: gethcommon.BytesToAddress(receiver),
+		})
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return transfers, nil
+}
+
+func fetchWrappedTokens(db *sql.DB, query string, args ...any) ([]common.WrappedToken, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := make([]common.WrappedToken, 0)
+	for rows.Next() {
+		var l2Token, token []byte
+		if err := rows.Scan(&l2Token, &token); err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, common.WrappedToken{
+			Token:        gethcommon.BytesToAddress(token),
+			WrappedToken: gethcommon.BytesToAddress(l2Token),
+		})
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return tokens, nil
+}
@@ -10,6 +10,7 @@ import (
 
 const (
 	cfgInsert = "insert into config values (?,?)"
+	cfgUpsert = "replace into config values (?,?)"
 	cfgUpdate = "update config set val=? where ky=?"
 	cfgSelect = "select val from config where ky=?"
 )
@@ -23,8 +24,9 @@ func WriteConfigToBatch(dbtx DBTransaction, key string, value any) {
 	dbtx.ExecuteSQL(cfgInsert, key, value)
 }
 
+// WriteConfigToTx writes the value, replacing any previous value of the key
 func WriteConfigToTx(dbtx *sql.Tx, key string, value any) (sql.Result, error) {
-	return dbtx.Exec(cfgInsert, key, value)
+	return dbtx.Exec(cfgUpsert, key, value)
 }
 
 func WriteConfig(db *sql.DB, key string, value []byte) (sql.Result, error) {
@@ -289,7 +289,6 @@ func loadLogs(db *sql.DB, requestingAccount *gethcommon.Address, whereCondition
 		return nil, fmt.Errorf("logs can only be requested for an account")
 	}
 
-	result := make([]*types.Log, 0)
 	query := baseEventsQuerySelect + " " + baseEventsJoin
 	var queryParams []any
 
@@ -307,6 +306,18 @@ func loadLogs(db *sql.DB, requestingAccount *gethcommon.Address, whereCondition
 
 	query += orderBy
 
+	return queryLogs(db, query, queryParams)
+}
+
+// FilterLogsOfSender returns the logs with the topic emitted by the contract in the canonical transactions sent by the
+// account, between the batch heights. The relevancy rules do not apply, as the logs are returned to the sender.
+func FilterLogsOfSender(db *sql.DB, sender gethcommon.Address, contract gethcommon.Address, topic gethcommon.Hash, fromHeight, toHeight uint64) ([]*types.Log, error) {
+	query := baseEventsQuerySelect + " " + baseEventsJoin + " AND tx.sender_address=? AND address=? AND topic0=? AND b.height >= ? AND b.height <= ?" + orderBy + ", log_idx asc"
+	return queryLogs(db, query, []any{sender.Bytes(), contract.Bytes(), topic.Bytes(), fromHeight, toHeight})
+}
+
+func queryLogs(db *sql.DB, query string, queryParams []any) ([]*types.Log, error) {
+	result := make([]*types.Log, 0)
 	rows, err := db.Query(query, queryParams...)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,31 @@
+package enclavedb
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// `replace` makes recording the same revocation again a no-op, e.g. when a batch is re-executed
+	vkRevocationInsert = "replace into viewing_key_revocation values (?,?)"
+	vkRevocationSelect = "select account from viewing_key_revocation where viewing_key=?"
+)
+
+func WriteViewingKeyRevocation(db *sql.DB, viewingKey []byte, account common.Address) error {
+	_, err := db.Exec(vkRevocationInsert, viewingKey, account.Bytes())
+	return err
+}
+
+func ViewingKeyWasRevoked(db *sql.DB, viewingKey []byte) (bool, error) {
+	var account []byte
+	err := db.QueryRow(vkRevocationSelect, viewingKey).Scan(&account)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,7 @@
+create table if not exists obsdb.viewing_key_revocation
+(
+    viewing_key binary(33),
+    account     binary(20) NOT NULL,
+    primary key (viewing_key)
+);
+GRANT ALL ON obsdb.viewing_key_revocation TO obscuro;
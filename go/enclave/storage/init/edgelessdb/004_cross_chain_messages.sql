@@ -0,0 +1,15 @@
+alter table obsdb.l1_msg
+    add column hash binary(32),
+    add column tx binary(32),
+    add column tx_sender binary(20),
+    add INDEX (tx);
+
+create table if not exists obsdb.l1_msg_delivery
+(
+    msg_hash binary(32) NOT NULL,
+    tx       binary(32) NOT NULL,
+    batch    binary(32) NOT NULL,
+    INDEX (batch),
+    primary key (msg_hash, batch)
+);
+GRANT ALL ON obsdb.l1_msg_delivery TO obscuro;
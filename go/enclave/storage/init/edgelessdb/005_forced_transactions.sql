@@ -0,0 +1,10 @@
+create table if not exists obsdb.forced_tx
+(
+    id      INTEGER AUTO_INCREMENT,
+    hash    binary(32) NOT NULL,
+    content mediumblob NOT NULL,
+    block   binary(32) NOT NULL,
+    INDEX (block),
+    primary key (id)
+);
+GRANT ALL ON obsdb.forced_tx TO obscuro;
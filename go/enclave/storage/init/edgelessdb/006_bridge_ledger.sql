@@ -0,0 +1,22 @@
+create table if not exists obsdb.bridge_transfer
+(
+    source    binary(32)    NOT NULL,
+    idx       int           NOT NULL,
+    kind      int           NOT NULL,
+    token     binary(20)    NOT NULL,
+    amount    varbinary(32) NOT NULL,
+    sender    binary(20)    NOT NULL,
+    receiver  binary(20)    NOT NULL,
+    primary key (source, idx)
+);
+GRANT ALL ON obsdb.bridge_transfer TO obscuro;
+
+create table if not exists obsdb.wrapped_token
+(
+    l2_token binary(20) NOT NULL,
+    token    binary(20) NOT NULL,
+    batch    binary(32) NOT NULL,
+    INDEX (batch),
+    primary key (l2_token, batch)
+);
+GRANT ALL ON obsdb.wrapped_token TO obscuro;
@@ -0,0 +1,8 @@
+create table if not exists obsdb.l1_msg_deferred
+(
+    batch   binary(32) NOT NULL,
+    idx     int        NOT NULL,
+    message mediumblob NOT NULL,
+    primary key (batch, idx)
+);
+GRANT ALL ON obsdb.l1_msg_deferred TO obscuro;
@@ -25,21 +25,23 @@ func DBMigration(db *sql.DB, sqlFiles embed.FS, logger gethlog.Logger) error {
 
 	maxMigration := int64(len(migrationFiles))
 
-	var maxDB int64
+	// the index of the next file to execute
+	var next int64
 	config, err := enclavedb.FetchConfig(db, currentFileKey)
 	if err != nil {
 		// first time there is no entry, so we assume 001 was executed already
 		if errors.Is(err, errutil.ErrNotFound) {
-			maxDB = 1
+			next = 1
 		} else {
 			return err
 		}
 	} else {
-		maxDB = ByteArrayToInt(config)
+		// the config holds the index of the last executed file
+		next = ByteArrayToInt(config) + 1
 	}
 
 	// write to the database
-	for i := maxDB; i < maxMigration; i++ {
+	for i := next; i < maxMigration; i++ {
 		logger.Info("Executing db migration", "file", migrationFiles[i].Name())
 		content, err := sqlFiles.ReadFile(migrationFiles[i].Name())
 		if err != nil {
@@ -0,0 +1,5 @@
+create table if not exists viewing_key_revocation
+(
+    viewing_key binary(33) primary key,
+    account     binary(20) NOT NULL
+);
@@ -0,0 +1,12 @@
+alter table l1_msg add column hash binary(32);
+alter table l1_msg add column tx binary(32);
+alter table l1_msg add column tx_sender binary(20);
+create index IDX_L1_MSG_TX on l1_msg (tx);
+
+create table if not exists l1_msg_delivery
+(
+    msg_hash binary(32) NOT NULL,
+    tx       binary(32) NOT NULL,
+    batch    binary(32) NOT NULL,
+    primary key (msg_hash, batch)
+);
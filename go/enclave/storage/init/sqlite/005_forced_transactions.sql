@@ -0,0 +1,8 @@
+create table if not exists forced_tx
+(
+    id      INTEGER PRIMARY KEY AUTOINCREMENT,
+    hash    binary(32) NOT NULL,
+    content mediumblob NOT NULL,
+    block   binary(32) NOT NULL REFERENCES block
+);
+create index IDX_FORCED_TX_BLOCK on forced_tx (block);
@@ -0,0 +1,19 @@
+create table if not exists bridge_transfer
+(
+    source    binary(32)    NOT NULL,
+    idx       int           NOT NULL,
+    kind      int           NOT NULL,
+    token     binary(20)    NOT NULL,
+    amount    varbinary(32) NOT NULL,
+    sender    binary(20)    NOT NULL,
+    receiver  binary(20)    NOT NULL,
+    primary key (source, idx)
+);
+
+create table if not exists wrapped_token
+(
+    l2_token binary(20) NOT NULL,
+    token    binary(20) NOT NULL,
+    batch    binary(32) NOT NULL,
+    primary key (l2_token, batch)
+);
@@ -0,0 +1,7 @@
+create table if not exists l1_msg_deferred
+(
+    batch   binary(32) NOT NULL,
+    idx     int        NOT NULL,
+    message mediumblob NOT NULL,
+    primary key (batch, idx)
+);
@@ -68,6 +68,9 @@ type BatchResolver interface {
 	// StoreRollup
 	StoreRollup(rollup *common.ExtRollup, header *common.CalldataRollupHeader) error
 	FetchReorgedRollup(reorgedBlocks []common.L1BlockHash) (*common.L2BatchHash, error)
+	// FetchRollupBySeqNo returns the header and the first batch sequence number of the canonical rollup which
+	// published the batch with the given sequence number
+	FetchRollupBySeqNo(seqNo uint64) (*common.RollupHeader, uint64, error)
 }
 
 type GethStateDB interface {
@@ -103,8 +106,38 @@ type AttestationStorage interface {
 }
 
 type CrossChainMessagesStorage interface {
-	StoreL1Messages(blockHash common.L1BlockHash, messages common.CrossChainMessages) error
+	StoreL1Messages(blockHash common.L1BlockHash, messages []common.L1Message) error
 	GetL1Messages(blockHash common.L1BlockHash) (common.CrossChainMessages, error)
+	// StoreL1MessageDeliveries - records the synthetic transactions of the batch which stored the inbound messages
+	StoreL1MessageDeliveries(batchHash common.L2BatchHash, deliveries []common.L1MessageDelivery) error
+	// StoreDeferredL1Messages - records the inbound messages the batch left out because of the cap on its synthetic gas
+	StoreDeferredL1Messages(batchHash common.L2BatchHash, messages common.CrossChainMessages) error
+	// GetDeferredL1Messages - returns the inbound messages the batch left out, to be delivered by its child first
+	GetDeferredL1Messages(batchHash common.L2BatchHash) (common.CrossChainMessages, error)
+	// GetInboundMessageStatus - returns the status of the messages published by the L1 transaction in a canonical block
+	GetInboundMessageStatus(l1TxHash common.TxHash) ([]*common.InboundMessageStatus, error)
+}
+
+type ForcedTransactionStorage interface {
+	// StoreForcedTransactions - stores the L2 transactions submitted through the management contract in the block
+	StoreForcedTransactions(blockHash common.L1BlockHash, transactions common.L2Transactions) error
+	// GetForcedTransactions - returns the L2 transactions submitted in the block, in the order they were submitted
+	GetForcedTransactions(blockHash common.L1BlockHash) (common.L2Transactions, error)
+}
+
+type BridgeStorage interface {
+	// StoreBridgeTransfers - records the bridge transfers of the L1 block or of the batch with the hash
+	StoreBridgeTransfers(source gethcommon.Hash, transfers []common.BridgeTransfer) error
+	// StoreWrappedTokens - records the tokens created by the L2 bridge in the batch
+	StoreWrappedTokens(batchHash common.L2BatchHash, tokens []common.WrappedToken) error
+	// GetBridgeTransfers - returns the bridge transfers of the L1 block or of the batch with the hash
+	GetBridgeTransfers(source gethcommon.Hash) ([]common.BridgeTransfer, error)
+	// GetCanonicalBridgeTransfers - returns the bridge transfers of the canonical blocks and batches
+	GetCanonicalBridgeTransfers() ([]common.BridgeTransfer, error)
+	// GetWrappedTokens - returns the tokens created by the L2 bridge in the batch
+	GetWrappedTokens(batchHash common.L2BatchHash) ([]common.WrappedToken, error)
+	// GetCanonicalWrappedTokens - returns the tokens created by the L2 bridge in the canonical batches
+	GetCanonicalWrappedTokens() ([]common.WrappedToken, error)
 }
 
 type EnclaveKeyStorage interface {
@@ -112,6 +145,13 @@ type EnclaveKeyStorage interface {
 	GetEnclaveKey() (*ecdsa.PrivateKey, error)
 }
 
+type ViewingKeyStorage interface {
+	// StoreViewingKeyRevocation - records that the viewing key authorised by the account was revoked
+	StoreViewingKeyRevocation(viewingKey []byte, account gethcommon.Address) error
+	// IsViewingKeyRevoked - returns true if the viewing key was revoked, so it can no longer be used to encrypt responses
+	IsViewingKeyRevoked(viewingKey []byte) (bool, error)
+}
+
 // Storage is the enclave's interface for interacting with the enclave's datastore
 type Storage interface {
 	BlockResolver
@@ -121,18 +161,29 @@ type Storage interface {
 	TransactionStorage
 	AttestationStorage
 	CrossChainMessagesStorage
+	ForcedTransactionStorage
+	BridgeStorage
 	EnclaveKeyStorage
+	ViewingKeyStorage
 	ScanStorage
 	io.Closer
 
 	// HealthCheck returns whether the storage is deemed healthy or not
 	HealthCheck() (bool, error)
 
+	// DeleteNonCanonicalBlocks - deletes the non-canonical blocks below the height, except the ones a batch was built
+	// on, and returns their hashes
+	DeleteNonCanonicalBlocks(belowHeight uint64) ([]common.L1BlockHash, error)
+
 	// FilterLogs - applies the properties the relevancy checks for the requestingAccount to all the stored log events
 	// nil values will be ignored. Make sure to set all fields to the right values before calling this function
 	// the blockHash should always be nil.
 	FilterLogs(requestingAccount *gethcommon.Address, fromBlock, toBlock *big.Int, blockHash *common.L2BatchHash, addresses []gethcommon.Address, topics [][]gethcommon.Hash) ([]*types.Log, error)
 
+	// FilterLogsOfSender - returns the logs with the topic emitted by the contract in the canonical transactions sent by
+	// the account between the batch heights, without applying the relevancy rules
+	FilterLogsOfSender(sender gethcommon.Address, contract gethcommon.Address, topic gethcommon.Hash, fromHeight, toHeight uint64) ([]*types.Log, error)
+
 	// DebugGetLogs returns logs for a given tx hash without any constraints - should only be used for debug purposes
 	DebugGetLogs(txHash common.TxHash) ([]*tracers.DebugLogs, error)
 
@@ -164,6 +164,30 @@ func (s *storageImpl) StoreBlock(b *types.Block, chainFork *common.ChainFork) er
 	return nil
 }
 
+func (s *storageImpl) DeleteNonCanonicalBlocks(belowHeight uint64) ([]common.L1BlockHash, error) {
+	callStart := time.Now()
+	defer s.logDuration("DeleteNonCanonicalBlocks", callStart)
+	hashes, err := enclavedb.SelectPrunableBlocks(s.db.GetSQLDB(), belowHeight)
+	if err != nil {
+		return nil, fmt.Errorf("could not select the blocks to prune. Cause: %w", err)
+	}
+	if len(hashes) == 0 {
+		return nil, nil
+	}
+
+	dbTransaction := s.db.NewDBTransaction()
+	enclavedb.DeleteBlocks(dbTransaction, hashes)
+	if err := dbTransaction.Write(); err != nil {
+		return nil, fmt.Errorf("could not delete blocks. Cause: %w", err)
+	}
+
+	for _, hash := range hashes {
+		// the error is ignored, as it is returned when the block was not cached
+		_ = s.blockCache.Delete(context.Background(), hash)
+	}
+	return hashes, nil
+}
+
 func (s *storageImpl) FetchBlock(blockHash common.L1BlockHash) (*types.Block, error) {
 	callStart := time.Now()
 	defer s.logDuration("FetchBlock", callStart)
@@ -420,7 +444,7 @@ func (s *storageImpl) StoreExecutedBatch(batch *core.Batch, receipts []*types.Re
 	return nil
 }
 
-func (s *storageImpl) StoreL1Messages(blockHash common.L1BlockHash, messages common.CrossChainMessages) error {
+func (s *storageImpl) StoreL1Messages(blockHash common.L1BlockHash, messages []common.L1Message) error {
 	callStart := time.Now()
 	defer s.logDuration("StoreL1Messages", callStart)
 	return enclavedb.WriteL1Messages(s.db.GetSQLDB(), blockHash, messages)
@@ -432,6 +456,78 @@ func (s *storageImpl) GetL1Messages(blockHash common.L1BlockHash) (common.CrossC
 	return enclavedb.FetchL1Messages(s.db.GetSQLDB(), blockHash)
 }
 
+func (s *storageImpl) StoreForcedTransactions(blockHash common.L1BlockHash, transactions common.L2Transactions) error {
+	callStart := time.Now()
+	defer s.logDuration("StoreForcedTransactions", callStart)
+	return enclavedb.WriteForcedTransactions(s.db.GetSQLDB(), blockHash, transactions)
+}
+
+func (s *storageImpl) GetForcedTransactions(blockHash common.L1BlockHash) (common.L2Transactions, error) {
+	callStart := time.Now()
+	defer s.logDuration("GetForcedTransactions", callStart)
+	return enclavedb.FetchForcedTransactions(s.db.GetSQLDB(), blockHash)
+}
+
+func (s *storageImpl) StoreL1MessageDeliveries(batchHash common.L2BatchHash, deliveries []common.L1MessageDelivery) error {
+	callStart := time.Now()
+	defer s.logDuration("StoreL1MessageDeliveries", callStart)
+	return enclavedb.WriteL1MessageDeliveries(s.db.GetSQLDB(), batchHash, deliveries)
+}
+
+func (s *storageImpl) StoreDeferredL1Messages(batchHash common.L2BatchHash, messages common.CrossChainMessages) error {
+	callStart := time.Now()
+	defer s.logDuration("StoreDeferredL1Messages", callStart)
+	return enclavedb.WriteDeferredL1Messages(s.db.GetSQLDB(), batchHash, messages)
+}
+
+func (s *storageImpl) GetDeferredL1Messages(batchHash common.L2BatchHash) (common.CrossChainMessages, error) {
+	callStart := time.Now()
+	defer s.logDuration("GetDeferredL1Messages", callStart)
+	return enclavedb.FetchDeferredL1Messages(s.db.GetSQLDB(), batchHash)
+}
+
+func (s *storageImpl) GetInboundMessageStatus(l1TxHash common.TxHash) ([]*common.InboundMessageStatus, error) {
+	callStart := time.Now()
+	defer s.logDuration("GetInboundMessageStatus", callStart)
+	return enclavedb.FetchInboundMessageStatus(s.db.GetSQLDB(), l1TxHash)
+}
+
+func (s *storageImpl) StoreBridgeTransfers(source gethcommon.Hash, transfers []common.BridgeTransfer) error {
+	callStart := time.Now()
+	defer s.logDuration("StoreBridgeTransfers", callStart)
+	return enclavedb.WriteBridgeTransfers(s.db.GetSQLDB(), source, transfers)
+}
+
+func (s *storageImpl) StoreWrappedTokens(batchHash common.L2BatchHash, tokens []common.WrappedToken) error {
+	callStart := time.Now()
+	defer s.logDuration("StoreWrappedTokens", callStart)
+	return enclavedb.WriteWrappedTokens(s.db.GetSQLDB(), batchHash, tokens)
+}
+
+func (s *storageImpl) GetBridgeTransfers(source gethcommon.Hash) ([]common.BridgeTransfer, error) {
+	callStart := time.Now()
+	defer s.logDuration("GetBridgeTransfers", callStart)
+	return enclavedb.FetchBridgeTransfers(s.db.GetSQLDB(), source)
+}
+
+func (s *storageImpl) GetCanonicalBridgeTransfers() ([]common.BridgeTransfer, error) {
+	callStart := time.Now()
+	defer s.logDuration("GetCanonicalBridgeTransfers", callStart)
+	return enclavedb.FetchCanonicalBridgeTransfers(s.db.GetSQLDB())
+}
+
+func (s *storageImpl) GetWrappedTokens(batchHash common.L2BatchHash) ([]common.WrappedToken, error) {
+	callStart := time.Now()
+	defer s.logDuration("GetWrappedTokens", callStart)
+	return enclavedb.FetchWrappedTokens(s.db.GetSQLDB(), batchHash)
+}
+
+func (s *storageImpl) GetCanonicalWrappedTokens() ([]common.WrappedToken, error) {
+	callStart := time.Now()
+	defer s.logDuration("GetCanonicalWrappedTokens", callStart)
+	return enclavedb.FetchCanonicalWrappedTokens(s.db.GetSQLDB())
+}
+
 const enclaveKeyKey = "ek"
 
 func (s *storageImpl) StoreEnclaveKey(enclaveKey *ecdsa.PrivateKey) error {
@@ -460,6 +556,18 @@ func (s *storageImpl) GetEnclaveKey() (*ecdsa.PrivateKey, error) {
 	return enclaveKey, nil
 }
 
+func (s *storageImpl) StoreViewingKeyRevocation(viewingKey []byte, account gethcommon.Address) error {
+	callStart := time.Now()
+	defer s.logDuration("StoreViewingKeyRevocation", callStart)
+	return enclavedb.WriteViewingKeyRevocation(s.db.GetSQLDB(), viewingKey, account)
+}
+
+func (s *storageImpl) IsViewingKeyRevoked(viewingKey []byte) (bool, error) {
+	callStart := time.Now()
+	defer s.logDuration("IsViewingKeyRevoked", callStart)
+	return enclavedb.ViewingKeyWasRevoked(s.db.GetSQLDB(), viewingKey)
+}
+
 func (s *storageImpl) StoreRollup(rollup *common.ExtRollup, internalHeader *common.CalldataRollupHeader) error {
 	callStart := time.Now()
 	defer s.logDuration("StoreRollup", callStart)
@@ -479,12 +587,24 @@ func (s *storageImpl) FetchReorgedRollup(reorgedBlocks []common.L1BlockHash) (*c
 	return enclavedb.FetchReorgedRollup(s.db.GetSQLDB(), reorgedBlocks)
 }
 
+func (s *storageImpl) FetchRollupBySeqNo(seqNo uint64) (*common.RollupHeader, uint64, error) {
+	callStart := time.Now()
+	defer s.logDuration("FetchRollupBySeqNo", callStart)
+	return enclavedb.FetchRollupBySeqNo(s.db.GetSQLDB(), seqNo)
+}
+
 func (s *storageImpl) DebugGetLogs(txHash common.TxHash) ([]*tracers.DebugLogs, error) {
 	callStart := time.Now()
 	defer s.logDuration("DebugGetLogs", callStart)
 	return enclavedb.DebugGetLogs(s.db.GetSQLDB(), txHash)
 }
 
+func (s *storageImpl) FilterLogsOfSender(sender gethcommon.Address, contract gethcommon.Address, topic gethcommon.Hash, fromHeight, toHeight uint64) ([]*types.Log, error) {
+	callStart := time.Now()
+	defer s.logDuration("FilterLogsOfSender", callStart)
+	return enclavedb.FilterLogsOfSender(s.db.GetSQLDB(), sender, contract, topic, fromHeight, toHeight)
+}
+
 func (s *storageImpl) FilterLogs(
 	requestingAccount *gethcommon.Address,
 	fromBlock, toBlock *big.Int,
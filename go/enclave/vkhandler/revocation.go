@@ -0,0 +1,84 @@
+package vkhandler
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// RevocationAddress is the recipient of the system transactions carrying viewing key revocations. These transactions
+// are never executed by the EVM, so there is no contract deployed at this address.
+var RevocationAddress = gethcommon.BytesToAddress(crypto.Keccak256([]byte("obscuro.viewingKeyRevocation")))
+
+// Revocation records that the viewing key authorised by the account can no longer be used to encrypt responses. It is
+// authenticated by the signature which authorised the viewing key in the first place.
+type Revocation struct {
+	Account    gethcommon.Address
+	ViewingKey []byte
+	Signature  []byte
+}
+
+// Verify checks that the signature authorised the viewing key for the account. Expired authorisations and legacy text
+// signatures can always be revoked, so every enclave reaches the same outcome.
+func (r *Revocation) Verify(chainID int64) error {
+	return CheckAuthorisation(&r.Account, r.ViewingKey, r.Signature, chainID)
+}
+
+// NewForRevocation creates the viewing key handler used to confirm the revocation of the viewing key to the user.
+// Unlike New, it accepts any authorisation that can be revoked.
+func NewForRevocation(revocation *Revocation, chainID int64) (*VKHandler, error) {
+	if err := revocation.Verify(chainID); err != nil {
+		return nil, err
+	}
+	return newVKHandler(revocation.ViewingKey)
+}
+
+// NewRevocationTx creates the system transaction that shares the revocation with the other enclaves, as part of a
+// batch. It is signed with a throwaway key, so it always has a zero nonce and never clashes with user transactions.
+func NewRevocationTx(revocation *Revocation, chainID int64) (*types.Transaction, error) {
+	data, err := rlp.EncodeToBytes(revocation)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode viewing key revocation. Cause: %w", err)
+	}
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, fmt.Errorf("could not generate key to sign viewing key revocation. Cause: %w", err)
+	}
+
+	tx := types.NewTx(&types.LegacyTx{
+		Nonce:    0,
+		GasPrice: big.NewInt(0),
+		Gas:      0,
+		To:       &RevocationAddress,
+		Value:    big.NewInt(0),
+		Data:     data,
+	})
+	return types.SignTx(tx, types.LatestSignerForChainID(big.NewInt(chainID)), key)
+}
+
+// IsRevocationTx returns whether the transaction carries a viewing key revocation
+func IsRevocationTx(tx *types.Transaction) bool {
+	return tx.To() != nil && *tx.To() == RevocationAddress
+}
+
+// DecodeRevocationTx extracts the revocation carried by the system transaction, and verifies it
+func DecodeRevocationTx(tx *types.Transaction, chainID int64) (*Revocation, error) {
+	if !IsRevocationTx(tx) {
+		return nil, fmt.Errorf("transaction %s does not carry a viewing key revocation", tx.Hash())
+	}
+
+	revocation := &Revocation{}
+	if err := rlp.DecodeBytes(tx.Data(), revocation); err != nil {
+		return nil, fmt.Errorf("could not decode viewing key revocation. Cause: %w", err)
+	}
+	if err := revocation.Verify(chainID); err != nil {
+		return nil, fmt.Errorf("invalid viewing key revocation. Cause: %w", err)
+	}
+	return revocation, nil
+}
@@ -3,6 +3,7 @@ package vkhandler
 import (
 	"crypto/rand"
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -12,7 +13,11 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
-var ErrInvalidAddressSignature = fmt.Errorf("invalid viewing key signature for requested address")
+var (
+	ErrInvalidAddressSignature = fmt.Errorf("invalid viewing key signature for requested address")
+	ErrLegacySignatureDisabled = fmt.Errorf("legacy text signatures of viewing keys are not accepted, the viewing key must be signed as EIP-712 typed data")
+	ErrViewingKeyRevoked       = fmt.Errorf("viewing key has been revoked")
+)
 
 // Used when the result to an eth_call is equal to nil. Attempting to encrypt then decrypt nil using ECIES throws an exception.
 var placeholderResult = []byte("0x")
@@ -25,15 +30,52 @@ type VKHandler struct {
 // New creates a new viewing key handler
 // checks if the signature is valid
 // as well if signature matches account address
+// EIP-712 signatures are bound to the chain ID and checked for expiry. The legacy text signatures are only accepted if
+// allowLegacySignatures is set
 // todo (@ziga) - function now accepts both old and new messages
-func New(requestedAddr *gethcommon.Address, vkPubKeyBytes, accountSignatureHexBytes []byte) (*VKHandler, error) {
+func New(requestedAddr *gethcommon.Address, vkPubKeyBytes, accountSignatureHexBytes []byte, chainID int64, allowLegacySignatures bool) (*VKHandler, error) {
+	if viewingkey.IsEIP712Signature(accountSignatureHexBytes) {
+		if err := viewingkey.CheckEIP712Expiry(accountSignatureHexBytes, time.Now()); err != nil {
+			return nil, fmt.Errorf("could not check the expiry of the EIP-712 signature authorising the viewing key - %w", err)
+		}
+	} else if !allowLegacySignatures {
+		return nil, ErrLegacySignatureDisabled
+	}
+
+	if err := CheckAuthorisation(requestedAddr, vkPubKeyBytes, accountSignatureHexBytes, chainID); err != nil {
+		return nil, err
+	}
+	return newVKHandler(vkPubKeyBytes)
+}
+
+// CheckExpiry returns an error if the signature authorising the viewing key has expired at the given time. Legacy
+// text signatures carry no expiry.
+func CheckExpiry(accountSignatureHexBytes []byte, now time.Time) error {
+	if !viewingkey.IsEIP712Signature(accountSignatureHexBytes) {
+		return nil
+	}
+	return viewingkey.CheckEIP712Expiry(accountSignatureHexBytes, now)
+}
+
+// CheckAuthorisation checks that the signature authorising the viewing key was produced by the requested account,
+// in either the EIP-712 or the legacy text format. Unlike New, it does not check the expiry, so the outcome does not
+// depend on the time or the configuration of the enclave.
+func CheckAuthorisation(requestedAddr *gethcommon.Address, vkPubKeyBytes, accountSignatureHexBytes []byte, chainID int64) error {
+	if viewingkey.IsEIP712Signature(accountSignatureHexBytes) {
+		err := viewingkey.CheckEIP712Authorisation(requestedAddr, vkPubKeyBytes, accountSignatureHexBytes, chainID)
+		if err != nil {
+			return fmt.Errorf("could not validate the EIP-712 signature authorising the viewing key - %w", err)
+		}
+		return nil
+	}
+
 	// Recalculate the message signed by MetaMask.
 	msgToSign := viewingkey.GenerateSignMessageOG(vkPubKeyBytes, requestedAddr)
 
 	// We recover the key based on the signed message and the signature.
 	recoveredAccountPublicKey, err := crypto.SigToPub(accounts.TextHash([]byte(msgToSign)), accountSignatureHexBytes)
 	if err != nil {
-		return nil, fmt.Errorf("viewing key but could not validate its signature - %w", err)
+		return fmt.Errorf("viewing key but could not validate its signature - %w", err)
 	}
 	recoveredAccountAddress := crypto.PubkeyToAddress(*recoveredAccountPublicKey)
 
@@ -42,7 +84,7 @@ func New(requestedAddr *gethcommon.Address, vkPubKeyBytes, accountSignatureHexBy
 	msgToSignLegacy := viewingkey.GenerateSignMessage(vkPubKeyBytes)
 	recoveredAccountPublicKeyLegacy, err := crypto.SigToPub(accounts.TextHash([]byte(msgToSignLegacy)), accountSignatureHexBytes)
 	if err != nil {
-		return nil, fmt.Errorf("viewing key but could not validate its signature - %w", err)
+		return fmt.Errorf("viewing key but could not validate its signature - %w", err)
 	}
 	recoveredAccountAddressLegacy := crypto.PubkeyToAddress(*recoveredAccountPublicKeyLegacy)
 
@@ -50,9 +92,12 @@ func New(requestedAddr *gethcommon.Address, vkPubKeyBytes, accountSignatureHexBy
 	// todo (@ziga) - we currently check also for legacy address and allow both (remove this after transition period)
 	if requestedAddr.Hash() != recoveredAccountAddress.Hash() &&
 		requestedAddr.Hash() != recoveredAccountAddressLegacy.Hash() {
-		return nil, ErrInvalidAddressSignature
+		return ErrInvalidAddressSignature
 	}
+	return nil
+}
 
+func newVKHandler(vkPubKeyBytes []byte) (*VKHandler, error) {
 	// We decompress the viewing key and create the corresponding ECIES key.
 	viewingKey, err := crypto.DecompressPubkey(vkPubKeyBytes)
 	if err != nil {
@@ -1,15 +1,22 @@
 package vkhandler
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/ecies"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/obscuronet/go-obscuro/go/common/viewingkey"
 	"github.com/stretchr/testify/assert"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
+const testChainID = 777
+
 func TestVKHandler(t *testing.T) {
 	// generate user private Key
 	userPrivKey, err := crypto.GenerateKey()
@@ -36,12 +43,58 @@ func TestVKHandler(t *testing.T) {
 			assert.NoError(t, err)
 
 			// Create a new vk Handler
-			_, err = New(&userAddr, vkPubKeyBytes, signature)
+			_, err = New(&userAddr, vkPubKeyBytes, signature, testChainID, true)
 			assert.NoError(t, err)
+
+			// legacy text signatures are rejected if they are not allowed
+			_, err = New(&userAddr, vkPubKeyBytes, signature, testChainID, false)
+			assert.ErrorIs(t, err, ErrLegacySignatureDisabled)
 		})
 	}
 }
 
+func TestVKHandlerEIP712(t *testing.T) {
+	// generate user private Key
+	userPrivKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	userAddr := crypto.PubkeyToAddress(userPrivKey.PublicKey)
+
+	// generate ViewingKey private Key
+	vkPrivKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	vkPubKeyBytes := crypto.CompressPubkey(ecies.ImportECDSAPublic(&vkPrivKey.PublicKey).ExportECDSA())
+
+	now := uint64(time.Now().Unix())
+	signTypedData := func(chainID int64, issuedAt uint64, expiry uint64) []byte {
+		hash, _, err := apitypes.TypedDataAndHash(viewingkey.GenerateEIP712TypedData(vkPubKeyBytes, chainID, issuedAt, expiry))
+		assert.NoError(t, err)
+		signature, err := crypto.Sign(hash, userPrivKey)
+		assert.NoError(t, err)
+		return viewingkey.EncodeEIP712Signature(signature, issuedAt, expiry)
+	}
+
+	// the EIP-712 signatures are accepted even when the legacy ones are not
+	_, err = New(&userAddr, vkPubKeyBytes, signTypedData(testChainID, now, now+3600), testChainID, false)
+	assert.NoError(t, err)
+
+	// the signature is bound to the chain ID
+	_, err = New(&userAddr, vkPubKeyBytes, signTypedData(testChainID+1, now, now+3600), testChainID, false)
+	assert.ErrorIs(t, err, viewingkey.ErrInvalidEIP712Signature)
+
+	// the signature is bound to the account
+	otherAddr := gethcommon.BigToAddress(big.NewInt(1))
+	_, err = New(&otherAddr, vkPubKeyBytes, signTypedData(testChainID, now, now+3600), testChainID, false)
+	assert.ErrorIs(t, err, viewingkey.ErrInvalidEIP712Signature)
+
+	// expired signatures are rejected
+	_, err = New(&userAddr, vkPubKeyBytes, signTypedData(testChainID, now-7200, now-3600), testChainID, false)
+	assert.ErrorIs(t, err, viewingkey.ErrViewingKeyExpired)
+}
+
 func TestSignAndCheckSignature(t *testing.T) {
 	// generate user private Key
 	userPrivKey, err := crypto.GenerateKey()
@@ -82,3 +135,52 @@ func TestSignAndCheckSignature(t *testing.T) {
 		})
 	}
 }
+
+func TestRevocationTx(t *testing.T) {
+	// generate user private Key
+	userPrivKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	userAddr := crypto.PubkeyToAddress(userPrivKey.PublicKey)
+
+	// generate ViewingKey private Key
+	vkPrivKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	vkPubKeyBytes := crypto.CompressPubkey(ecies.ImportECDSAPublic(&vkPrivKey.PublicKey).ExportECDSA())
+
+	// an expired authorisation can still be revoked
+	now := uint64(time.Now().Unix())
+	hash, _, err := apitypes.TypedDataAndHash(viewingkey.GenerateEIP712TypedData(vkPubKeyBytes, testChainID, now-7200, now-3600))
+	assert.NoError(t, err)
+	signature, err := crypto.Sign(hash, userPrivKey)
+	assert.NoError(t, err)
+	revocation := &Revocation{
+		Account:    userAddr,
+		ViewingKey: vkPubKeyBytes,
+		Signature:  viewingkey.EncodeEIP712Signature(signature, now-7200, now-3600),
+	}
+
+	_, err = NewForRevocation(revocation, testChainID)
+	assert.NoError(t, err)
+
+	tx, err := NewRevocationTx(revocation, testChainID)
+	assert.NoError(t, err)
+	assert.True(t, IsRevocationTx(tx))
+
+	decoded, err := DecodeRevocationTx(tx, testChainID)
+	assert.NoError(t, err)
+	assert.Equal(t, revocation, decoded)
+
+	// the revocation must be authorised by the account
+	revocation.Account = gethcommon.BigToAddress(big.NewInt(1))
+	_, err = NewForRevocation(revocation, testChainID)
+	assert.ErrorIs(t, err, viewingkey.ErrInvalidEIP712Signature)
+
+	tx, err = NewRevocationTx(revocation, testChainID)
+	assert.NoError(t, err)
+	_, err = DecodeRevocationTx(tx, testChainID)
+	assert.ErrorIs(t, err, viewingkey.ErrInvalidEIP712Signature)
+}
@@ -0,0 +1,20 @@
+package bridgelib
+
+import (
+	"github.com/obscuronet/go-obscuro/contracts/generated/EthereumBridge"
+	"github.com/obscuronet/go-obscuro/contracts/generated/WrappedERC20"
+)
+
+const (
+	ReceiveAssetsMethod      = "receiveAssets"
+	CreatedWrappedTokenEvent = "CreatedWrappedToken"
+	TransferEvent            = "Transfer"
+)
+
+var (
+	// BridgeABI is the ABI of the L2 bridge, whose receiveAssets calls are the payloads of the ERC20 transfers of both
+	// bridges
+	BridgeABI = EthereumBridge.EthereumBridgeMetaData.ABI
+	// WrappedTokenABI is the ABI of the tokens created by the L2 bridge
+	WrappedTokenABI = WrappedERC20.WrappedERC20MetaData.ABI
+)
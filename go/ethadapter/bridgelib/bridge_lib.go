@@ -0,0 +1,162 @@
+package bridgelib
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// the topic of the bridge transfers, as defined in IBridge.Topics
+const transferTopic = 0
+
+// BridgeLib decodes the asset transfers of the bridges of both layers: the payloads of the messages they publish, the
+// wrapped tokens the L2 bridge creates and the logs of these tokens being minted and burned.
+type BridgeLib interface {
+	// DecodeTransfer decodes the ERC20 transfer requested by the payload of a message published by a bridge.
+	// Returns nil if the message does not transfer assets, e.g. the command creating a wrapped token. The sender of
+	// the transfer is left empty, as it is the sender of the transaction which published the message.
+	DecodeTransfer(kind common.BridgeTransferKind, msg common.CrossChainMessage) (*common.BridgeTransfer, error)
+
+	// CreatedWrappedTokenTopic returns the topic of the logs the L2 bridge emits when it creates a wrapped token
+	CreatedWrappedTokenTopic() gethcommon.Hash
+	// DecodeCreatedWrappedToken converts the log of a created wrapped token to the token
+	DecodeCreatedWrappedToken(log types.Log) (*common.WrappedToken, error)
+
+	// TokenTransferTopic returns the topic of the Transfer logs of the wrapped tokens
+	TokenTransferTopic() gethcommon.Hash
+	// DecodeTokenTransfer converts the Transfer log of a token to a mint if the tokens come from the zero address, or to
+	// a burn if they go to it. Returns nil for the other transfers.
+	DecodeTokenTransfer(log types.Log) (*common.BridgeTransfer, error)
+}
+
+type bridgeLibImpl struct {
+	bridgeABI       abi.ABI
+	wrappedTokenABI abi.ABI
+
+	// the encoding of ICrossChainMessenger.CrossChainCall, which is the payload of the messages queued by the bridges
+	crossChainCallArgs abi.Arguments
+}
+
+// crossChainCall is the decoded ICrossChainMessenger.CrossChainCall
+type crossChainCall struct {
+	Target gethcommon.Address
+	Data   []byte
+	Gas    *big.Int
+}
+
+func NewBridgeLib() BridgeLib {
+	crossChainCallType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "target", Type: "address"},
+		{Name: "data", Type: "bytes"},
+		{Name: "gas", Type: "uint256"},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return &bridgeLibImpl{
+		bridgeABI:          mustParseABI(BridgeABI),
+		wrappedTokenABI:    mustParseABI(WrappedTokenABI),
+		crossChainCallArgs: abi.Arguments{{Type: crossChainCallType}},
+	}
+}
+
+func (b *bridgeLibImpl) DecodeTransfer(kind common.BridgeTransferKind, msg common.CrossChainMessage) (*common.BridgeTransfer, error) {
+	if msg.Topic != transferTopic {
+		return nil, nil //nolint:nilnil
+	}
+
+	unpacked, err := b.crossChainCallArgs.Unpack(msg.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack cross chain call. Cause: %w", err)
+	}
+	call, ok := abi.ConvertType(unpacked[0], new(crossChainCall)).(*crossChainCall)
+	if !ok {
+		return nil, fmt.Errorf("unexpected cross chain call type %T", unpacked[0])
+	}
+	if len(call.Data) < 4 {
+		return nil, nil //nolint:nilnil
+	}
+
+	selector, args := call.Data[:4], call.Data[4:]
+	if method, err := b.bridgeABI.MethodById(selector); err == nil && method.Name == ReceiveAssetsMethod {
+		unpackedArgs, err := method.Inputs.Unpack(args)
+		if err != nil {
+			return nil, fmt.Errorf("could not unpack %s call. Cause: %w", ReceiveAssetsMethod, err)
+		}
+		return &common.BridgeTransfer{
+			Kind:     kind,
+			Token:    unpackedArgs[0].(gethcommon.Address),
+			Amount:   unpackedArgs[1].(*big.Int),
+			Receiver: unpackedArgs[2].(gethcommon.Address),
+		}, nil
+	}
+	return nil, nil //nolint:nilnil
+}
+
+func (b *bridgeLibImpl) CreatedWrappedTokenTopic() gethcommon.Hash {
+	return b.bridgeABI.Events[CreatedWrappedTokenEvent].ID
+}
+
+func (b *bridgeLibImpl) DecodeCreatedWrappedToken(log types.Log) (*common.WrappedToken, error) {
+	if len(log.Topics) == 0 || log.Topics[0] != b.CreatedWrappedTokenTopic() {
+		return nil, fmt.Errorf("log is not a %s event", CreatedWrappedTokenEvent)
+	}
+	unpacked, err := b.bridgeABI.Unpack(CreatedWrappedTokenEvent, log.Data)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode %s log. Cause: %w", CreatedWrappedTokenEvent, err)
+	}
+	return &common.WrappedToken{
+		Token:        unpacked[0].(gethcommon.Address),
+		WrappedToken: unpacked[1].(gethcommon.Address),
+	}, nil
+}
+
+func (b *bridgeLibImpl) TokenTransferTopic() gethcommon.Hash {
+	return b.wrappedTokenABI.Events[TransferEvent].ID
+}
+
+func (b *bridgeLibImpl) DecodeTokenTransfer(log types.Log) (*common.BridgeTransfer, error) {
+	if len(log.Topics) == 0 || log.Topics[0] != b.TokenTransferTopic() {
+		return nil, fmt.Errorf("log is not a %s event", TransferEvent)
+	}
+
+	// the ERC20 tokens index the sender and the receiver, and log the amount
+	if len(log.Topics) != 3 {
+		return nil, fmt.Errorf("unexpected number of topics %d in %s log", len(log.Topics), TransferEvent)
+	}
+	unpacked, err := b.wrappedTokenABI.Unpack(TransferEvent, log.Data)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode %s log. Cause: %w", TransferEvent, err)
+	}
+	transfer := &common.BridgeTransfer{
+		Token:    log.Address,
+		Amount:   unpacked[0].(*big.Int),
+		Sender:   gethcommon.BytesToAddress(log.Topics[1].Bytes()),
+		Receiver: gethcommon.BytesToAddress(log.Topics[2].Bytes()),
+	}
+
+	switch {
+	case transfer.Sender == gethcommon.Address{}:
+		transfer.Kind = common.BridgeMint
+	case transfer.Receiver == gethcommon.Address{}:
+		transfer.Kind = common.BridgeBurn
+	default:
+		return nil, nil //nolint:nilnil
+	}
+	return transfer, nil
+}
+
+func mustParseABI(contractABI string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(contractABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
@@ -18,6 +18,12 @@ type L1RollupTx struct {
 	Rollup common.EncodedRollup
 }
 
+// L1ForcedTx is an L2 transaction submitted through the management contract, encrypted with the enclave public key, so
+// the sequencer can't leave it out of the batches
+type L1ForcedTx struct {
+	EncryptedTx []byte
+}
+
 type L1DepositTx struct {
 	Amount        *big.Int            // Amount to be deposited
 	To            *gethcommon.Address // Address the ERC20 Transfer was made to (always be the Management Contract Addr)
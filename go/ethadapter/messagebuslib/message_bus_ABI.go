@@ -0,0 +1,13 @@
+package messagebuslib
+
+import "github.com/obscuronet/go-obscuro/contracts/generated/MessageBus"
+
+const (
+	VerifyMessageInclusionMethod   = "verifyMessageInclusion"
+	GetRootTimeOfFinalityMethod    = "getRootTimeOfFinality"
+	GetMessageTimeOfFinalityMethod = "getMessageTimeOfFinality"
+	GetPublishFeeMethod            = "getPublishFee"
+	MessagePublishedEvent          = "LogMessagePublished"
+)
+
+var MessageBusABI = MessageBus.MessageBusMetaData.ABI
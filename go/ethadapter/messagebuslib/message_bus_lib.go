@@ -0,0 +1,179 @@
+package messagebuslib
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/contracts/generated/MessageBus"
+	"github.com/obscuronet/go-obscuro/go/common"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// MessageBusLib provides methods for creating the calls verifying the outbound messages of Obscuro against the cross
+// chain roots stored in the L1 message bus, and for decoding the messages published by a message bus.
+type MessageBusLib interface {
+	// VerifyMessageInclusion returns the call checking the proof of the message against its root, which only succeeds
+	// once the root is final
+	VerifyMessageInclusion(proof *common.CrossChainProof) (ethereum.CallMsg, error)
+	// DecodeVerifyMessageInclusion unpacks the response to the VerifyMessageInclusion call
+	DecodeVerifyMessageInclusion(callResponse []byte) (bool, error)
+	// GetRootTimeOfFinality returns the call returning the L1 timestamp from which the messages of the root can be
+	// relayed. The call reverts if the root was never submitted by the management contract.
+	GetRootTimeOfFinality(root gethcommon.Hash) (ethereum.CallMsg, error)
+	// GetMessageTimeOfFinality returns the call returning the timestamp from which a message delivered to the message
+	// bus can be relayed. The call reverts until the message is delivered.
+	GetMessageTimeOfFinality(msg common.CrossChainMessage) (ethereum.CallMsg, error)
+	// DecodeTimeOfFinality unpacks the response to the GetRootTimeOfFinality and GetMessageTimeOfFinality calls
+	DecodeTimeOfFinality(callResponse []byte) (*big.Int, error)
+	// GetPublishFee returns the call returning the fee the message bus charges for publishing a message with a payload
+	// of the given length, which is paid by the value of the publishing transaction
+	GetPublishFee(payloadLength int) (ethereum.CallMsg, error)
+	// DecodePublishFee unpacks the response to the GetPublishFee call
+	DecodePublishFee(callResponse []byte) (*big.Int, error)
+
+	// MessagePublishedTopic returns the topic of the logs the message bus publishes the messages with
+	MessagePublishedTopic() gethcommon.Hash
+	// DecodeMessagePublished converts the log of a published message to the message
+	DecodeMessagePublished(log types.Log) (common.CrossChainMessage, error)
+	GetContractAddr() *gethcommon.Address
+}
+
+type messageBusLibImpl struct {
+	addr        *gethcommon.Address
+	contractABI abi.ABI
+	filterer    *MessageBus.MessageBusFilterer
+}
+
+func NewMessageBusLib(addr *gethcommon.Address) MessageBusLib {
+	contractABI, err := abi.JSON(strings.NewReader(MessageBusABI))
+	if err != nil {
+		panic(err)
+	}
+	// the filterer only parses the logs it is given, so it needs no backend
+	filterer, err := MessageBus.NewMessageBusFilterer(*addr, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return &messageBusLibImpl{
+		addr:        addr,
+		contractABI: contractABI,
+		filterer:    filterer,
+	}
+}
+
+func (m *messageBusLibImpl) GetContractAddr() *gethcommon.Address {
+	return m.addr
+}
+
+func (m *messageBusLibImpl) VerifyMessageInclusion(proof *common.CrossChainProof) (ethereum.CallMsg, error) {
+	data, err := m.contractABI.Pack(VerifyMessageInclusionMethod, proof.Message, toBytes32(proof.Proof), proof.Root)
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("could not pack %s call. Cause: %w", VerifyMessageInclusionMethod, err)
+	}
+	return ethereum.CallMsg{To: m.addr, Data: data}, nil
+}
+
+func (m *messageBusLibImpl) DecodeVerifyMessageInclusion(callResponse []byte) (bool, error) {
+	unpacked, err := m.unpackSingle(VerifyMessageInclusionMethod, callResponse)
+	if err != nil {
+		return false, err
+	}
+	included, ok := unpacked.(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected %s response type %T", VerifyMessageInclusionMethod, unpacked)
+	}
+	return included, nil
+}
+
+func (m *messageBusLibImpl) GetRootTimeOfFinality(root gethcommon.Hash) (ethereum.CallMsg, error) {
+	data, err := m.contractABI.Pack(GetRootTimeOfFinalityMethod, root)
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("could not pack %s call. Cause: %w", GetRootTimeOfFinalityMethod, err)
+	}
+	return ethereum.CallMsg{To: m.addr, Data: data}, nil
+}
+
+func (m *messageBusLibImpl) GetMessageTimeOfFinality(msg common.CrossChainMessage) (ethereum.CallMsg, error) {
+	data, err := m.contractABI.Pack(GetMessageTimeOfFinalityMethod, msg)
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("could not pack %s call. Cause: %w", GetMessageTimeOfFinalityMethod, err)
+	}
+	return ethereum.CallMsg{To: m.addr, Data: data}, nil
+}
+
+func (m *messageBusLibImpl) DecodeTimeOfFinality(callResponse []byte) (*big.Int, error) {
+	unpacked, err := m.unpackSingle(GetRootTimeOfFinalityMethod, callResponse)
+	if err != nil {
+		return nil, err
+	}
+	timeOfFinality, ok := unpacked.(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected %s response type %T", GetRootTimeOfFinalityMethod, unpacked)
+	}
+	return timeOfFinality, nil
+}
+
+func (m *messageBusLibImpl) GetPublishFee(payloadLength int) (ethereum.CallMsg, error) {
+	data, err := m.contractABI.Pack(GetPublishFeeMethod, big.NewInt(int64(payloadLength)))
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("could not pack %s call. Cause: %w", GetPublishFeeMethod, err)
+	}
+	return ethereum.CallMsg{To: m.addr, Data: data}, nil
+}
+
+func (m *messageBusLibImpl) DecodePublishFee(callResponse []byte) (*big.Int, error) {
+	unpacked, err := m.unpackSingle(GetPublishFeeMethod, callResponse)
+	if err != nil {
+		return nil, err
+	}
+	fee, ok := unpacked.(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected %s response type %T", GetPublishFeeMethod, unpacked)
+	}
+	return fee, nil
+}
+
+func (m *messageBusLibImpl) MessagePublishedTopic() gethcommon.Hash {
+	return m.contractABI.Events[MessagePublishedEvent].ID
+}
+
+func (m *messageBusLibImpl) DecodeMessagePublished(log types.Log) (common.CrossChainMessage, error) {
+	event, err := m.filterer.ParseLogMessagePublished(log)
+	if err != nil {
+		return common.CrossChainMessage{}, fmt.Errorf("could not decode %s log. Cause: %w", MessagePublishedEvent, err)
+	}
+	return common.CrossChainMessage{
+		Sender:           event.Sender,
+		Sequence:         event.Sequence,
+		Nonce:            event.Nonce,
+		Topic:            event.Topic,
+		Payload:          event.Payload,
+		ConsistencyLevel: event.ConsistencyLevel,
+	}, nil
+}
+
+func (m *messageBusLibImpl) unpackSingle(method string, callResponse []byte) (interface{}, error) {
+	unpacked, err := m.contractABI.Unpack(method, callResponse)
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack %s response. Cause: %w", method, err)
+	}
+	if len(unpacked) != 1 {
+		return nil, fmt.Errorf("unexpected %s response length %d", method, len(unpacked))
+	}
+	return unpacked[0], nil
+}
+
+// toBytes32 converts the proof to the type the abi packs as bytes32[]
+func toBytes32(hashes []gethcommon.Hash) [][32]byte {
+	converted := make([][32]byte, len(hashes))
+	for i, h := range hashes {
+		converted[i] = h
+	}
+	return converted
+}
@@ -8,6 +8,7 @@ const (
 	RequestSecretMethod    = "RequestNetworkSecret"
 	InitializeSecretMethod = "InitializeNetworkSecret" //#nosec
 	GetHostAddressesMethod = "GetHostAddresses"
+	SubmitForcedTxMethod   = "SubmitForcedTransaction"
 )
 
 var MgmtContractABI = ManagementContract.ManagementContractMetaData.ABI
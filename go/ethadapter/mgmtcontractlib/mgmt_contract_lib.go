@@ -10,7 +10,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/obscuronet/go-obscuro/contracts/generated/ManagementContract"
-	"github.com/obscuronet/go-obscuro/contracts/generated/MessageBus"
 	"github.com/obscuronet/go-obscuro/go/common"
 	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/ethadapter"
@@ -28,6 +27,7 @@ type MgmtContractLib interface {
 	CreateRequestSecret(tx *ethadapter.L1RequestSecretTx) types.TxData
 	CreateRespondSecret(tx *ethadapter.L1RespondSecretTx, verifyAttester bool) types.TxData
 	CreateInitializeSecret(tx *ethadapter.L1InitializeSecretTx) types.TxData
+	CreateForcedTx(tx *ethadapter.L1ForcedTx) types.TxData
 	GetHostAddresses() (ethereum.CallMsg, error)
 
 	// DecodeTx receives a *types.Transaction and converts it to an common.L1Transaction
@@ -93,6 +93,9 @@ func (c *contractLibImpl) DecodeTx(tx *types.Transaction) ethadapter.L1Transacti
 
 	case InitializeSecretMethod:
 		return c.unpackInitSecretTx(tx, method, contractCallData)
+
+	case SubmitForcedTxMethod:
+		return c.unpackForcedTx(tx, method, contractCallData)
 	}
 
 	return nil
@@ -110,17 +113,13 @@ func (c *contractLibImpl) CreateRollup(t *ethadapter.L1RollupTx) types.TxData {
 		Hash:               decodedRollup.Hash(),
 		AggregatorID:       decodedRollup.Header.Coinbase,
 		LastSequenceNumber: big.NewInt(int64(decodedRollup.Header.LastBatchSeqNo)),
-	}
-
-	crossChain := ManagementContract.StructsHeaderCrossChainData{
-		Messages: convertCrossChainMessages(decodedRollup.Header.CrossChainMessages),
+		CrossChainRoot:     decodedRollup.Header.CrossChainRoot,
 	}
 
 	data, err := c.contractABI.Pack(
 		AddRollupMethod,
 		metaRollup,
 		encRollupData,
-		crossChain,
 	)
 	if err != nil {
 		panic(err)
@@ -180,6 +179,17 @@ func (c *contractLibImpl) CreateInitializeSecret(tx *ethadapter.L1InitializeSecr
 	}
 }
 
+func (c *contractLibImpl) CreateForcedTx(tx *ethadapter.L1ForcedTx) types.TxData {
+	data, err := c.contractABI.Pack(SubmitForcedTxMethod, tx.EncryptedTx)
+	if err != nil {
+		panic(err)
+	}
+	return &types.LegacyTx{
+		To:   c.addr,
+		Data: data,
+	}
+}
+
 func (c *contractLibImpl) GetHostAddresses() (ethereum.CallMsg, error) {
 	data, err := c.contractABI.Pack(GetHostAddressesMethod)
 	if err != nil {
@@ -228,6 +238,22 @@ func (c *contractLibImpl) unpackInitSecretTx(tx *types.Transaction, method *abi.
 	}
 }
 
+// unpackForcedTx does not panic on invalid call data, unlike the other transactions it is submitted by any user
+func (c *contractLibImpl) unpackForcedTx(tx *types.Transaction, method *abi.Method, contractCallData map[string]interface{}) *ethadapter.L1ForcedTx {
+	if err := method.Inputs.UnpackIntoMap(contractCallData, tx.Data()[methodBytesLen:]); err != nil {
+		c.logger.Warn("could not unpack forced transaction.", log.TxKey, tx.Hash(), log.ErrKey, err)
+		return nil
+	}
+	encryptedTx, ok := contractCallData["_encryptedTx"].([]byte)
+	if !ok {
+		c.logger.Warn("call data not found for encryptedTx.", log.TxKey, tx.Hash())
+		return nil
+	}
+	return &ethadapter.L1ForcedTx{
+		EncryptedTx: encryptedTx,
+	}
+}
+
 func (c *contractLibImpl) unpackRequestSecretTx(tx *types.Transaction, method *abi.Method, contractCallData map[string]interface{}) *ethadapter.L1RequestSecretTx {
 	err := method.Inputs.UnpackIntoMap(contractCallData, tx.Data()[methodBytesLen:])
 	if err != nil {
@@ -310,19 +336,3 @@ func Base64DecodeFromString(in string) []byte {
 	}
 	return bytesStr
 }
-
-func convertCrossChainMessages(messages []MessageBus.StructsCrossChainMessage) []ManagementContract.StructsCrossChainMessage {
-	msgs := make([]ManagementContract.StructsCrossChainMessage, 0)
-
-	for _, message := range messages {
-		msgs = append(msgs, ManagementContract.StructsCrossChainMessage{
-			Sender:   message.Sender,
-			Sequence: message.Sequence,
-			Nonce:    message.Nonce,
-			Topic:    message.Topic,
-			Payload:  message.Payload,
-		})
-	}
-
-	return msgs
-}
@@ -163,7 +163,7 @@ func NewHostContainer(cfg *config.HostConfig, services *host.ServicesRegistry, p
 			{
 				Namespace: APINamespaceObscuro,
 				Version:   APIVersion1,
-				Service:   clientapi.NewObscuroAPI(h),
+				Service:   clientapi.NewObscuroAPI(h, logger),
 				Public:    true,
 			},
 			{
@@ -85,6 +85,11 @@ func NewGuardian(cfg *config.HostConfig, hostData host.Identity, serviceLocator
 }
 
 func (g *Guardian) Start() error {
+	// the enclave must not be fed any other chain than the one starting with the configured block
+	if err := g.verifyL1Start(); err != nil {
+		return err
+	}
+
 	go g.mainLoop()
 	if g.hostData.IsSequencer {
 		// if we are a sequencer then we need to start the periodic batch/rollup production
@@ -185,6 +190,17 @@ func (g *Guardian) HandleTransaction(tx common.EncryptedTx) {
 	}
 }
 
+func (g *Guardian) HandleViewingKeyRevocation(encryptedParams common.EncryptedParamsRevokeViewingKey) {
+	resp, sysError := g.enclaveClient.RevokeViewingKey(encryptedParams)
+	if sysError != nil {
+		g.logger.Warn("could not revoke viewing key due to sysError", log.ErrKey, sysError)
+		return
+	}
+	if resp.Error() != nil {
+		g.logger.Trace("could not revoke viewing key", log.ErrKey, resp.Error())
+	}
+}
+
 // mainLoop runs until the enclave guardian is stopped. It checks the state of the enclave and takes action as
 // required to improve the state (e.g. provide a secret, catch up with L1, etc.)
 func (g *Guardian) mainLoop() {
@@ -329,12 +345,15 @@ func (g *Guardian) catchupWithL1() error {
 	for !g.hostInterrupter.IsStopping() && g.state.GetStatus() == L1Catchup {
 		// generally we will be feeding the block after the enclave's current head
 		enclaveHead := g.state.GetEnclaveL1Head()
+		var l1Block *types.Block
+		var isLatest bool
+		var err error
 		if enclaveHead == gethutil.EmptyHash {
-			// but if enclave has no current head, then we use the configured hash to find the first block to feed
-			enclaveHead = g.l1StartHash
+			// but if enclave has no current head, then we feed it the configured start block
+			l1Block, err = g.fetchL1StartBlock()
+		} else {
+			l1Block, isLatest, err = g.sl.L1Repo().FetchNextBlock(enclaveHead)
 		}
-
-		l1Block, isLatest, err := g.sl.L1Repo().FetchNextBlock(enclaveHead)
 		if err != nil {
 			if errors.Is(err, l1.ErrNoNextBlock) {
 				if g.state.hostL1Head == gethutil.EmptyHash {
@@ -352,6 +371,26 @@ func (g *Guardian) catchupWithL1() error {
 	return nil
 }
 
+// verifyL1Start returns an error if the configured L1 start block can't be found, rather than falling back to another block
+func (g *Guardian) verifyL1Start() error {
+	if g.l1StartHash == gethutil.EmptyHash {
+		g.logger.Warn("No L1 start block configured, the enclave will be fed the L1 chain from its genesis block")
+		return nil
+	}
+	if _, err := g.sl.L1Repo().FetchBlock(g.l1StartHash); err != nil {
+		return fmt.Errorf("could not find the configured L1 start block %s. Cause: %w", g.l1StartHash, err)
+	}
+	return nil
+}
+
+// fetchL1StartBlock returns the first block fed to the enclave, the L1 genesis block if no start block is configured
+func (g *Guardian) fetchL1StartBlock() (*types.Block, error) {
+	if g.l1StartHash == gethutil.EmptyHash {
+		return g.sl.L1Repo().FetchBlockByHeight(big.NewInt(0))
+	}
+	return g.sl.L1Repo().FetchBlock(g.l1StartHash)
+}
+
 func (g *Guardian) catchupWithL2() error {
 	// while we are behind the L2 head and still running:
 	for !g.hostInterrupter.IsStopping() && g.state.GetStatus() == L2Catchup {
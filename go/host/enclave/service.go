@@ -109,6 +109,27 @@ func (e *Service) SubmitAndBroadcastTx(encryptedParams common.EncryptedParamsSen
 	return enclaveResponse, nil
 }
 
+func (e *Service) SubmitAndBroadcastViewingKeyRevocation(encryptedParams common.EncryptedParamsRevokeViewingKey) (*responses.ViewingKeyRevocation, error) {
+	enclaveResponse, sysError := e.enclaveGuardian.GetEnclaveClient().RevokeViewingKey(encryptedParams)
+	if sysError != nil {
+		e.logger.Warn("Could not revoke viewing key due to sysError.", log.ErrKey, sysError)
+		return nil, sysError
+	}
+	if enclaveResponse.Error() != nil {
+		e.logger.Trace("Could not revoke viewing key.", log.ErrKey, enclaveResponse.Error())
+		return enclaveResponse, nil //nolint: nilerr
+	}
+
+	if !e.hostData.IsSequencer {
+		err := e.sl.P2P().SendViewingKeyRevocationToSequencer(encryptedParams)
+		if err != nil {
+			return nil, fmt.Errorf("could not send viewing key revocation to sequencer. Cause: %w", err)
+		}
+	}
+
+	return enclaveResponse, nil
+}
+
 func (e *Service) Subscribe(id rpc.ID, encryptedParams common.EncryptedParamsLogSubscription) error {
 	return e.enclaveGuardian.GetEnclaveClient().Subscribe(id, encryptedParams)
 }
@@ -141,6 +141,13 @@ func (h *host) SubmitAndBroadcastTx(encryptedParams common.EncryptedParamsSendRa
 	return h.services.Enclaves().SubmitAndBroadcastTx(encryptedParams)
 }
 
+func (h *host) SubmitAndBroadcastViewingKeyRevocation(encryptedParams common.EncryptedParamsRevokeViewingKey) (*responses.ViewingKeyRevocation, error) {
+	if h.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested SubmitAndBroadcastViewingKeyRevocation with the host stopping"))
+	}
+	return h.services.Enclaves().SubmitAndBroadcastViewingKeyRevocation(encryptedParams)
+}
+
 func (h *host) Subscribe(id rpc.ID, encryptedLogSubscription common.EncryptedParamsLogSubscription, matchedLogsCh chan []byte) error {
 	if h.stopControl.IsStopping() {
 		return responses.ToInternalError(fmt.Errorf("requested Subscribe with the host stopping"))
@@ -220,6 +220,10 @@ func (r *Repository) FetchBlockByHeight(height *big.Int) (*types.Block, error) {
 	return r.ethClient.BlockByNumber(height)
 }
 
+func (r *Repository) FetchBlock(blockHash gethcommon.Hash) (*types.Block, error) {
+	return r.ethClient.BlockByHash(blockHash)
+}
+
 // isObscuroTransaction will look at the 'to' address of the transaction, we are only interested in management contract and bridge transactions
 func (r *Repository) isObscuroTransaction(transaction *types.Transaction) bool {
 	for _, address := range r.obscuroRelevantContracts {
@@ -30,6 +30,7 @@ const (
 	msgTypeTx msgType = iota
 	msgTypeBatches
 	msgTypeBatchRequest
+	msgTypeViewingKeyRevocation
 )
 
 var (
@@ -206,6 +207,18 @@ func (p *Service) SendTxToSequencer(tx common.EncryptedTx) error {
 	return p.send(msg, sequencer)
 }
 
+func (p *Service) SendViewingKeyRevocationToSequencer(encryptedParams common.EncryptedParamsRevokeViewingKey) error {
+	if p.isSequencer {
+		return errors.New("sequencer cannot send viewing key revocation to itself")
+	}
+	msg := message{Sender: p.ourPublicAddress, Type: msgTypeViewingKeyRevocation, Contents: encryptedParams}
+	sequencer, err := p.getSequencer()
+	if err != nil {
+		return fmt.Errorf("failed to find sequencer - %w", err)
+	}
+	return p.send(msg, sequencer)
+}
+
 func (p *Service) BroadcastBatches(batches []*common.ExtBatch) error {
 	if p.isIncomingP2PDisabled {
 		return nil
@@ -344,6 +357,15 @@ func (p *Service) handle(conn net.Conn) {
 		for _, txSubs := range p.txSubscribers.Subscribers() {
 			txSubs.HandleTransaction(msg.Contents)
 		}
+	case msgTypeViewingKeyRevocation:
+		if !p.isSequencer {
+			p.logger.Error("received viewing key revocation from peer, but not a sequencer node")
+			return
+		}
+		// The revocation is encrypted, so we cannot check that it's correctly formed.
+		for _, txSubs := range p.txSubscribers.Subscribers() {
+			txSubs.HandleViewingKeyRevocation(msg.Contents)
+		}
 	case msgTypeBatches:
 		if p.isSequencer {
 			p.logger.Error("received batch from peer, but this is a sequencer node")
@@ -66,9 +66,18 @@ func (api *EthereumAPI) GetBlockByHash(_ context.Context, hash gethcommon.Hash,
 	return *batchHeader, nil
 }
 
-// GasPrice is a placeholder for an RPC method required by MetaMask/Remix.
+// GasPrice returns the base fee of the head batch, which is the minimum gas price a transaction must pay to be
+// included in the next batch, unless the base fee increases in between.
 func (api *EthereumAPI) GasPrice(context.Context) (*hexutil.Big, error) {
-	return (*hexutil.Big)(big.NewInt(1)), nil
+	headBatchHeader, err := api.host.DB().GetHeadBatchHeader()
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return nil, err
+	}
+	// before the first batch is produced, or for batches produced without a base fee, we fall back to the minimum
+	if headBatchHeader == nil || headBatchHeader.BaseFee == nil || headBatchHeader.BaseFee.Sign() == 0 {
+		return (*hexutil.Big)(big.NewInt(1)), nil
+	}
+	return (*hexutil.Big)(headBatchHeader.BaseFee), nil
 }
 
 // GetBalance returns the address's balance on the Obscuro network, encrypted with the viewing key corresponding to the
@@ -110,6 +119,15 @@ func (api *EthereumAPI) EstimateGas(_ context.Context, encryptedParams common.En
 	return *enclaveResponse, nil
 }
 
+// CreateAccessList requests the enclave the access list of the callMsg supplied params (encrypted)
+func (api *EthereumAPI) CreateAccessList(_ context.Context, encryptedParams common.EncryptedParamsAccessList) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().CreateAccessList(encryptedParams)
+	if sysError != nil {
+		return api.handleSysError("CreateAccessList", sysError)
+	}
+	return *enclaveResponse, nil
+}
+
 // SendRawTransaction sends the encrypted transaction.
 func (api *EthereumAPI) SendRawTransaction(_ context.Context, encryptedParams common.EncryptedParamsSendRawTx) (responses.EnclaveResponse, error) {
 	enclaveResponse, sysError := api.host.SubmitAndBroadcastTx(encryptedParams)
@@ -1,18 +1,30 @@
 package clientapi
 
 import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/obscuronet/go-obscuro/go/common"
 	"github.com/obscuronet/go-obscuro/go/common/host"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/responses"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
 )
 
 // ObscuroAPI implements Obscuro-specific JSON RPC operations.
 type ObscuroAPI struct {
-	host host.Host
+	host   host.Host
+	logger gethlog.Logger
 }
 
-func NewObscuroAPI(host host.Host) *ObscuroAPI {
+func NewObscuroAPI(host host.Host, logger gethlog.Logger) *ObscuroAPI {
 	return &ObscuroAPI{
-		host: host,
+		host:   host,
+		logger: logger,
 	}
 }
 
@@ -25,3 +37,114 @@ func (api *ObscuroAPI) Health() (*host.HealthCheck, error) {
 func (api *ObscuroAPI) Config() (*common.ObscuroNetworkInfo, error) {
 	return api.host.ObscuroConfig()
 }
+
+// L1GasPrice returns the base fee of the latest L1 block, which prices the L1 data fee the transactions pay in addition
+// to the L2 gas, based on their compressed size. The fee is debited from the balance of the sender, so it is not part
+// of the gas price nor of the gas estimates.
+func (api *ObscuroAPI) L1GasPrice() (*hexutil.Big, error) {
+	l1BaseFee, err := api.l1BaseFee()
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(l1BaseFee), nil
+}
+
+// EstimateL1Fee returns the L1 data fee of the binary encoded transaction, signed or not, at the base fee of the latest
+// L1 block. The fee charged is priced at the base fee of the L1 block of the batch including the transaction.
+func (api *ObscuroAPI) EstimateL1Fee(encodedTx hexutil.Bytes) (*hexutil.Big, error) {
+	tx := new(common.L2Tx)
+	if err := tx.UnmarshalBinary(encodedTx); err != nil {
+		return nil, fmt.Errorf("could not decode transaction. Cause: %w", err)
+	}
+	l1BaseFee, err := api.l1BaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	var l1Fee *big.Int
+	if _, _, r := tx.RawSignatureValues(); r == nil || r.Sign() == 0 {
+		l1Fee, err = common.EstimateL1DataFee(tx, l1BaseFee)
+	} else {
+		l1Fee, _, err = common.L1DataFee(tx, l1BaseFee)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(l1Fee), nil
+}
+
+func (api *ObscuroAPI) l1BaseFee() (*big.Int, error) {
+	l1Head, err := api.host.DB().GetBlockAtTip()
+	if err != nil {
+		return nil, err
+	}
+	if l1Head.BaseFee == nil {
+		return big.NewInt(0), nil
+	}
+	return l1Head.BaseFee, nil
+}
+
+// RevokeViewingKey revokes the viewing key authenticating the request in the enclave of this host, and forwards the
+// revocation to the sequencer, which shares it with the other enclaves. The confirmation is encrypted with the revoked
+// viewing key.
+func (api *ObscuroAPI) RevokeViewingKey(encryptedParams common.EncryptedParamsRevokeViewingKey) (responses.EnclaveResponse, error) {
+	enclaveResponse, err := api.host.SubmitAndBroadcastViewingKeyRevocation(encryptedParams)
+	if err != nil {
+		api.logger.Error(fmt.Sprintf("Enclave System Error. Function %s", "RevokeViewingKey"), log.ErrKey, err)
+		return responses.EnclaveResponse{
+			Err: &responses.InternalErrMsg,
+		}, nil
+	}
+	return *enclaveResponse, nil
+}
+
+// SimulateCalls returns the results of executing the list of calls in order on top of the head batch state, encrypted
+// with the viewing key corresponding to the `from` field of the calls.
+func (api *ObscuroAPI) SimulateCalls(encryptedParams common.EncryptedParamsSimulateCalls) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().SimulateCalls(encryptedParams)
+	if sysError != nil {
+		api.logger.Error(fmt.Sprintf("Enclave System Error. Function %s", "SimulateCalls"), log.ErrKey, sysError)
+		return responses.EnclaveResponse{
+			Err: &responses.InternalErrMsg,
+		}, nil
+	}
+	return *enclaveResponse, nil
+}
+
+// GetInboundMessageStatus returns the delivery status of the cross chain messages published by an L1 transaction,
+// encrypted with the viewing key of the sender of the transaction.
+func (api *ObscuroAPI) GetInboundMessageStatus(encryptedParams common.EncryptedParamsInboundMessages) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().GetInboundMessageStatus(encryptedParams)
+	if sysError != nil {
+		api.logger.Error(fmt.Sprintf("Enclave System Error. Function %s", "GetInboundMessageStatus"), log.ErrKey, sysError)
+		return responses.EnclaveResponse{
+			Err: &responses.InternalErrMsg,
+		}, nil
+	}
+	return *enclaveResponse, nil
+}
+
+// GetOutboundMessages returns the cross chain messages published by the transactions of an account in a range of
+// batches, encrypted with the viewing key of the account.
+func (api *ObscuroAPI) GetOutboundMessages(encryptedParams common.EncryptedParamsOutboundMessages) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().GetOutboundMessages(encryptedParams)
+	if sysError != nil {
+		api.logger.Error(fmt.Sprintf("Enclave System Error. Function %s", "GetOutboundMessages"), log.ErrKey, sysError)
+		return responses.EnclaveResponse{
+			Err: &responses.InternalErrMsg,
+		}, nil
+	}
+	return *enclaveResponse, nil
+}
+
+// GetCrossChainProof returns the proof that the outbound cross chain message published by the log at the index of the
+// transaction is included in the cross chain root of a rollup, to be verified by the L1 message bus. Returns nil if
+// there is no such message, or if its batch has not been published in a rollup yet.
+func (api *ObscuroAPI) GetCrossChainProof(txHash gethcommon.Hash, logIndex hexutil.Uint64) (*common.CrossChainProof, error) {
+	proof, sysError := api.host.EnclaveClient().GetCrossChainProof(txHash, uint64(logIndex))
+	if sysError != nil {
+		api.logger.Error(fmt.Sprintf("Enclave System Error. Function %s", "GetCrossChainProof"), log.ErrKey, sysError)
+		return nil, errors.New(responses.InternalErrMsg)
+	}
+	return proof, nil
+}
@@ -41,6 +41,11 @@ func (s *ScanAPI) GetPublicTransactionData(pagination *common.QueryPagination) (
 	return s.host.EnclaveClient().GetPublicTransactionData(pagination)
 }
 
+// GetBridgeLedger returns the balance locked in the L1 bridge and the supply minted on the L2 of each bridged token.
+func (s *ScanAPI) GetBridgeLedger() ([]common.BridgeTokenBalance, error) {
+	return s.host.EnclaveClient().GetBridgeLedger()
+}
+
 func (s *ScanAPI) GetBatchListing(pagination *common.QueryPagination) (*common.BatchListingResponse, error) {
 	return s.host.DB().GetBatchListing(pagination)
 }
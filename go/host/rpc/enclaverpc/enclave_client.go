@@ -366,6 +366,91 @@ func (c *Client) EstimateGas(encryptedParams common.EncryptedParamsEstimateGas)
 	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
 }
 
+func (c *Client) SimulateCalls(encryptedParams common.EncryptedParamsSimulateCalls) (*responses.SimulatedCalls, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.SimulateCalls(timeoutCtx, &generated.SimulateCallsRequest{
+		EncryptedParams: encryptedParams,
+	})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+
+	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
+}
+
+func (c *Client) CreateAccessList(encryptedParams common.EncryptedParamsAccessList) (*responses.AccessList, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.CreateAccessList(timeoutCtx, &generated.CreateAccessListRequest{
+		EncryptedParams: encryptedParams,
+	})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+
+	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
+}
+
+func (c *Client) RevokeViewingKey(encryptedParams common.EncryptedParamsRevokeViewingKey) (*responses.ViewingKeyRevocation, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.RevokeViewingKey(timeoutCtx, &generated.RevokeViewingKeyRequest{
+		EncryptedParams: encryptedParams,
+	})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+
+	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
+}
+
+func (c *Client) GetInboundMessageStatus(encryptedParams common.EncryptedParamsInboundMessages) (*responses.InboundMessageStatus, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetInboundMessageStatus(timeoutCtx, &generated.GetInboundMessageStatusRequest{
+		EncryptedParams: encryptedParams,
+	})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+
+	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
+}
+
+func (c *Client) GetOutboundMessages(encryptedParams common.EncryptedParamsOutboundMessages) (*responses.OutboundMessages, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetOutboundMessages(timeoutCtx, &generated.GetOutboundMessagesRequest{
+		EncryptedParams: encryptedParams,
+	})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+
+	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
+}
+
 func (c *Client) GetLogs(encryptedParams common.EncryptedParamsGetLogs) (*responses.Logs, common.SystemError) {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
@@ -479,6 +564,31 @@ func (c *Client) GetBatchBySeqNo(seqNo uint64) (*common.ExtBatch, common.SystemE
 	return common.DecodeExtBatch(batchMsg.Batch)
 }
 
+func (c *Client) GetCrossChainProof(txHash gethcommon.Hash, logIndex uint64) (*common.CrossChainProof, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetCrossChainProof(timeoutCtx, &generated.GetCrossChainProofRequest{
+		TxHash:   txHash.Bytes(),
+		LogIndex: logIndex,
+	})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	if len(response.Proof) == 0 {
+		return nil, nil
+	}
+
+	var proof common.CrossChainProof
+	if err = json.Unmarshal(response.Proof, &proof); err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not decode cross chain proof. Cause: %w", err))
+	}
+	return &proof, nil
+}
+
 func (c *Client) StreamL2Updates() (chan common.StreamL2UpdatesResponse, func()) {
 	// channel size is 10 to allow for some buffering but caller is expected to read immediately to avoid blocking
 	batchChan := make(chan common.StreamL2UpdatesResponse, 10)
@@ -596,3 +706,22 @@ func (c *Client) GetPublicTransactionData(pagination *common.QueryPagination) (*
 
 	return &result, nil
 }
+
+func (c *Client) GetBridgeLedger() ([]common.BridgeTokenBalance, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetBridgeLedger(timeoutCtx, &generated.GetBridgeLedgerRequest{})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+
+	var ledger []common.BridgeTokenBalance
+	if err = json.Unmarshal(response.Ledger, &ledger); err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not unmarshal the bridge ledger. Cause: %w", err))
+	}
+	return ledger, nil
+}
@@ -78,6 +78,7 @@ func (c *Config) ToEnclaveConfig() *config.EnclaveConfig {
 	cfg.LogLevel = c.logLevel
 	cfg.Address = fmt.Sprintf("%s:%d", _localhost, c.enclaveWSPort)
 	cfg.DebugNamespaceEnabled = c.debugNamespaceEnabled
+	cfg.L1StartHash = gethcommon.HexToHash(c.l1Start)
 
 	return cfg
 }
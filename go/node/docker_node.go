@@ -171,6 +171,7 @@ func (d *DockerNode) startEnclave() error {
 		"-hostAddress", d.cfg.hostPublicP2PAddr,
 		"-sequencerID", d.cfg.sequencerID,
 		"-messageBusAddress", d.cfg.messageBusContractAddress,
+		"-l1Start", d.cfg.l1Start,
 		"-profilerEnabled=false",
 		"-useInMemoryDB=false",
 		"-logPath", "sys_out",
@@ -2,6 +2,7 @@ package obsclient
 
 import (
 	"context"
+	"errors"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum"
@@ -9,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/gethapi"
 	"github.com/obscuronet/go-obscuro/go/common/viewingkey"
 	"github.com/obscuronet/go-obscuro/go/responses"
 	"github.com/obscuronet/go-obscuro/go/rpc"
@@ -106,6 +108,87 @@ func (ac *AuthObsClient) CallContract(ctx context.Context, msg ethereum.CallMsg,
 	return []byte(result), nil
 }
 
+// CallContractWithOverrides executes the call against the state at the given block number, after applying the state
+// and block overrides. The overrides are only applied for this call and are never persisted.
+func (ac *AuthObsClient) CallContractWithOverrides(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int, overrides *gethapi.StateOverride, blockOverrides *gethapi.BlockOverrides) ([]byte, error) {
+	var result responses.CallType
+	err := ac.rpcClient.CallContext(ctx, &result, rpc.Call, ToCallArg(msg), toBlockNumArg(blockNumber), overrides, blockOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(result), nil
+}
+
+// SimulateCalls executes the calls in order against the state at the given block number, with each call seeing the
+// state changes of the previous ones. A failed call does not abort the simulation - its error is reported in the
+// corresponding result. Nothing is persisted.
+func (ac *AuthObsClient) SimulateCalls(ctx context.Context, msgs []ethereum.CallMsg, blockNumber *big.Int, overrides *gethapi.StateOverride) ([]common.SimulatedCallResult, error) {
+	calls := make([]interface{}, 0, len(msgs))
+	for _, msg := range msgs {
+		calls = append(calls, ToCallArg(msg))
+	}
+
+	var result []common.SimulatedCallResult
+	err := ac.rpcClient.CallContext(ctx, &result, rpc.SimulateCalls, calls, toBlockNumArg(blockNumber), overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// RevokeViewingKey revokes the viewing key of the client in the enclaves, so it can no longer be used to decrypt the
+// data of the account, even by someone holding a copy of the key and its signature. The confirmation is the last
+// response the client can decrypt.
+func (ac *AuthObsClient) RevokeViewingKey(ctx context.Context) error {
+	var revoked bool
+	return ac.rpcClient.CallContext(ctx, &revoked, rpc.RevokeViewingKey, ac.account)
+}
+
+// GetInboundMessageStatus returns the delivery status of the cross chain messages published by the L1 transaction,
+// which must have been sent by the account of the client. Returns ethereum.NotFound if the transaction did not publish
+// any message in a canonical L1 block.
+func (ac *AuthObsClient) GetInboundMessageStatus(ctx context.Context, l1TxHash gethcommon.Hash) ([]common.InboundMessageStatus, error) {
+	var result []common.InboundMessageStatus
+	err := ac.rpcClient.CallContext(ctx, &result, rpc.GetInboundMessageStatus, l1TxHash)
+	if errors.Is(err, rpc.ErrNilResponse) {
+		return nil, ethereum.NotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetOutboundMessages returns the cross chain messages published by the transactions of the account of the client in
+// the batches of the range. Their proofs can be retrieved with GetCrossChainProof.
+func (ac *AuthObsClient) GetOutboundMessages(ctx context.Context, batchRange common.BatchRange) ([]common.OutboundMessage, error) {
+	var result []common.OutboundMessage
+	err := ac.rpcClient.CallContext(ctx, &result, rpc.GetOutboundMessages, ac.account, batchRange)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CreateAccessList returns the access list of the call against the latest state, and the gas used by the call when
+// the access list is attached to it.
+func (ac *AuthObsClient) CreateAccessList(ctx context.Context, msg ethereum.CallMsg) (*types.AccessList, uint64, error) {
+	var result common.AccessListResult
+	err := ac.rpcClient.CallContext(ctx, &result, rpc.CreateAccessList, ToCallArg(msg), toBlockNumArg(nil))
+	if err != nil {
+		return nil, 0, err
+	}
+	if result.Error != "" {
+		return result.Accesslist, uint64(result.GasUsed), errors.New(result.Error)
+	}
+
+	return result.Accesslist, uint64(result.GasUsed), nil
+}
+
 func (ac *AuthObsClient) SendTransaction(ctx context.Context, signedTx *types.Transaction) error {
 	var result responses.RawTxType
 	err := ac.rpcClient.CallContext(ctx, &result, rpc.SendRawTransaction, encodeTx(signedTx))
@@ -163,6 +246,18 @@ func (ac *AuthObsClient) EstimateGas(ctx context.Context, msg *ethereum.CallMsg)
 	return hexutil.DecodeUint64(result.String())
 }
 
+// EstimateGasWithOverrides estimates the gas of the call against the latest state, after applying the state and block
+// overrides. The overrides are only applied for this estimation and are never persisted.
+func (ac *AuthObsClient) EstimateGasWithOverrides(ctx context.Context, msg *ethereum.CallMsg, overrides *gethapi.StateOverride, blockOverrides *gethapi.BlockOverrides) (uint64, error) {
+	var result responses.GasType
+	err := ac.rpcClient.CallContext(ctx, &result, rpc.EstimateGas, ToCallArg(*msg), toBlockNumArg(nil), overrides, blockOverrides)
+	if err != nil {
+		return 0, err
+	}
+
+	return hexutil.DecodeUint64(result.String())
+}
+
 func (ac *AuthObsClient) EstimateGasAndGasPrice(txData types.TxData) types.TxData {
 	unEstimatedTx := types.NewTx(txData)
 	gasPrice := gethcommon.Big1 // constant gas price atm
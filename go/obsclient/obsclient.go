@@ -2,6 +2,7 @@ package obsclient
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 	"strings"
 
@@ -99,6 +100,43 @@ func (oc *ObsClient) Health() (bool, error) {
 	return healthy.OverallHealth, nil
 }
 
+// L1GasPrice returns the L1 base fee used to price the L1 data fee of the transactions
+func (oc *ObsClient) L1GasPrice() (*big.Int, error) {
+	var l1GasPrice hexutil.Big
+	err := oc.rpcClient.Call(&l1GasPrice, rpc.L1GasPrice)
+	if err != nil {
+		return nil, err
+	}
+	return l1GasPrice.ToInt(), nil
+}
+
+// GetCrossChainProof returns the proof of the outbound cross chain message published by the log at the index of the
+// transaction, to be verified by the L1 message bus once the root is final. Returns ethereum.NotFound if there is no
+// such message, or if it has not been published in a rollup yet.
+func (oc *ObsClient) GetCrossChainProof(txHash gethcommon.Hash, logIndex uint) (*common.CrossChainProof, error) {
+	var proof *common.CrossChainProof
+	err := oc.rpcClient.Call(&proof, rpc.GetCrossChainProof, txHash, hexutil.Uint64(logIndex))
+	if errors.Is(err, rpc.ErrNilResponse) || (err == nil && proof == nil) {
+		err = ethereum.NotFound
+	}
+	return proof, err
+}
+
+// EstimateL1Fee returns the L1 data fee the transaction pays on top of its L2 gas, which is debited from the balance of
+// its sender. The transaction does not need to be signed.
+func (oc *ObsClient) EstimateL1Fee(tx *common.L2Tx) (*big.Int, error) {
+	encodedTx, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("could not encode transaction. Cause: %w", err)
+	}
+	var l1Fee hexutil.Big
+	err = oc.rpcClient.Call(&l1Fee, rpc.EstimateL1Fee, hexutil.Bytes(encodedTx))
+	if err != nil {
+		return nil, err
+	}
+	return l1Fee.ToInt(), nil
+}
+
 // GetTotalContractCount returns the total count of created contracts
 func (oc *ObsClient) GetTotalContractCount() (int, error) {
 	var count int
@@ -139,6 +177,16 @@ func (oc *ObsClient) GetPublicTxListing(pagination *common.QueryPagination) (*co
 	return &result, nil
 }
 
+// GetBridgeLedger returns the balance locked in the L1 bridge and the supply minted on the L2 of each bridged token
+func (oc *ObsClient) GetBridgeLedger() ([]common.BridgeTokenBalance, error) {
+	var ledger []common.BridgeTokenBalance
+	err := oc.rpcClient.Call(&ledger, rpc.GetBridgeLedger)
+	if err != nil {
+		return nil, err
+	}
+	return ledger, nil
+}
+
 // GetBatchesListing returns a list of batches
 func (oc *ObsClient) GetBatchesListing(pagination *common.QueryPagination) (*common.BatchListingResponse, error) {
 	var result common.BatchListingResponse
@@ -38,6 +38,11 @@ type (
 	Logs                 = EnclaveResponse
 	Receipts             = EnclaveResponse
 	PrivateQueryResponse = EnclaveResponse
+	SimulatedCalls       = EnclaveResponse // As above, but for an RPC simulateCalls response.
+	AccessList           = EnclaveResponse // As above, but for an RPC createAccessList response.
+	ViewingKeyRevocation = EnclaveResponse // As above, but for an RPC revokeViewingKey response.
+	InboundMessageStatus = EnclaveResponse // As above, but for an RPC getInboundMessageStatus response.
+	OutboundMessages     = EnclaveResponse // As above, but for an RPC getOutboundMessages response.
 )
 
 // Data Types
@@ -22,9 +22,17 @@ const (
 	EstimateGas           = "eth_estimateGas"
 	GetLogs               = "eth_getLogs"
 	GetStorageAt          = "eth_getStorageAt"
+	CreateAccessList      = "eth_createAccessList"
 
-	Health = "obscuro_health"
-	Config = "obscuro_config"
+	Health                  = "obscuro_health"
+	Config                  = "obscuro_config"
+	SimulateCalls           = "obscuro_simulateCalls"
+	L1GasPrice              = "obscuro_l1GasPrice"
+	EstimateL1Fee           = "obscuro_estimateL1Fee"
+	RevokeViewingKey        = "obscuro_revokeViewingKey"
+	GetCrossChainProof      = "obscuro_getCrossChainProof"
+	GetInboundMessageStatus = "obscuro_getInboundMessageStatus"
+	GetOutboundMessages     = "obscuro_getOutboundMessages"
 
 	GetBlockHeaderByHash = "obscuroscan_getBlockHeaderByHash"
 	GetBatch             = "obscuroscan_getBatch"
@@ -47,6 +55,7 @@ const (
 	GetBatchListing          = "scan_getBatchListing"
 	GetBlockListing          = "scan_getBlockListing"
 	GetFullBatchByHash       = "scan_getBatchByHash"
+	GetBridgeLedger          = "scan_getBridgeLedger"
 )
 
 var ErrNilResponse = errors.New("nil response received from Obscuro node")
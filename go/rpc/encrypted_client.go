@@ -41,6 +41,11 @@ var SensitiveMethods = []string{
 	EstimateGas,
 	GetLogs,
 	GetStorageAt,
+	SimulateCalls,
+	CreateAccessList,
+	RevokeViewingKey,
+	GetInboundMessageStatus,
+	GetOutboundMessages,
 }
 
 // EncRPCClient is a Client wrapper that implements Client but also has extra functionality for managing viewing key registration and decryption
@@ -53,12 +58,10 @@ type EncRPCClient struct {
 
 // NewEncRPCClient sets up a client with a viewing key for encrypted communication
 func NewEncRPCClient(client Client, viewingKey *viewingkey.ViewingKey, logger gethlog.Logger) (*EncRPCClient, error) {
-	// todo: this is a convenience for testnet but needs to replaced by a parameter and/or retrieved from the target host
-	enclPubECDSA, err := crypto.DecompressPubkey(gethcommon.Hex2Bytes(enclavePublicKeyHex))
+	enclavePublicKey, err := enclaveKey()
 	if err != nil {
-		return nil, fmt.Errorf("failed to decompress key for RPC client: %w", err)
+		return nil, err
 	}
-	enclavePublicKey := ecies.ImportECDSAPublic(enclPubECDSA)
 
 	encClient := &EncRPCClient{
 		obscuroClient:    client,
@@ -70,6 +73,29 @@ func NewEncRPCClient(client Client, viewingKey *viewingkey.ViewingKey, logger ge
 	return encClient, nil
 }
 
+// EncryptForEnclave encrypts the data with the enclave public key, e.g. a signed L2 transaction forced through the
+// management contract
+func EncryptForEnclave(data []byte) ([]byte, error) {
+	enclavePublicKey, err := enclaveKey()
+	if err != nil {
+		return nil, err
+	}
+	encryptedData, err := ecies.Encrypt(rand.Reader, enclavePublicKey, data, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not encrypt data with enclave public key. Cause: %w", err)
+	}
+	return encryptedData, nil
+}
+
+func enclaveKey() (*ecies.PublicKey, error) {
+	// todo: this is a convenience for testnet but needs to replaced by a parameter and/or retrieved from the target host
+	enclPubECDSA, err := crypto.DecompressPubkey(gethcommon.Hex2Bytes(enclavePublicKeyHex))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress key for RPC client: %w", err)
+	}
+	return ecies.ImportECDSAPublic(enclPubECDSA), nil
+}
+
 // Call handles JSON rpc requests without a context - see CallContext for details
 func (c *EncRPCClient) Call(result interface{}, method string, args ...interface{}) error {
 	return c.CallContext(nil, result, method, args...) //nolint:staticcheck
@@ -276,7 +302,7 @@ func (c *EncRPCClient) executeSensitiveCall(ctx context.Context, result interfac
 	if decodedError != nil {
 		// EstimateGas and Call methods return EVM Errors that are json objects
 		// and contain multiple keys that normally do not get serialized
-		if method == EstimateGas || method == Call {
+		if method == EstimateGas || method == Call || method == SimulateCalls {
 			var result errutil.EVMSerialisableError
 			err = json.Unmarshal([]byte(decodedError.Error()), &result)
 			if err != nil {
@@ -0,0 +1,138 @@
+package ethereummock
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/contracts/generated/ObscuroBridge"
+	"github.com/obscuronet/go-obscuro/go/ethadapter/messagebuslib"
+	"github.com/obscuronet/go-obscuro/integration/datagenerator"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// BridgeAddress - the mock network emulates the L1 bridge at this address. The transactions calling `sendNative` or
+	// `sendERC20` on it are given a receipt with the message the bridge would publish in the MessageBusAddress.
+	BridgeAddress = datagenerator.RandomAddress()
+	// MessageBusAddress - the address of the L1 message bus the emulated bridge publishes its messages in
+	MessageBusAddress = datagenerator.RandomAddress()
+	// RemoteBridgeAddress - the L2 bridge the messages of the emulated bridge are addressed to
+	RemoteBridgeAddress = datagenerator.RandomAddress()
+	// MessageFee - the fee the emulated message bus charges for the messages of the bridge, which is paid by the value of
+	// the calls to the bridge. The messages of the bridge all have the same length, so the fee is the same for all.
+	MessageFee = big.NewInt(1_000_000)
+)
+
+// the topic of the bridge transfers, as defined in IBridge.Topics
+const transferTopic = 0
+
+var (
+	bridgeABI     = mustParseABI(ObscuroBridge.ObscuroBridgeMetaData.ABI)
+	messageBusABI = mustParseABI(messagebuslib.MessageBusABI)
+
+	// the encoding of ICrossChainMessenger.CrossChainCall, which is the payload of the messages queued by the bridge
+	crossChainCallArgs = abi.Arguments{{Type: mustNewTupleType([]abi.ArgumentMarshaling{
+		{Name: "target", Type: "address"},
+		{Name: "data", Type: "bytes"},
+		{Name: "gas", Type: "uint256"},
+	})}}
+)
+
+// isBridgeTx returns true if the transaction is a call to the emulated L1 bridge
+func isBridgeTx(tx *types.Transaction) bool {
+	return tx.To() != nil && *tx.To() == BridgeAddress
+}
+
+// bridgeReceipt returns the receipt of a call to the emulated L1 bridge, with the log of the message it publishes.
+// The message only depends on the transaction, so that all the nodes return the same receipt.
+func bridgeReceipt(tx *types.Transaction) (*types.Receipt, error) {
+	if len(tx.Data()) < 4 {
+		return nil, errors.New("call to the mock bridge has no method")
+	}
+	method, err := bridgeABI.MethodById(tx.Data()[:4])
+	if err != nil {
+		return nil, fmt.Errorf("unknown method of the mock bridge. Cause: %w", err)
+	}
+	args, err := method.Inputs.Unpack(tx.Data()[4:])
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack %s call. Cause: %w", method.Name, err)
+	}
+
+	var asset gethcommon.Address
+	var amount *big.Int
+	var receiver gethcommon.Address
+	switch method.Name {
+	case "sendNative":
+		// the bridge takes the message fee from the value, and transfers the rest
+		amount, receiver = new(big.Int).Sub(tx.Value(), MessageFee), args[0].(gethcommon.Address)
+		if amount.Sign() <= 0 {
+			return revertedReceipt(tx), nil
+		}
+	case "sendERC20":
+		if tx.Value().Cmp(MessageFee) < 0 {
+			return revertedReceipt(tx), nil
+		}
+		asset, amount, receiver = args[0].(gethcommon.Address), args[1].(*big.Int), args[2].(gethcommon.Address)
+	default:
+		return nil, fmt.Errorf("method %s of the mock bridge is not emulated", method.Name)
+	}
+
+	data, err := bridgeABI.Pack("receiveAssets", asset, amount, receiver)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := crossChainCallArgs.Pack(struct {
+		Target gethcommon.Address
+		Data   []byte
+		Gas    *big.Int
+	}{RemoteBridgeAddress, data, big.NewInt(0)})
+	if err != nil {
+		return nil, err
+	}
+
+	// the real message bus counts the messages of each sender, the mock uses the transaction hash to keep them unique
+	sequence := binary.BigEndian.Uint64(tx.Hash().Bytes()[:8])
+	event := messageBusABI.Events[messagebuslib.MessagePublishedEvent]
+	logData, err := event.Inputs.NonIndexed().Pack(BridgeAddress, sequence, uint32(0), uint32(transferTopic), payload, uint8(0))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Receipt{
+		Status: types.ReceiptStatusSuccessful,
+		TxHash: tx.Hash(),
+		Logs: []*types.Log{{
+			Address: MessageBusAddress,
+			Topics:  []gethcommon.Hash{event.ID},
+			Data:    logData,
+			TxHash:  tx.Hash(),
+		}},
+	}, nil
+}
+
+// revertedReceipt returns the receipt of a call the bridge reverts, which publishes no message
+func revertedReceipt(tx *types.Transaction) *types.Receipt {
+	return &types.Receipt{Status: types.ReceiptStatusFailed, TxHash: tx.Hash()}
+}
+
+func mustParseABI(contractABI string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(contractABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+func mustNewTupleType(components []abi.ArgumentMarshaling) abi.Type {
+	t, err := abi.NewType("tuple", "", components)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
@@ -22,6 +22,7 @@ var (
 	storeSecretTxAddr      = datagenerator.RandomAddress()
 	requestSecretTxAddr    = datagenerator.RandomAddress()
 	initializeSecretTxAddr = datagenerator.RandomAddress()
+	forcedTxAddr           = datagenerator.RandomAddress()
 	// MgmtContractAddresses make all these addresses available for the host to know what receipts will be forwarded to the enclave
 	MgmtContractAddresses = []gethcommon.Address{
 		depositTxAddr,
@@ -29,6 +30,7 @@ var (
 		storeSecretTxAddr,
 		requestSecretTxAddr,
 		initializeSecretTxAddr,
+		forcedTxAddr,
 	}
 )
 
@@ -51,6 +53,10 @@ func (m *mockContractLib) DecodeTx(tx *types.Transaction) ethadapter.L1Transacti
 	if tx.To().Hex() == depositTxAddr.Hex() {
 		return nil
 	}
+	// The calls to the emulated bridge are not management contract transactions either
+	if isBridgeTx(tx) {
+		return nil
+	}
 
 	return decodeTx(tx)
 }
@@ -71,6 +77,10 @@ func (m *mockContractLib) CreateInitializeSecret(tx *ethadapter.L1InitializeSecr
 	return encodeTx(tx, initializeSecretTxAddr)
 }
 
+func (m *mockContractLib) CreateForcedTx(tx *ethadapter.L1ForcedTx) types.TxData {
+	return encodeTx(tx, forcedTxAddr)
+}
+
 func (m *mockContractLib) GetHostAddresses() (ethereum.CallMsg, error) {
 	return ethereum.CallMsg{}, nil
 }
@@ -103,6 +113,8 @@ func decodeTx(tx *types.Transaction) ethadapter.L1Transaction {
 		t = &ethadapter.L1RequestSecretTx{}
 	case initializeSecretTxAddr.Hex():
 		t = &ethadapter.L1InitializeSecretTx{}
+	case forcedTxAddr.Hex():
+		t = &ethadapter.L1ForcedTx{}
 	default:
 		panic("unexpected type")
 	}
@@ -67,6 +67,9 @@ type Node struct {
 	subs     map[uuid.UUID]*mockSubscription // active subscription for mock blocks
 	subMu    sync.Mutex
 
+	bridgeTxs   map[common.TxHash]*types.Transaction // the calls to the emulated bridge, to produce their receipts
+	bridgeTxsMu sync.RWMutex
+
 	// Channels
 	exitCh       chan bool // the Node stops
 	exitMiningCh chan bool // the mining loop is notified to stop
@@ -99,17 +102,35 @@ func (m *Node) PrepareTransactionToSend(txData types.TxData, _ gethcommon.Addres
 }
 
 func (m *Node) SendTransaction(tx *types.Transaction) error {
+	m.recordBridgeTx(tx)
 	m.Network.BroadcastTx(tx)
 	return nil
 }
 
-func (m *Node) TransactionReceipt(_ gethcommon.Hash) (*types.Receipt, error) {
+func (m *Node) TransactionReceipt(hash gethcommon.Hash) (*types.Receipt, error) {
+	m.bridgeTxsMu.RLock()
+	bridgeTx, found := m.bridgeTxs[hash]
+	m.bridgeTxsMu.RUnlock()
+	if found {
+		return bridgeReceipt(bridgeTx)
+	}
+
 	// all transactions are immediately processed
 	return &types.Receipt{
 		Status: types.ReceiptStatusSuccessful,
 	}, nil
 }
 
+// recordBridgeTx keeps the calls to the emulated bridge, as their receipts have the logs of the published messages
+func (m *Node) recordBridgeTx(tx *types.Transaction) {
+	if !isBridgeTx(tx) {
+		return
+	}
+	m.bridgeTxsMu.Lock()
+	defer m.bridgeTxsMu.Unlock()
+	m.bridgeTxs[tx.Hash()] = tx
+}
+
 func (m *Node) Nonce(gethcommon.Address) (uint64, error) {
 	return 0, nil
 }
@@ -441,6 +462,7 @@ func (m *Node) P2PGossipTx(tx *types.Transaction) {
 		return
 	}
 
+	m.recordBridgeTx(tx)
 	m.mempoolCh <- tx
 }
 
@@ -532,6 +554,7 @@ func NewMiner(
 		logger:           log.New(log.EthereumL1Cmp, int(gethlog.LvlInfo), cfg.LogFile, log.NodeIDKey, id),
 		subs:             map[uuid.UUID]*mockSubscription{},
 		subMu:            sync.Mutex{},
+		bridgeTxs:        map[common.TxHash]*types.Transaction{},
 	}
 }
 
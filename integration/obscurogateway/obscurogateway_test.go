@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -50,13 +51,22 @@ func TestObscuroGateway(t *testing.T) {
 	wallets := createObscuroNetwork(t, startPort)
 
 	obscuroGatewayConf := config.Config{
-		WalletExtensionHost:     "127.0.0.1",
-		WalletExtensionPortHTTP: startPort + integration.DefaultObscuroGatewayHTTPPortOffset,
-		WalletExtensionPortWS:   startPort + integration.DefaultObscuroGatewayWSPortOffset,
-		NodeRPCHTTPAddress:      fmt.Sprintf("127.0.0.1:%d", startPort+integration.DefaultHostRPCHTTPOffset),
-		NodeRPCWebsocketAddress: fmt.Sprintf("127.0.0.1:%d", startPort+integration.DefaultHostRPCWSOffset),
-		LogPath:                 "sys_out",
-		VerboseFlag:             false,
+		WalletExtensionHost:        "127.0.0.1",
+		WalletExtensionPortHTTP:    startPort + integration.DefaultObscuroGatewayHTTPPortOffset,
+		WalletExtensionPortWS:      startPort + integration.DefaultObscuroGatewayWSPortOffset,
+		NodeRPCHTTPAddresses:       []string{fmt.Sprintf("127.0.0.1:%d", startPort+integration.DefaultHostRPCHTTPOffset)},
+		NodeRPCWebsocketAddresses:  []string{fmt.Sprintf("127.0.0.1:%d", startPort+integration.DefaultHostRPCWSOffset)},
+		LogPath:                    "sys_out",
+		VerboseFlag:                false,
+		MasterKeyPath:              filepath.Join(t.TempDir(), "master_key"),
+		GenerateMasterKey:          true,
+		MaxBatchSize:               100,
+		HostHealthCheckInterval:    10 * time.Second,
+		HostFailureThreshold:       3,
+		HostCircuitBreakerCooldown: 30 * time.Second,
+		ObscuroChainID:             integration.ObscuroChainID,
+		ViewingKeyValidity:         time.Hour,
+		AllowLegacySignatures:      true,
 	}
 
 	obscuroGwContainer := container.NewWalletExtensionContainerFromConfig(obscuroGatewayConf, testlog.Logger())
@@ -81,7 +91,7 @@ func TestObscuroGateway(t *testing.T) {
 
 	vk, err := viewingkey.GenerateViewingKeyForWallet(w)
 	assert.Nil(t, err)
-	client, err := rpc.NewEncNetworkClient(fmt.Sprintf("ws://%s", obscuroGatewayConf.NodeRPCWebsocketAddress), vk, testlog.Logger())
+	client, err := rpc.NewEncNetworkClient(fmt.Sprintf("ws://%s", obscuroGatewayConf.NodeRPCWebsocketAddresses[0]), vk, testlog.Logger())
 	assert.Nil(t, err)
 	authClient := obsclient.NewAuthObsClient(client)
 
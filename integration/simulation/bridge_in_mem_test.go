@@ -0,0 +1,178 @@
+package simulation
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/bridge"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/enclave/crosschain"
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+	"github.com/obscuronet/go-obscuro/go/ethadapter/messagebuslib"
+	"github.com/obscuronet/go-obscuro/integration"
+	"github.com/obscuronet/go-obscuro/integration/common/testlog"
+	"github.com/obscuronet/go-obscuro/integration/datagenerator"
+	"github.com/obscuronet/go-obscuro/integration/ethereummock"
+	"github.com/obscuronet/go-obscuro/integration/simulation/network"
+	"github.com/obscuronet/go-obscuro/integration/simulation/params"
+	"github.com/obscuronet/go-obscuro/integration/simulation/stats"
+	"github.com/stretchr/testify/require"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	testcommon "github.com/obscuronet/go-obscuro/integration/common"
+)
+
+// This test runs the bridge client against a network of in memory nodes. The mock L1 emulates the messages published by
+// the L1 bridge, which the enclaves deliver to the L2 message bus, while the L1 contracts checking the withdrawals are
+// not emulated.
+func TestBridgeClientAgainstInMemoryNetwork(t *testing.T) {
+	setupSimTestLog("bridge-in-mem")
+
+	numberOfNodes := 2
+	wallets := params.NewSimWallets(1, numberOfNodes, integration.EthereumChainID, integration.ObscuroChainID)
+	simParams := params.SimParams{
+		NumberOfNodes:    numberOfNodes,
+		AvgBlockDuration: 250 * time.Millisecond,
+		MgmtContractLib:  ethereummock.NewMgmtContractLibMock(),
+		ERC20ContractLib: ethereummock.NewERC20ContractLibMock(),
+		Wallets:          wallets,
+		StartPort:        integration.StartPortSimulationInMem,
+		IsInMem:          true,
+		L1SetupData:      &params.L1SetupData{},
+		ReceiptTimeout:   30 * time.Second,
+	}
+	simParams.AvgNetworkLatency = simParams.AvgBlockDuration / 15
+
+	netw := network.NewBasicNetworkOfInMemoryNodes()
+	defer netw.TearDown()
+	rpcHandles, err := netw.Create(&simParams, stats.NewStats(numberOfNodes))
+	require.NoError(t, err)
+	simulation := Simulation{RPCHandles: rpcHandles, Params: &simParams, ctx: context.Background()}
+	simulation.waitForObscuroGenesisOnL1()
+	simulation.prefundObscuroAccounts()
+
+	l1Wallet, l2Wallet := wallets.SimEthWallets[0], wallets.SimObsWallets[0]
+	l2Client := rpcHandles.ObscuroWalletClient(l2Wallet.Address(), 0)
+	// the address of the L2 message bus only depends on the key of its owner
+	l2MessageBus := crosschain.NewObscuroMessageBusManager(nil, big.NewInt(integration.ObscuroChainID), testlog.Logger()).GetBusAddress()
+	cfg := &bridge.Config{
+		L1BridgeAddress:     ethereummock.BridgeAddress,
+		L1MessageBusAddress: ethereummock.MessageBusAddress,
+		L1MessengerAddress:  datagenerator.RandomAddress(),
+		L2MessageBusAddress: *l2MessageBus,
+		PollInterval:        simParams.AvgBlockDuration,
+	}
+	client := bridge.NewClient(cfg, &noContractsL1Client{rpcHandles.EthClients[0], cfg.L1MessengerAddress}, l2Client, l1Wallet, l2Wallet, testlog.Logger())
+	events := make(chan bridge.Event, 10)
+	sub := client.SubscribeEvents(events)
+	defer sub.Unsubscribe()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	// the deposit is matched with the message the synthetic transaction stored in the L2 message bus
+	deposit, err := client.DepositNative(ctx, big.NewInt(1000), l2Wallet.Address())
+	require.NoError(t, err)
+	require.Equal(t, ethereummock.BridgeAddress, deposit.Message.Sender)
+	require.Equal(t, bridge.DepositSent, (<-events).Type)
+	require.NoError(t, client.WaitForDeposit(ctx, deposit))
+	require.True(t, deposit.Delivered)
+	require.Equal(t, bridge.Event{Type: bridge.DepositDelivered, TxHash: deposit.L1TxHash, Message: deposit.Message}, <-events)
+
+	// the sender of the deposit can follow the delivery of its message, as the sim wallets share their keys across layers
+	statuses, err := l2Client.GetInboundMessageStatus(ctx, deposit.L1TxHash)
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	require.Equal(t, common.InboundMessageDelivered, statuses[0].Status)
+	require.Equal(t, deposit.Message, statuses[0].Message)
+	require.Equal(t, l1Wallet.Address(), statuses[0].L1TxSender)
+	require.NotNil(t, statuses[0].L2TxHash)
+	_, err = l2Client.GetInboundMessageStatus(ctx, gethcommon.BytesToHash(datagenerator.RandomBytes(gethcommon.HashLength)))
+	require.ErrorIs(t, err, ethereum.NotFound)
+
+	// the native currency locked by the deposit is in the ledger of the bridge, with no wrapped token minted on the L2
+	ledger, err := rpcHandles.ObscuroClients[1].GetBridgeLedger()
+	require.NoError(t, err)
+	require.Len(t, ledger, 1)
+	require.Equal(t, gethcommon.Address{}, ledger[0].Token)
+	require.Equal(t, big.NewInt(1000), ledger[0].Locked.ToInt())
+	require.Zero(t, ledger[0].Minted.ToInt().Sign())
+	require.False(t, ledger[0].SupplyExceedsLocked())
+
+	// the message of a withdrawal is published in the L2 message bus, and is in a rollup once its batch is rolled up
+	txHash := publishL2Message(ctx, t, &simulation, *l2MessageBus)
+	withdrawal, err := client.TrackWithdrawal(ctx, txHash)
+	require.NoError(t, err)
+	require.Equal(t, l2Wallet.Address(), withdrawal.Message.Sender)
+	require.Equal(t, bridge.WithdrawalSent, (<-events).Type)
+
+	// the sender of the withdrawal finds its message in the batch of its transaction
+	receipt, err := l2Client.TransactionReceipt(ctx, txHash)
+	require.NoError(t, err)
+	batchHeight := receipt.BlockNumber.Uint64()
+	outboundMessages, err := l2Client.GetOutboundMessages(ctx, common.BatchRange{From: batchHeight, To: batchHeight})
+	require.NoError(t, err)
+	require.Len(t, outboundMessages, 1)
+	require.Equal(t, txHash, outboundMessages[0].TxHash)
+	require.Equal(t, withdrawal.Message, outboundMessages[0].Message)
+	for withdrawal.Status == bridge.WithdrawalPending {
+		_, err = client.WithdrawalStatus(withdrawal)
+		require.NoError(t, err)
+		select {
+		case <-ctx.Done():
+			t.Fatal("withdrawal was not rolled up")
+		case <-time.After(simParams.AvgBlockDuration):
+		}
+	}
+	require.Equal(t, bridge.WithdrawalInRollup, withdrawal.Status)
+	require.Equal(t, withdrawal.Message, withdrawal.Proof.Message)
+	require.Equal(t, bridge.WithdrawalInRollup, (<-events).Status)
+}
+
+// publishL2Message publishes a message in the L2 message bus from the first simulated wallet, as the L2 bridge would
+func publishL2Message(ctx context.Context, t *testing.T, simulation *Simulation, l2MessageBus gethcommon.Address) gethcommon.Hash {
+	data, err := messageBusABI.Pack("publishMessage", uint32(0), uint32(0), []byte("withdrawal"), uint8(0))
+	require.NoError(t, err)
+
+	l2Wallet := simulation.Params.Wallets.SimObsWallets[0]
+	l2Client := simulation.RPCHandles.ObscuroWalletClient(l2Wallet.Address(), 0)
+	tx := l2Client.EstimateGasAndGasPrice(&types.LegacyTx{
+		Nonce: NextNonce(ctx, simulation.RPCHandles, l2Wallet),
+		Gas:   uint64(1_000_000),
+		To:    &l2MessageBus,
+		Data:  data,
+	})
+	signedTx, err := l2Wallet.SignTransaction(tx)
+	require.NoError(t, err)
+	require.NoError(t, l2Client.SendTransaction(ctx, signedTx))
+	require.NoError(t, testcommon.AwaitReceipt(ctx, l2Client, signedTx.Hash(), simulation.Params.ReceiptTimeout))
+	return signedTx.Hash()
+}
+
+var messageBusABI, _ = abi.JSON(strings.NewReader(messagebuslib.MessageBusABI))
+
+// noContractsL1Client answers the calls to the L1 contracts, which the mock L1 does not run, as if the messenger had
+// not consumed any message and the message bus had not stored any root. The message bus charges the fee of the mock L1.
+type noContractsL1Client struct {
+	ethadapter.EthClient
+	messengerAddress gethcommon.Address
+}
+
+func (c *noContractsL1Client) CallContract(msg ethereum.CallMsg) ([]byte, error) {
+	if *msg.To == c.messengerAddress {
+		// the encoding of false
+		return make([]byte, 32), nil
+	}
+	if *msg.To == ethereummock.MessageBusAddress && bytes.Equal(msg.Data[:4], messageBusABI.Methods[messagebuslib.GetPublishFeeMethod].ID) {
+		return messageBusABI.Methods[messagebuslib.GetPublishFeeMethod].Outputs.Pack(ethereummock.MessageFee)
+	}
+	return nil, errors.New("execution reverted")
+}
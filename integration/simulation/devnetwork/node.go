@@ -158,24 +158,28 @@ func (n *InMemNodeOperator) createEnclaveContainer() *enclavecontainer.EnclaveCo
 	hostAddr := fmt.Sprintf("%s:%d", network.Localhost, hostPort)
 
 	enclaveConfig := &config.EnclaveConfig{
-		HostID:                    n.l1Wallet.Address(),
-		SequencerID:               n.config.SequencerID,
-		HostAddress:               hostAddr,
-		Address:                   enclaveAddr,
-		NodeType:                  n.nodeType,
-		L1ChainID:                 integration.EthereumChainID,
-		ObscuroChainID:            integration.ObscuroChainID,
-		ValidateL1Blocks:          false,
-		WillAttest:                false,
-		GenesisJSON:               nil,
-		UseInMemoryDB:             false,
-		ManagementContractAddress: n.l1Data.MgmtContractAddress,
-		MinGasPrice:               big.NewInt(1),
-		MessageBusAddress:         n.l1Data.MessageBusAddr,
-		SqliteDBPath:              n.enclaveDBFilepath,
-		DebugNamespaceEnabled:     true,
-		MaxBatchSize:              1024 * 25,
-		MaxRollupSize:             1024 * 64,
+		HostID:                          n.l1Wallet.Address(),
+		SequencerID:                     n.config.SequencerID,
+		HostAddress:                     hostAddr,
+		Address:                         enclaveAddr,
+		NodeType:                        n.nodeType,
+		L1ChainID:                       integration.EthereumChainID,
+		ObscuroChainID:                  integration.ObscuroChainID,
+		WillAttest:                      false,
+		UseInMemoryDB:                   false,
+		ManagementContractAddress:       n.l1Data.MgmtContractAddress,
+		MinGasPrice:                     big.NewInt(1),
+		MessageBusAddress:               n.l1Data.MessageBusAddr,
+		SqliteDBPath:                    n.enclaveDBFilepath,
+		DebugNamespaceEnabled:           true,
+		MaxBatchSize:                    1024 * 25,
+		MaxRollupSize:                   1024 * 64,
+		TargetGasPerBatch:               1_000_000_000,
+		ElasticityMultiplier:            2,
+		AllowLegacyViewingKeySignatures: true,
+		ForcedTxInclusionWindow:         config.DefaultEnclaveConfig().ForcedTxInclusionWindow,
+		L1StartHash:                     n.l1Data.ObscuroStartBlock,
+		L1MaxReorgDepth:                 config.DefaultEnclaveConfig().L1MaxReorgDepth,
 	}
 	return enclavecontainer.NewEnclaveContainerWithLogger(enclaveConfig, enclaveLogger)
 }
@@ -0,0 +1,141 @@
+package simulation
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+	"github.com/obscuronet/go-obscuro/go/rpc"
+	"github.com/obscuronet/go-obscuro/go/wallet"
+	"github.com/obscuronet/go-obscuro/integration"
+	"github.com/obscuronet/go-obscuro/integration/datagenerator"
+	"github.com/obscuronet/go-obscuro/integration/ethereummock"
+	"github.com/obscuronet/go-obscuro/integration/simulation/network"
+	"github.com/obscuronet/go-obscuro/integration/simulation/params"
+	"github.com/obscuronet/go-obscuro/integration/simulation/stats"
+	"github.com/stretchr/testify/require"
+
+	testcommon "github.com/obscuronet/go-obscuro/integration/common"
+)
+
+const forcedTxInclusionWindow = 3
+
+// The forced transaction is never sent to the sequencer, which must include it in a batch once the inclusion window
+// has passed.
+func TestForcedTransactionIsIncludedInMemory(t *testing.T) {
+	setupSimTestLog("forced-tx-in-mem")
+
+	simulation, teardown := startForcedTxSimulation(t, false)
+	defer teardown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	tx := forceTransaction(ctx, t, simulation)
+
+	// the validator only accepts the batch including the transaction
+	validatorClient := simulation.RPCHandles.ObscuroWalletClient(simulation.Params.Wallets.SimObsWallets[0].Address(), 1)
+	require.NoError(t, testcommon.AwaitReceipt(ctx, validatorClient, tx.Hash(), simulation.Params.ReceiptTimeout))
+}
+
+// The sequencer leaves out the forced transaction past its deadline, so the validator rejects its batches and stops
+// following the chain.
+func TestCensoringSequencerIsRejectedInMemory(t *testing.T) {
+	setupSimTestLog("forced-tx-censorship-in-mem")
+
+	simulation, teardown := startForcedTxSimulation(t, true)
+	defer teardown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	tx := forceTransaction(ctx, t, simulation)
+
+	// past the deadline, the batches of the sequencer are rejected by the validator, so the transactions the sequencer
+	// includes afterwards are never executed by the validator
+	time.Sleep(2 * forcedTxInclusionWindow * simulation.Params.AvgBlockDuration)
+	transferTx := transfer(ctx, t, simulation, simulation.Params.Wallets.SimObsWallets[1])
+	sequencerClient := simulation.RPCHandles.ObscuroWalletClient(simulation.Params.Wallets.SimObsWallets[1].Address(), 0)
+	require.NoError(t, testcommon.AwaitReceipt(ctx, sequencerClient, transferTx.Hash(), simulation.Params.ReceiptTimeout))
+	time.Sleep(5 * simulation.Params.AvgBlockDuration)
+
+	validatorClient := simulation.RPCHandles.ObscuroWalletClient(simulation.Params.Wallets.SimObsWallets[1].Address(), 1)
+	_, err := validatorClient.TransactionReceipt(ctx, transferTx.Hash())
+	require.True(t, errors.Is(err, rpc.ErrNilResponse), "the validator should not follow the censoring sequencer")
+
+	sequencerClient = simulation.RPCHandles.ObscuroWalletClient(simulation.Params.Wallets.SimObsWallets[0].Address(), 0)
+	_, err = sequencerClient.TransactionReceipt(ctx, tx.Hash())
+	require.True(t, errors.Is(err, rpc.ErrNilResponse), "the censoring sequencer should not include the forced transaction")
+}
+
+// startForcedTxSimulation starts a network of an in memory sequencer and validator, with the L2 accounts funded
+func startForcedTxSimulation(t *testing.T, censoringSequencer bool) (*Simulation, func()) {
+	numberOfNodes := 2
+	wallets := params.NewSimWallets(2, numberOfNodes, integration.EthereumChainID, integration.ObscuroChainID)
+	simParams := params.SimParams{
+		NumberOfNodes:           numberOfNodes,
+		AvgBlockDuration:        250 * time.Millisecond,
+		MgmtContractLib:         ethereummock.NewMgmtContractLibMock(),
+		ERC20ContractLib:        ethereummock.NewERC20ContractLibMock(),
+		Wallets:                 wallets,
+		StartPort:               integration.StartPortSimulationInMem,
+		IsInMem:                 true,
+		L1SetupData:             &params.L1SetupData{},
+		ReceiptTimeout:          30 * time.Second,
+		ForcedTxInclusionWindow: forcedTxInclusionWindow,
+		CensoringSequencer:      censoringSequencer,
+	}
+	simParams.AvgNetworkLatency = simParams.AvgBlockDuration / 15
+
+	netw := network.NewBasicNetworkOfInMemoryNodes()
+	rpcHandles, err := netw.Create(&simParams, stats.NewStats(numberOfNodes))
+	if err != nil {
+		netw.TearDown()
+		t.Fatal(err)
+	}
+	simulation := &Simulation{RPCHandles: rpcHandles, Params: &simParams, ctx: context.Background()}
+	simulation.waitForObscuroGenesisOnL1()
+	simulation.prefundObscuroAccounts()
+	return simulation, netw.TearDown
+}
+
+// forceTransaction submits a transfer from the first simulated wallet to the management contract, encrypted with the
+// enclave key, without sending it to the L2 nodes
+func forceTransaction(ctx context.Context, t *testing.T, simulation *Simulation) *types.Transaction {
+	l1Wallet, l2Wallet := simulation.Params.Wallets.SimEthWallets[0], simulation.Params.Wallets.SimObsWallets[0]
+	signedTx := signTransfer(ctx, t, simulation, l2Wallet)
+	txBytes, err := signedTx.MarshalBinary()
+	require.NoError(t, err)
+	encryptedTx, err := rpc.EncryptForEnclave(txBytes)
+	require.NoError(t, err)
+
+	l1Tx, err := l1Wallet.SignTransaction(simulation.Params.MgmtContractLib.CreateForcedTx(&ethadapter.L1ForcedTx{EncryptedTx: encryptedTx}))
+	require.NoError(t, err)
+	require.NoError(t, simulation.RPCHandles.EthClients[0].SendTransaction(l1Tx))
+	return signedTx
+}
+
+// transfer sends a transfer from the wallet to the sequencer
+func transfer(ctx context.Context, t *testing.T, simulation *Simulation, l2Wallet wallet.Wallet) *types.Transaction {
+	signedTx := signTransfer(ctx, t, simulation, l2Wallet)
+	sequencerClient := simulation.RPCHandles.ObscuroWalletClient(l2Wallet.Address(), 0)
+	require.NoError(t, sequencerClient.SendTransaction(ctx, signedTx))
+	return signedTx
+}
+
+func signTransfer(ctx context.Context, t *testing.T, simulation *Simulation, l2Wallet wallet.Wallet) *types.Transaction {
+	to := datagenerator.RandomAddress()
+	signedTx, err := l2Wallet.SignTransaction(&types.LegacyTx{
+		Nonce:    NextNonce(ctx, simulation.RPCHandles, l2Wallet),
+		Value:    big.NewInt(1),
+		Gas:      uint64(1_000_000),
+		GasPrice: big.NewInt(1),
+		To:       &to,
+	})
+	require.NoError(t, err)
+	return signedTx
+}
@@ -42,7 +42,7 @@ func (n *networkInMemGeth) Create(params *params.SimParams, _ *stats.Stats) (*RP
 		&params.L1SetupData.ObxErc20Address, &params.L1SetupData.EthErc20Address)
 
 	// Start the obscuro nodes and return the handles
-	n.l2Clients = startInMemoryObscuroNodes(params, n.eth2Network.GethGenesis(), n.gethClients)
+	n.l2Clients = startInMemoryObscuroNodes(params, n.gethClients)
 
 	obscuroClients := make([]*obsclient.ObsClient, params.NumberOfNodes)
 	for idx, l2Client := range n.l2Clients {
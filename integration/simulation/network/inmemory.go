@@ -1,9 +1,11 @@
 package network
 
 import (
+	"math"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	obscurocommon "github.com/obscuronet/go-obscuro/go/common"
 	"github.com/obscuronet/go-obscuro/go/common/host"
 	"github.com/obscuronet/go-obscuro/go/ethadapter"
 	"github.com/obscuronet/go-obscuro/go/host/container"
@@ -41,7 +43,6 @@ func (n *basicNetworkOfInMemoryNodes) Create(params *params.SimParams, stats *st
 	params.Wallets.Tokens[testcommon.HOC].L1ContractAddress = &dummyOBXAddress
 	dummyETHAddress := datagenerator.RandomAddress()
 	params.Wallets.Tokens[testcommon.POC].L1ContractAddress = &dummyETHAddress
-	dummyBus := datagenerator.RandomAddress()
 	// dummyMgmtContractAddress := datagenerator.RandomAddress()
 	// params.MgmtContractLib
 
@@ -50,6 +51,12 @@ func (n *basicNetworkOfInMemoryNodes) Create(params *params.SimParams, stats *st
 
 		incomingP2PDisabled := !isGenesis && i == params.NodeWithInboundP2PDisabled
 
+		inclusionWindow := forcedTxInclusionWindow(params)
+		if params.CensoringSequencer && GetNodeType(i) == obscurocommon.Sequencer {
+			// the deadline of the forced transactions is never reached from the point of view of the sequencer
+			inclusionWindow = math.MaxUint32
+		}
+
 		// create the in memory l1 and l2 node
 		miner := createMockEthNode(int64(i), params.NumberOfNodes, params.AvgBlockDuration, params.AvgNetworkLatency, stats)
 
@@ -58,16 +65,16 @@ func (n *basicNetworkOfInMemoryNodes) Create(params *params.SimParams, stats *st
 			isGenesis,
 			GetNodeType(i),
 			params.MgmtContractLib,
-			false,
-			nil,
 			params.Wallets.NodeWallets[i],
 			miner,
 			p2pNetw.NewNode(i),
-			dummyBus,
+			ethereummock.MessageBusAddress, // the mock L1 emulates the messages published by the bridge in this bus
+			ethereummock.BridgeAddress,
 			common.Hash{},
 			params.AvgBlockDuration/2,
 			incomingP2PDisabled,
 			params.AvgBlockDuration,
+			inclusionWindow,
 		)
 		obscuroClient := p2p.NewInMemObscuroClient(agg)
 
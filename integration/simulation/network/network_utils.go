@@ -23,6 +23,7 @@ import (
 	"github.com/obscuronet/go-obscuro/integration"
 	"github.com/obscuronet/go-obscuro/integration/common/testlog"
 	"github.com/obscuronet/go-obscuro/integration/ethereummock"
+	"github.com/obscuronet/go-obscuro/integration/simulation/params"
 	"github.com/obscuronet/go-obscuro/integration/simulation/stats"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -50,16 +51,16 @@ func createInMemObscuroNode(
 	isGenesis bool,
 	nodeType common.NodeType,
 	mgmtContractLib mgmtcontractlib.MgmtContractLib,
-	validateBlocks bool,
-	genesisJSON []byte,
 	ethWallet wallet.Wallet,
 	ethClient ethadapter.EthClient,
 	mockP2P hostcommon.P2PHostService,
 	l1BusAddress gethcommon.Address,
+	l1BridgeAddress gethcommon.Address,
 	l1StartBlk gethcommon.Hash,
 	batchInterval time.Duration,
 	incomingP2PDisabled bool,
 	l1BlockTime time.Duration,
+	forcedTxInclusionWindow uint64,
 ) *container.HostContainer {
 	mgtContractAddress := mgmtContractLib.GetContractAddr()
 
@@ -79,20 +80,27 @@ func createInMemObscuroNode(
 	}
 
 	enclaveConfig := &config.EnclaveConfig{
-		SequencerID:               gethcommon.BigToAddress(big.NewInt(0)),
-		HostID:                    hostConfig.ID,
-		NodeType:                  nodeType,
-		L1ChainID:                 integration.EthereumChainID,
-		ObscuroChainID:            integration.ObscuroChainID,
-		WillAttest:                false,
-		ValidateL1Blocks:          validateBlocks,
-		GenesisJSON:               genesisJSON,
-		UseInMemoryDB:             true,
-		MinGasPrice:               big.NewInt(1),
-		MessageBusAddress:         l1BusAddress,
-		ManagementContractAddress: *mgtContractAddress,
-		MaxBatchSize:              1024 * 25,
-		MaxRollupSize:             1024 * 64,
+		SequencerID:                     gethcommon.BigToAddress(big.NewInt(0)),
+		HostID:                          hostConfig.ID,
+		NodeType:                        nodeType,
+		L1ChainID:                       integration.EthereumChainID,
+		ObscuroChainID:                  integration.ObscuroChainID,
+		WillAttest:                      false,
+		UseInMemoryDB:                   true,
+		MinGasPrice:                     big.NewInt(1),
+		MessageBusAddress:               l1BusAddress,
+		ManagementContractAddress:       *mgtContractAddress,
+		MaxBatchSize:                    1024 * 25,
+		MaxRollupSize:                   1024 * 64,
+		TargetGasPerBatch:               1_000_000_000,
+		ElasticityMultiplier:            2,
+		MaxSyntheticGasPerBatch:         100_000_000,
+		SequencerFeeRecipient:           gethcommon.BigToAddress(big.NewInt(1)),
+		AllowLegacyViewingKeySignatures: true,
+		ForcedTxInclusionWindow:         forcedTxInclusionWindow,
+		L1StartHash:                     l1StartBlk,
+		L1MaxReorgDepth:                 config.DefaultEnclaveConfig().L1MaxReorgDepth,
+		L1BridgeAddress:                 l1BridgeAddress,
 	}
 
 	enclaveLogger := testlog.Logger().New(log.NodeIDKey, id, log.CmpKey, log.EnclaveCmp)
@@ -107,6 +115,14 @@ func createInMemObscuroNode(
 	return currentContainer
 }
 
+// forcedTxInclusionWindow returns the inclusion window of the forced transactions configured for the simulation
+func forcedTxInclusionWindow(params *params.SimParams) uint64 {
+	if params.ForcedTxInclusionWindow == 0 {
+		return config.DefaultEnclaveConfig().ForcedTxInclusionWindow
+	}
+	return params.ForcedTxInclusionWindow
+}
+
 func defaultMockEthNodeCfg(nrNodes int, avgBlockDuration time.Duration) ethereummock.MiningConfig {
 	return ethereummock.MiningConfig{
 		PowTime: func() time.Duration {
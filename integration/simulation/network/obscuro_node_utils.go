@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/obscuronet/go-obscuro/go/common/host"
 	"github.com/obscuronet/go-obscuro/go/ethadapter"
 	hostcontainer "github.com/obscuronet/go-obscuro/go/host/container"
@@ -29,7 +30,7 @@ const (
 	networkTCP        = "tcp"
 )
 
-func startInMemoryObscuroNodes(params *params.SimParams, genesisJSON []byte, l1Clients []ethadapter.EthClient) []rpc.Client {
+func startInMemoryObscuroNodes(params *params.SimParams, l1Clients []ethadapter.EthClient) []rpc.Client {
 	// Create the in memory obscuro nodes, each connect each to a geth node
 	obscuroNodes := make([]*hostcontainer.HostContainer, params.NumberOfNodes)
 	obscuroHosts := make([]host.Host, params.NumberOfNodes)
@@ -43,16 +44,16 @@ func startInMemoryObscuroNodes(params *params.SimParams, genesisJSON []byte, l1C
 			isGenesis,
 			GetNodeType(i),
 			params.MgmtContractLib,
-			true,
-			genesisJSON,
 			params.Wallets.NodeWallets[i],
 			l1Clients[i],
 			mockP2PNetw.NewNode(i),
 			params.L1SetupData.MessageBusAddr,
+			gethcommon.Address{}, // the bridge is not deployed
 			params.L1SetupData.ObscuroStartBlock,
 			params.AvgBlockDuration/3,
 			true,
 			params.AvgBlockDuration,
+			forcedTxInclusionWindow(params),
 		)
 		obscuroHosts[i] = obscuroNodes[i].Host()
 	}
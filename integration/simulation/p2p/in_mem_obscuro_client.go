@@ -34,6 +34,7 @@ type inMemObscuroClient struct {
 	ethAPI           *clientapi.EthereumAPI
 	filterAPI        *clientapi.FilterAPI
 	obscuroScanAPI   *clientapi.ObscuroScanAPI
+	scanAPI          *clientapi.ScanAPI
 	testAPI          *clientapi.TestAPI
 	enclavePublicKey *ecies.PublicKey
 }
@@ -48,10 +49,11 @@ func NewInMemObscuroClient(hostContainer *container.HostContainer) rpc.Client {
 	enclPubKey := ecies.ImportECDSAPublic(enclPubECDSA)
 
 	return &inMemObscuroClient{
-		obscuroAPI:       clientapi.NewObscuroAPI(hostContainer.Host()),
+		obscuroAPI:       clientapi.NewObscuroAPI(hostContainer.Host(), logger),
 		ethAPI:           clientapi.NewEthereumAPI(hostContainer.Host(), logger),
 		filterAPI:        clientapi.NewFilterAPI(hostContainer.Host(), logger),
 		obscuroScanAPI:   clientapi.NewObscuroScanAPI(hostContainer.Host()),
+		scanAPI:          clientapi.NewScanAPI(hostContainer.Host(), logger),
 		testAPI:          clientapi.NewTestAPI(hostContainer),
 		enclavePublicKey: enclPubKey,
 	}
@@ -69,9 +71,15 @@ func (c *inMemObscuroClient) Call(result interface{}, method string, args ...int
 	case rpc.Call:
 		return c.rpcCall(result, args)
 
+	case rpc.CreateAccessList:
+		return c.createAccessList(result, args)
+
 	case rpc.GetTransactionCount:
 		return c.getTransactionCount(result, args)
 
+	case rpc.RevokeViewingKey:
+		return c.revokeViewingKey(result, args)
+
 	case rpc.GetTransactionReceipt:
 		return c.getTransactionReceipt(result, args)
 
@@ -106,6 +114,18 @@ func (c *inMemObscuroClient) Call(result interface{}, method string, args ...int
 	case rpc.GetBatch:
 		return c.getBatch(result, args)
 
+	case rpc.GetInboundMessageStatus:
+		return c.getInboundMessageStatus(result, args)
+
+	case rpc.GetOutboundMessages:
+		return c.getOutboundMessages(result, args)
+
+	case rpc.GetCrossChainProof:
+		return c.getCrossChainProof(result, args)
+
+	case rpc.GetBridgeLedger:
+		return c.getBridgeLedger(result)
+
 	default:
 		return fmt.Errorf("RPC method %s is unknown", method)
 	}
@@ -162,6 +182,58 @@ func (c *inMemObscuroClient) rpcCall(result interface{}, args []interface{}) err
 	return nil
 }
 
+func (c *inMemObscuroClient) createAccessList(result interface{}, args []interface{}) error {
+	enc, err := getEncryptedBytes(args, rpc.CreateAccessList)
+	if err != nil {
+		return err
+	}
+	encryptedResponse, err := c.ethAPI.CreateAccessList(context.Background(), enc)
+	if err != nil {
+		return fmt.Errorf("`%s` call failed. Cause: %w", rpc.CreateAccessList, err)
+	}
+	*result.(*responses.EnclaveResponse) = encryptedResponse
+	return nil
+}
+
+func (c *inMemObscuroClient) revokeViewingKey(result interface{}, args []interface{}) error {
+	enc, err := getEncryptedBytes(args, rpc.RevokeViewingKey)
+	if err != nil {
+		return err
+	}
+	encryptedResponse, err := c.obscuroAPI.RevokeViewingKey(enc)
+	if err != nil {
+		return fmt.Errorf("`%s` call failed. Cause: %w", rpc.RevokeViewingKey, err)
+	}
+	*result.(*responses.EnclaveResponse) = encryptedResponse
+	return nil
+}
+
+func (c *inMemObscuroClient) getInboundMessageStatus(result interface{}, args []interface{}) error {
+	enc, err := getEncryptedBytes(args, rpc.GetInboundMessageStatus)
+	if err != nil {
+		return err
+	}
+	encryptedResponse, err := c.obscuroAPI.GetInboundMessageStatus(enc)
+	if err != nil {
+		return fmt.Errorf("`%s` call failed. Cause: %w", rpc.GetInboundMessageStatus, err)
+	}
+	*result.(*responses.EnclaveResponse) = encryptedResponse
+	return nil
+}
+
+func (c *inMemObscuroClient) getOutboundMessages(result interface{}, args []interface{}) error {
+	enc, err := getEncryptedBytes(args, rpc.GetOutboundMessages)
+	if err != nil {
+		return err
+	}
+	encryptedResponse, err := c.obscuroAPI.GetOutboundMessages(enc)
+	if err != nil {
+		return fmt.Errorf("`%s` call failed. Cause: %w", rpc.GetOutboundMessages, err)
+	}
+	*result.(*responses.EnclaveResponse) = encryptedResponse
+	return nil
+}
+
 func (c *inMemObscuroClient) getTransactionReceipt(result interface{}, args []interface{}) error {
 	enc, err := getEncryptedBytes(args, rpc.GetTransactionReceipt)
 	if err != nil {
@@ -340,6 +412,28 @@ func (c *inMemObscuroClient) getBatch(result interface{}, args []interface{}) er
 	return nil
 }
 
+func (c *inMemObscuroClient) getCrossChainProof(result interface{}, args []interface{}) error {
+	if len(args) != 2 {
+		return fmt.Errorf("expected 2 args to %s, got %d", rpc.GetCrossChainProof, len(args))
+	}
+	txHash, ok := args[0].(gethcommon.Hash)
+	if !ok {
+		return fmt.Errorf("first arg to %s is of type %T, expected type gethcommon.Hash", rpc.GetCrossChainProof, args[0])
+	}
+	logIndex, ok := args[1].(hexutil.Uint64)
+	if !ok {
+		return fmt.Errorf("second arg to %s is of type %T, expected type hexutil.Uint64", rpc.GetCrossChainProof, args[1])
+	}
+
+	proof, err := c.obscuroAPI.GetCrossChainProof(txHash, logIndex)
+	if err != nil {
+		return fmt.Errorf("`%s` call failed. Cause: %w", rpc.GetCrossChainProof, err)
+	}
+
+	*result.(**common.CrossChainProof) = proof
+	return nil
+}
+
 // getEncryptedBytes expects args to have a single element and it to be of type bytes (client doesn't know anything about what's getting passed through on sensitive methods)
 func getEncryptedBytes(args []interface{}, methodName string) ([]byte, error) {
 	if len(args) != 1 {
@@ -351,3 +445,13 @@ func getEncryptedBytes(args []interface{}, methodName string) ([]byte, error) {
 	}
 	return encBytes, nil
 }
+
+func (c *inMemObscuroClient) getBridgeLedger(result interface{}) error {
+	ledger, err := c.scanAPI.GetBridgeLedger()
+	if err != nil {
+		return fmt.Errorf("`%s` call failed. Cause: %w", rpc.GetBridgeLedger, err)
+	}
+
+	*result.(*[]common.BridgeTokenBalance) = ledger
+	return nil
+}
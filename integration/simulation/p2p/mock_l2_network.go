@@ -57,6 +57,11 @@ func (m *MockP2PNetwork) SendTransactionToSequencer(tx common.EncryptedTx) {
 	async.Schedule(m.delay()/2, func() { seqNode.ReceiveTransaction(tx) })
 }
 
+func (m *MockP2PNetwork) SendViewingKeyRevocationToSequencer(encryptedParams common.EncryptedParamsRevokeViewingKey) {
+	seqNode := m.nodes[_sequencerID]
+	async.Schedule(m.delay()/2, func() { seqNode.ReceiveViewingKeyRevocation(encryptedParams) })
+}
+
 func (m *MockP2PNetwork) BroadcastBatch(fromNodeID string, batches []*common.ExtBatch) {
 	for _, node := range m.nodes {
 		if node.id != fromNodeID {
@@ -135,6 +140,14 @@ func (n *MockP2P) SendTxToSequencer(tx common.EncryptedTx) error {
 	return nil
 }
 
+func (n *MockP2P) SendViewingKeyRevocationToSequencer(encryptedParams common.EncryptedParamsRevokeViewingKey) error {
+	if atomic.LoadInt32(n.listenerInterrupt) == 1 {
+		return nil
+	}
+	n.network.SendViewingKeyRevocationToSequencer(encryptedParams)
+	return nil
+}
+
 func (n *MockP2P) BroadcastBatches(batches []*common.ExtBatch) error {
 	if n.isIncomingP2PDisabled {
 		return nil
@@ -202,6 +215,14 @@ func (n *MockP2P) ReceiveTransaction(tx common.EncryptedTx) {
 	}
 }
 
+// ReceiveViewingKeyRevocation is a mock method that simulates receiving a viewing key revocation from a peer and then
+// forwarding to all subscribers
+func (n *MockP2P) ReceiveViewingKeyRevocation(encryptedParams common.EncryptedParamsRevokeViewingKey) {
+	for _, sub := range n.txSubscribers.Subscribers() {
+		sub.HandleViewingKeyRevocation(encryptedParams)
+	}
+}
+
 // ReceiveBatches is a mock method that simulates receiving a batch from a peer and then forwarding to all subscribers
 func (n *MockP2P) ReceiveBatches(batches []*common.ExtBatch, isLive bool) {
 	if n.isIncomingP2PDisabled {
@@ -38,6 +38,9 @@ type SimParams struct {
 
 	StoppingDelay              time.Duration // How long to wait between injection and verification
 	NodeWithInboundP2PDisabled int
+
+	ForcedTxInclusionWindow uint64 // The L1 blocks within which forced transactions must be included. Defaults to the enclave default if zero.
+	CensoringSequencer      bool   // Denotes that the sequencer never includes the forced transactions. Only supported in memory.
 }
 
 type L1SetupData struct {
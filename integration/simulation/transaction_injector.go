@@ -8,6 +8,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/ecies"
@@ -15,6 +16,7 @@ import (
 	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/ethadapter/erc20contractlib"
 	"github.com/obscuronet/go-obscuro/go/ethadapter/mgmtcontractlib"
+	"github.com/obscuronet/go-obscuro/go/obsclient"
 	"github.com/obscuronet/go-obscuro/go/wallet"
 	"github.com/obscuronet/go-obscuro/integration"
 	"github.com/obscuronet/go-obscuro/integration/common/testlog"
@@ -24,6 +26,7 @@ import (
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethlog "github.com/ethereum/go-ethereum/log"
+	gethparams "github.com/ethereum/go-ethereum/params"
 	testcommon "github.com/obscuronet/go-obscuro/integration/common"
 	simstats "github.com/obscuronet/go-obscuro/integration/simulation/stats"
 )
@@ -204,11 +207,13 @@ func (ti *TransactionInjector) issueRandomTransfers() {
 		}
 		tx := ti.newObscuroTransferTx(fromWallet, toWallet.Address(), testcommon.RndBtw(1, 500))
 		tx = obscuroClient.EstimateGasAndGasPrice(tx)
+		// the transfers rotate through the transaction types, so they all go through the full pipeline
+		tx = ti.asTypedTx(tx, txCounter, fromWallet, obscuroClient)
 		signedTx, err := fromWallet.SignTransaction(tx)
 		if err != nil {
 			panic(err)
 		}
-		ti.logger.Info("Transfer transaction injected into L2.", log.TxKey, signedTx.Hash(), "fromAddress", fromWallet.Address(), "toAddress", toWallet.Address())
+		ti.logger.Info("Transfer transaction injected into L2.", log.TxKey, signedTx.Hash(), "type", signedTx.Type(), "fromAddress", fromWallet.Address(), "toAddress", toWallet.Address())
 
 		ti.stats.Transfer()
 
@@ -330,6 +335,53 @@ func (ti *TransactionInjector) newTx(data []byte, nonce uint64) types.TxData {
 	}
 }
 
+// asTypedTx converts the estimated legacy transaction into an EIP-2930 or an EIP-1559 transaction, depending on the
+// counter. The access list of EIP-2930 transactions is generated by the node.
+func (ti *TransactionInjector) asTypedTx(txData types.TxData, txCounter int, from wallet.Wallet, obscuroClient *obsclient.AuthObsClient) types.TxData {
+	tx := types.NewTx(txData)
+
+	switch txCounter % 3 {
+	case 1:
+		accessList, _, err := obscuroClient.CreateAccessList(ti.ctx, ethereum.CallMsg{
+			From:  from.Address(),
+			To:    tx.To(),
+			Value: tx.Value(),
+			Data:  tx.Data(),
+		})
+		if err != nil || accessList == nil {
+			ti.logger.Info("Failed to create access list, sending the transaction with an empty one.", log.ErrKey, err)
+			accessList = &types.AccessList{}
+		}
+		// the estimation did not include the intrinsic gas of the access list
+		gas := tx.Gas() + uint64(len(*accessList))*gethparams.TxAccessListAddressGas + uint64(accessList.StorageKeys())*gethparams.TxAccessListStorageKeyGas
+		return &types.AccessListTx{
+			ChainID:    big.NewInt(integration.ObscuroChainID),
+			Nonce:      tx.Nonce(),
+			GasPrice:   tx.GasPrice(),
+			Gas:        gas,
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: *accessList,
+		}
+
+	case 2:
+		return &types.DynamicFeeTx{
+			ChainID:   big.NewInt(integration.ObscuroChainID),
+			Nonce:     tx.Nonce(),
+			GasTipCap: tx.GasPrice(),
+			GasFeeCap: tx.GasPrice(),
+			Gas:       tx.Gas(),
+			To:        tx.To(),
+			Value:     tx.Value(),
+			Data:      tx.Data(),
+		}
+
+	default:
+		return txData
+	}
+}
+
 // Indicates whether to keep issuing transactions, or halt.
 func (ti *TransactionInjector) shouldKeepIssuing(txCounter int) bool {
 	isInterrupted := atomic.LoadInt32(ti.interruptRun) != 0
@@ -429,7 +429,7 @@ func FindNotIncludedL2Txs(ctx context.Context, nodeIdx int, rpcHandles *network.
 }
 
 func getSender(tx *common.L2Tx) gethcommon.Address {
-	from, err := types.Sender(types.NewEIP155Signer(tx.ChainId()), tx)
+	from, err := types.Sender(types.NewLondonSigner(tx.ChainId()), tx)
 	if err != nil {
 		panic(fmt.Errorf("couldn't find sender to verify transaction - %w", err))
 	}
@@ -441,6 +441,7 @@ func checkTransactionReceipts(ctx context.Context, t *testing.T, nodeIdx int, rp
 	l2Txs := append(txInjector.TxTracker.TransferL2Transactions, txInjector.TxTracker.WithdrawalL2Transactions...)
 
 	nrSuccessful := 0
+	nrSuccessfulPerType := map[uint8]int{}
 	for _, tx := range l2Txs {
 		sender := getSender(tx)
 
@@ -456,16 +457,28 @@ func checkTransactionReceipts(ctx context.Context, t *testing.T, nodeIdx int, rp
 			assertRelevantLogsOnly(t, sender.Hex(), *retrievedLog)
 		}
 
+		if receipt.Type != tx.Type() {
+			t.Errorf("node %d: receipt for transaction %s has type %d, expected %d", nodeIdx, tx.Hash().Hex(), receipt.Type, tx.Type())
+		}
+
 		if receipt.Status == types.ReceiptStatusFailed {
 			testlog.Logger().Info("Transaction receipt had failed status.", log.TxKey, tx.Hash())
 		} else {
 			nrSuccessful++
+			nrSuccessfulPerType[tx.Type()]++
 		}
 	}
 
 	if nrSuccessful < len(l2Txs)/2 {
 		t.Errorf("node %d: More than half the transactions failed. Successful number: %d", nodeIdx, nrSuccessful)
 	}
+
+	// the transfers are issued with all the transaction types, and each of them must make it through the pipeline
+	for _, txType := range []uint8{types.LegacyTxType, types.AccessListTxType, types.DynamicFeeTxType} {
+		if nrSuccessfulPerType[txType] == 0 {
+			t.Errorf("node %d: no successful transaction of type %d", nodeIdx, txType)
+		}
+	}
 }
 
 func extractWithdrawals(t *testing.T, obscuroClient *obsclient.ObsClient, nodeIdx int) (totalSuccessfullyWithdrawn *big.Int) {
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"flag"
+	"time"
+
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/tools/relayer/relayer"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// Flag names, defaults and usages.
+	l1NodeHostName    = "l1NodeHost"
+	l1NodeHostDefault = "127.0.0.1"
+	l1NodeHostUsage   = "The host on which to connect to the L1 node. Default: `127.0.0.1`."
+
+	l1NodePortName    = "l1NodePort"
+	l1NodePortDefault = 9000
+	l1NodePortUsage   = "The port on which to connect to the L1 node via RPC over websockets. Default: 9000."
+
+	l1ConnectionTimeoutName    = "l1ConnectionTimeout"
+	l1ConnectionTimeoutDefault = 15 * time.Second
+	l1ConnectionTimeoutUsage   = "The timeout of the requests to the L1 node. Default: 15s."
+
+	l1ChainIDName    = "l1ChainID"
+	l1ChainIDDefault = 1337
+	l1ChainIDUsage   = "The chain ID of the L1 network. Default: 1337."
+
+	l2RPCAddressName    = "l2RPCAddress"
+	l2RPCAddressDefault = "http://127.0.0.1:80"
+	l2RPCAddressUsage   = "The RPC address of the Obscuro node the published messages and their proofs are read from. Default: `http://127.0.0.1:80`."
+
+	l2ChainIDName    = "l2ChainID"
+	l2ChainIDDefault = 777
+	l2ChainIDUsage   = "The chain ID of the Obscuro network. Default: 777."
+
+	privateKeyName    = "privateKey"
+	privateKeyDefault = ""
+	privateKeyUsage   = "The private key of the funded L1 account paying for the relay transactions. No default, must be set."
+
+	messageBusAddrName    = "messageBusAddress"
+	messageBusAddrDefault = ""
+	messageBusAddrUsage   = "The address of the message bus on the L1. No default, must be set."
+
+	l2MessageBusAddrName    = "l2MessageBusAddress"
+	l2MessageBusAddrDefault = ""
+	l2MessageBusAddrUsage   = "The address of the message bus on Obscuro. No default, must be set."
+
+	messengerAddrName    = "messengerAddress"
+	messengerAddrDefault = ""
+	messengerAddrUsage   = "The address of the cross chain messenger on the L1. No default, must be set."
+
+	stateFileName    = "stateFile"
+	stateFileDefault = "relayer_state.json"
+	stateFileUsage   = "The file the progress of the relayer is persisted to. Default: `relayer_state.json`."
+
+	startBatchHeightName    = "startBatchHeight"
+	startBatchHeightDefault = 0
+	startBatchHeightUsage   = "The L2 batch after which the published messages are followed, if there is no persisted " +
+		"progress. Default: the genesis batch."
+
+	pollIntervalName    = "pollInterval"
+	pollIntervalDefault = 10 * time.Second
+	pollIntervalUsage   = "How often the new L2 batches and the finality of the pending messages are checked. Default: 10s."
+
+	maxWaitForReceiptName    = "maxWaitForReceipt"
+	maxWaitForReceiptDefault = 3 * time.Minute
+	maxWaitForReceiptUsage   = "How long to wait for the receipt of a relay transaction. Default: 3m."
+
+	logPathName    = "logPath"
+	logPathDefault = log.SysOut
+	logPathUsage   = "The path to use for the relayer's log file. Default: stdout."
+
+	verboseFlagName    = "verbose"
+	verboseFlagDefault = false
+	verboseFlagUsage   = "Flag to enable verbose logging of the relayer"
+)
+
+func parseCLIArgs() *relayer.Config {
+	l1NodeHost := flag.String(l1NodeHostName, l1NodeHostDefault, l1NodeHostUsage)
+	l1NodePort := flag.Uint(l1NodePortName, l1NodePortDefault, l1NodePortUsage)
+	l1ConnectionTimeout := flag.Duration(l1ConnectionTimeoutName, l1ConnectionTimeoutDefault, l1ConnectionTimeoutUsage)
+	l1ChainID := flag.Int64(l1ChainIDName, l1ChainIDDefault, l1ChainIDUsage)
+	l2RPCAddress := flag.String(l2RPCAddressName, l2RPCAddressDefault, l2RPCAddressUsage)
+	l2ChainID := flag.Int64(l2ChainIDName, l2ChainIDDefault, l2ChainIDUsage)
+	privateKey := flag.String(privateKeyName, privateKeyDefault, privateKeyUsage)
+	messageBusAddr := flag.String(messageBusAddrName, messageBusAddrDefault, messageBusAddrUsage)
+	l2MessageBusAddr := flag.String(l2MessageBusAddrName, l2MessageBusAddrDefault, l2MessageBusAddrUsage)
+	messengerAddr := flag.String(messengerAddrName, messengerAddrDefault, messengerAddrUsage)
+	stateFile := flag.String(stateFileName, stateFileDefault, stateFileUsage)
+	startBatchHeight := flag.Uint64(startBatchHeightName, startBatchHeightDefault, startBatchHeightUsage)
+	pollInterval := flag.Duration(pollIntervalName, pollIntervalDefault, pollIntervalUsage)
+	maxWaitForReceipt := flag.Duration(maxWaitForReceiptName, maxWaitForReceiptDefault, maxWaitForReceiptUsage)
+	logPath := flag.String(logPathName, logPathDefault, logPathUsage)
+	verboseFlag := flag.Bool(verboseFlagName, verboseFlagDefault, verboseFlagUsage)
+	flag.Parse()
+
+	logLevel := gethlog.LvlInfo
+	if *verboseFlag {
+		logLevel = gethlog.LvlDebug
+	}
+
+	return &relayer.Config{
+		L1NodeHost:                 *l1NodeHost,
+		L1NodeWebsocketPort:        *l1NodePort,
+		L1ConnectionTimeout:        *l1ConnectionTimeout,
+		L1ChainID:                  *l1ChainID,
+		L2RPCAddress:               *l2RPCAddress,
+		L2ChainID:                  *l2ChainID,
+		PrivateKey:                 *privateKey,
+		MessageBusAddress:          gethcommon.HexToAddress(*messageBusAddr),
+		L2MessageBusAddress:        gethcommon.HexToAddress(*l2MessageBusAddr),
+		CrossChainMessengerAddress: gethcommon.HexToAddress(*messengerAddr),
+		StateFile:                  *stateFile,
+		StartBatchHeight:           *startBatchHeight,
+		PollInterval:               *pollInterval,
+		MaxWaitForReceipt:          *maxWaitForReceipt,
+		RetryIntervalForReceipt:    time.Second,
+		LogPath:                    *logPath,
+		LogLevel:                   int(logLevel),
+	}
+}
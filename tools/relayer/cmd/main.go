@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/obscuronet/go-obscuro/tools/relayer/container"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// local execution: go run ./tools/relayer/cmd --privateKey 0x... --messageBusAddress 0x... --l2MessageBusAddress 0x... --messengerAddress 0x...
+func main() {
+	cfg := parseCLIArgs()
+
+	if cfg.PrivateKey == "" {
+		panic("no private key loaded")
+	}
+	cfg.PrivateKey = strings.TrimPrefix(cfg.PrivateKey, "0x")
+	if cfg.MessageBusAddress == (gethcommon.Address{}) || cfg.L2MessageBusAddress == (gethcommon.Address{}) ||
+		cfg.CrossChainMessengerAddress == (gethcommon.Address{}) {
+		panic("the L1 and L2 message bus and the cross chain messenger addresses must be set")
+	}
+
+	relayerContainer, err := container.NewRelayerContainerFromConfig(cfg)
+	if err != nil {
+		panic(err)
+	}
+
+	err = relayerContainer.Start()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("Relayer started")
+
+	// Create a channel to receive signals
+	signalCh := make(chan os.Signal, 1)
+
+	// Notify the channel for interrupt signals
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM)
+
+	// Wait for an interrupt signal
+	<-signalCh
+
+	fmt.Println("Shutting down")
+
+	err = relayerContainer.Stop()
+	if err != nil {
+		panic(err)
+	}
+}
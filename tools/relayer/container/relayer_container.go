@@ -0,0 +1,77 @@
+package container
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+	"github.com/obscuronet/go-obscuro/go/ethadapter/messagebuslib"
+	"github.com/obscuronet/go-obscuro/go/obsclient"
+	"github.com/obscuronet/go-obscuro/go/wallet"
+	"github.com/obscuronet/go-obscuro/tools/relayer/relayer"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+type RelayerContainer struct {
+	relayer *relayer.Relayer
+	logger  gethlog.Logger
+}
+
+func NewRelayerContainerFromConfig(cfg *relayer.Config) (*RelayerContainer, error) {
+	logger := log.New(log.RelayerCmp, cfg.LogLevel, cfg.LogPath)
+
+	ethClient, err := ethadapter.NewEthClient(cfg.L1NodeHost, cfg.L1NodeWebsocketPort, cfg.L1ConnectionTimeout, gethcommon.Address{}, logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to the L1 node. Cause: %w", err)
+	}
+	relayerWallet := wallet.NewInMemoryWalletFromConfig(cfg.PrivateKey, cfg.L1ChainID, logger)
+
+	// the messages are read with a viewing key of the relayer account, as any account can read the lifecycle logs
+	l2Wallet := wallet.NewInMemoryWalletFromPK(big.NewInt(cfg.L2ChainID), relayerWallet.PrivateKey(), logger)
+	l2Client, err := obsclient.DialWithAuth(cfg.L2RPCAddress, l2Wallet, logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to the Obscuro node. Cause: %w", err)
+	}
+
+	l2MessageBus := messagebuslib.NewMessageBusLib(&cfg.L2MessageBusAddress)
+	messageBusLib := messagebuslib.NewMessageBusLib(&cfg.MessageBusAddress)
+	messengerLib := relayer.NewMessengerLib(cfg.CrossChainMessengerAddress)
+
+	return NewRelayerContainer(cfg, ethClient, l2Client, l2MessageBus, messageBusLib, messengerLib, relayerWallet, logger)
+}
+
+// NewRelayerContainer wires the relayer to the given clients and contract libraries, which can be the mock ones
+func NewRelayerContainer(
+	cfg *relayer.Config,
+	ethClient ethadapter.EthClient,
+	l2Client relayer.L2Client,
+	l2MessageBus messagebuslib.MessageBusLib,
+	messageBusLib messagebuslib.MessageBusLib,
+	messengerLib relayer.MessengerLib,
+	relayerWallet wallet.Wallet,
+	logger gethlog.Logger,
+) (*RelayerContainer, error) {
+	r, err := relayer.NewRelayer(cfg, ethClient, l2Client, l2MessageBus, messageBusLib, messengerLib, relayerWallet, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RelayerContainer{
+		relayer: r,
+		logger:  logger,
+	}, nil
+}
+
+func (c *RelayerContainer) Start() error {
+	return c.relayer.Start()
+}
+
+func (c *RelayerContainer) Stop() error {
+	if err := c.relayer.Stop(); err != nil {
+		c.logger.Error("Could not stop the relayer", log.ErrKey, err)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package relayer
+
+import (
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// Config is the configuration of the relayer
+type Config struct {
+	L1NodeHost          string
+	L1NodeWebsocketPort uint
+	L1ConnectionTimeout time.Duration
+	L1ChainID           int64
+	L2RPCAddress        string // The RPC address of the Obscuro node the published messages and their proofs are read from
+	L2ChainID           int64
+	PrivateKey          string // The key of the account paying for the relay transactions, hex-encoded without the 0x prefix
+
+	MessageBusAddress          gethcommon.Address // The address of the message bus on the L1
+	L2MessageBusAddress        gethcommon.Address // The address of the message bus on Obscuro
+	CrossChainMessengerAddress gethcommon.Address
+
+	StateFile               string        // The file the progress of the relayer is persisted to
+	StartBatchHeight        uint64        // The L2 batch after which messages are followed when there is no persisted progress
+	PollInterval            time.Duration // How often the new L2 batches and the finality of the pending messages are checked
+	MaxWaitForReceipt       time.Duration
+	RetryIntervalForReceipt time.Duration
+	LogPath                 string
+	LogLevel                int
+}
@@ -0,0 +1,55 @@
+package relayer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/contracts/generated/CrossChainMessenger"
+	"github.com/obscuronet/go-obscuro/go/common"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const relayMessageWithProofMethod = "relayMessageWithProof"
+
+// MessengerLib creates the transactions to the L1 cross chain messenger the relayer needs, in the same way the
+// MgmtContractLib does for the management contract.
+type MessengerLib interface {
+	// CreateRelayMessage returns the transaction delivering the message of the proof to its target through the
+	// messenger, which checks the proof against the root stored in the L1 message bus
+	CreateRelayMessage(proof *common.CrossChainProof) (types.TxData, error)
+}
+
+type messengerLibImpl struct {
+	messengerAddr gethcommon.Address
+	messengerABI  abi.ABI
+}
+
+func NewMessengerLib(messengerAddr gethcommon.Address) MessengerLib {
+	messengerABI, err := abi.JSON(strings.NewReader(CrossChainMessenger.CrossChainMessengerMetaData.ABI))
+	if err != nil {
+		panic(err)
+	}
+
+	return &messengerLibImpl{
+		messengerAddr: messengerAddr,
+		messengerABI:  messengerABI,
+	}
+}
+
+func (m *messengerLibImpl) CreateRelayMessage(proof *common.CrossChainProof) (types.TxData, error) {
+	siblings := make([][32]byte, len(proof.Proof))
+	for i, sibling := range proof.Proof {
+		siblings[i] = sibling
+	}
+	data, err := m.messengerABI.Pack(relayMessageWithProofMethod, CrossChainMessenger.StructsCrossChainMessage(proof.Message), siblings, proof.Root)
+	if err != nil {
+		return nil, fmt.Errorf("could not pack %s call. Cause: %w", relayMessageWithProofMethod, err)
+	}
+	return &types.LegacyTx{
+		To:   &m.messengerAddr,
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,332 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/common/retry"
+	"github.com/obscuronet/go-obscuro/go/common/stopcontrol"
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+	"github.com/obscuronet/go-obscuro/go/ethadapter/messagebuslib"
+	"github.com/obscuronet/go-obscuro/go/wallet"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// maxRelayAttempts - a message is abandoned after failing to be relayed this many times (e.g. because it was
+// already relayed by someone else)
+const maxRelayAttempts = 10
+
+// maxBatchRange - the logs of at most this many batches are requested at once
+const maxBatchRange = 1000
+
+// L2Client is the part of the Obscuro client the relayer uses to follow the published messages and get their proofs.
+// As the messages are published in lifecycle logs, any authenticated client can read them.
+type L2Client interface {
+	BatchNumber() (uint64, error)
+	BatchHeaderByNumber(number *big.Int) (*common.BatchHeader, error)
+	GetLogs(ctx context.Context, filterCriteria common.FilterCriteriaJSON) ([]*types.Log, error)
+	GetCrossChainProof(txHash gethcommon.Hash, logIndex uint) (*common.CrossChainProof, error)
+}
+
+// Relayer delivers the outbound cross chain messages of the Obscuro network to their targets on the L1. It follows the
+// messages published in the L2 message bus, waits for the rollup of their batch to store its cross chain root in the
+// L1 message bus, and once the challenge period of the root has passed it calls `relayMessageWithProof` on the cross
+// chain messenger with the proof of the message. Its progress is persisted after each step, so it resumes where it
+// stopped when restarted.
+type Relayer struct {
+	ethClient     ethadapter.EthClient
+	l2Client      L2Client
+	l2MessageBus  messagebuslib.MessageBusLib // Decodes the messages published on the L2
+	messageBusLib messagebuslib.MessageBusLib // Queries the roots stored in the L1 message bus
+	messengerLib  MessengerLib
+	wallet        wallet.Wallet // Wallet paying for the relay transactions. No other process should use its account.
+
+	stateFile               string
+	state                   *state
+	pollInterval            time.Duration
+	maxWaitForReceipt       time.Duration
+	retryIntervalForReceipt time.Duration
+
+	stopControl *stopcontrol.StopControl
+	stopped     chan struct{}
+	logger      gethlog.Logger
+}
+
+func NewRelayer(
+	config *Config,
+	ethClient ethadapter.EthClient,
+	l2Client L2Client,
+	l2MessageBus messagebuslib.MessageBusLib,
+	messageBusLib messagebuslib.MessageBusLib,
+	messengerLib MessengerLib,
+	relayerWallet wallet.Wallet,
+	logger gethlog.Logger,
+) (*Relayer, error) {
+	relayerState, err := loadState(config.StateFile, config.StartBatchHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Relayer{
+		ethClient:               ethClient,
+		l2Client:                l2Client,
+		l2MessageBus:            l2MessageBus,
+		messageBusLib:           messageBusLib,
+		messengerLib:            messengerLib,
+		wallet:                  relayerWallet,
+		stateFile:               config.StateFile,
+		state:                   relayerState,
+		pollInterval:            config.PollInterval,
+		maxWaitForReceipt:       config.MaxWaitForReceipt,
+		retryIntervalForReceipt: config.RetryIntervalForReceipt,
+		stopControl:             stopcontrol.New(),
+		stopped:                 make(chan struct{}),
+		logger:                  logger,
+	}, nil
+}
+
+func (r *Relayer) Start() error {
+	nonce, err := r.ethClient.Nonce(r.wallet.Address())
+	if err != nil {
+		return fmt.Errorf("could not fetch the nonce of the relayer account. Cause: %w", err)
+	}
+	r.wallet.SetNonce(nonce)
+
+	r.logger.Info("Starting relayer", "account", r.wallet.Address(), "pending_messages", len(r.state.Pending),
+		log.BatchHeightKey, r.state.LastBatchHeight)
+	go r.run()
+	return nil
+}
+
+func (r *Relayer) Stop() error {
+	r.stopControl.Stop()
+	<-r.stopped
+	return nil
+}
+
+func (r *Relayer) run() {
+	defer close(r.stopped)
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stopControl.Done():
+			return
+		case <-ticker.C:
+			r.followMessages()
+			r.relayFinalMessages()
+		}
+	}
+}
+
+// followMessages queues the messages published in the L2 batches since the last one processed
+func (r *Relayer) followMessages() {
+	for !r.stopControl.IsStopping() {
+		head, err := r.l2Client.BatchNumber()
+		if err != nil {
+			r.logger.Warn("Could not fetch the L2 head batch", log.ErrKey, err)
+			return
+		}
+		if head <= r.state.LastBatchHeight {
+			return
+		}
+		from, to := r.state.LastBatchHeight+1, head
+		if to-from >= maxBatchRange {
+			to = from + maxBatchRange - 1
+		}
+
+		fromBlock, toBlock := gethrpc.BlockNumber(from), gethrpc.BlockNumber(to)
+		logs, err := r.l2Client.GetLogs(context.Background(), common.FilterCriteriaJSON{
+			FromBlock: &fromBlock,
+			ToBlock:   &toBlock,
+			Addresses: []gethcommon.Address{*r.l2MessageBus.GetContractAddr()},
+			Topics:    []interface{}{r.l2MessageBus.MessagePublishedTopic()},
+		})
+		if err != nil {
+			r.logger.Warn("Could not fetch the messages published on the L2", "from", from, "to", to, log.ErrKey, err)
+			return
+		}
+		for _, l := range logs {
+			r.queueMessage(l)
+		}
+		r.state.LastBatchHeight = to
+		r.saveState()
+	}
+}
+
+// queueMessage queues the message published by the log
+func (r *Relayer) queueMessage(l *types.Log) {
+	msg, err := r.l2MessageBus.DecodeMessagePublished(*l)
+	if err != nil {
+		r.logger.Error("Could not decode cross chain message", log.TxKey, l.TxHash, log.ErrKey, err)
+		return
+	}
+	added, err := r.state.addPending(l, msg)
+	if err != nil {
+		r.logger.Error("Could not queue cross chain message", log.TxKey, l.TxHash, log.ErrKey, err)
+		return
+	}
+	if added {
+		r.logger.Info("Queued cross chain message", log.TxKey, l.TxHash, "sender", msg.Sender, "sequence", msg.Sequence)
+	}
+}
+
+// relayFinalMessages relays the queued messages whose challenge period has passed
+func (r *Relayer) relayFinalMessages() {
+	head, err := r.ethClient.FetchHeadBlock()
+	if err != nil {
+		// we don't count failed attempts while the L1 is unavailable
+		r.logger.Warn("Could not fetch L1 head block", log.ErrKey, err)
+		return
+	}
+
+	for _, pending := range append([]*pendingMessage{}, r.state.Pending...) {
+		if r.stopControl.IsStopping() {
+			return
+		}
+		if err = r.relayIfFinal(pending, head); err != nil {
+			pending.Attempts++
+			r.logger.Warn("Could not relay cross chain message", "message", pending.Hash, "attempts", pending.Attempts,
+				log.ErrKey, err)
+			if pending.Attempts >= maxRelayAttempts {
+				r.logger.Error("Abandoning cross chain message", "message", pending.Hash, log.ErrKey, err)
+				r.state.removePending(pending.Hash)
+			}
+		}
+		r.saveState()
+	}
+}
+
+// relayIfFinal relays the message if the root of its proof is final at the L1 head, and dequeues it once relayed.
+// Waiting for the batch of the message to be rolled up, or for the rollup to be published to the L1, is not a failed
+// attempt. Once the batch of the message is no longer canonical, each attempt to get its proof fails, so the message
+// is abandoned unless its transaction is included again in time.
+func (r *Relayer) relayIfFinal(pending *pendingMessage, head *types.Block) error {
+	if pending.Proof == nil {
+		proof, err := r.l2Client.GetCrossChainProof(pending.TxHash, pending.LogIndex)
+		if errors.Is(err, ethereum.NotFound) {
+			return r.checkBatchIsCanonical(pending)
+		}
+		if err != nil {
+			// we don't count failed attempts while the L2 node is unavailable
+			r.logger.Warn("Could not fetch the proof of the message", "message", pending.Hash, log.ErrKey, err)
+			return nil
+		}
+		pending.Proof = proof
+	}
+	if !pending.FinalityKnown {
+		callMsg, err := r.messageBusLib.GetRootTimeOfFinality(pending.Proof.Root)
+		if err != nil {
+			return err
+		}
+		response, err := r.ethClient.CallContract(callMsg)
+		if err != nil {
+			// the call reverts until the rollup of the root is published
+			r.logger.Debug("Cross chain root not stored in the L1 message bus yet", "root", pending.Proof.Root, log.ErrKey, err)
+			return nil
+		}
+		timeOfFinality, err := r.messageBusLib.DecodeTimeOfFinality(response)
+		if err != nil {
+			return err
+		}
+		pending.FinalityKnown, pending.TimeOfFinality = true, timeOfFinality.Uint64()
+	}
+	if pending.TimeOfFinality > head.Time() {
+		return nil
+	}
+
+	relayTx, err := r.messengerLib.CreateRelayMessage(pending.Proof)
+	if err != nil {
+		return err
+	}
+	txHash, err := r.publishTransaction(relayTx)
+	if err != nil {
+		return err
+	}
+	r.logger.Info("Relayed cross chain message", "message", pending.Hash, log.TxKey, txHash)
+	r.state.removePending(pending.Hash)
+	return nil
+}
+
+// checkBatchIsCanonical returns an error if the batch which published the message is no longer the canonical batch at
+// its height
+func (r *Relayer) checkBatchIsCanonical(pending *pendingMessage) error {
+	batchHeader, err := r.l2Client.BatchHeaderByNumber(new(big.Int).SetUint64(pending.BatchHeight))
+	if err != nil && !errors.Is(err, ethereum.NotFound) {
+		// we don't count failed attempts while the L2 node is unavailable
+		r.logger.Warn("Could not fetch the batch of the message", "message", pending.Hash, "height", pending.BatchHeight,
+			log.ErrKey, err)
+		return nil
+	}
+	if batchHeader == nil || batchHeader.Hash() != pending.BatchHash {
+		return fmt.Errorf("batch %s of the message is no longer canonical", pending.BatchHash)
+	}
+	return nil
+}
+
+// publishTransaction signs and sends the transaction with the next nonce of the relayer account, and waits for its
+// receipt. If the transaction could not be sent or confirmed, the nonce is synced with the L1 again.
+func (r *Relayer) publishTransaction(txData types.TxData) (gethcommon.Hash, error) {
+	nonce := r.wallet.GetNonce()
+	tx, err := r.ethClient.PrepareTransactionToSend(txData, r.wallet.Address(), nonce)
+	if err != nil {
+		return gethcommon.Hash{}, fmt.Errorf("could not estimate gas/gas price for L1 tx. Cause: %w", err)
+	}
+	signedTx, err := r.wallet.SignTransaction(tx)
+	if err != nil {
+		return gethcommon.Hash{}, fmt.Errorf("could not sign L1 tx. Cause: %w", err)
+	}
+	if err = r.ethClient.SendTransaction(signedTx); err != nil {
+		r.syncNonce()
+		return gethcommon.Hash{}, fmt.Errorf("could not broadcast L1 tx. Cause: %w", err)
+	}
+	r.wallet.SetNonce(nonce + 1)
+
+	var receipt *types.Receipt
+	err = retry.Do(
+		func() error {
+			receipt, err = r.ethClient.TransactionReceipt(signedTx.Hash())
+			if err != nil {
+				return fmt.Errorf("could not get receipt for L1 tx=%s: %w", signedTx.Hash(), err)
+			}
+			return nil
+		},
+		retry.NewTimeoutStrategy(r.maxWaitForReceipt, r.retryIntervalForReceipt),
+	)
+	if err != nil {
+		// the transaction may have been dropped, so it may have to be replaced on the same nonce
+		r.syncNonce()
+		return gethcommon.Hash{}, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return gethcommon.Hash{}, fmt.Errorf("unsuccessful receipt found for L1 tx=%s", signedTx.Hash())
+	}
+	return signedTx.Hash(), nil
+}
+
+// syncNonce sets the nonce of the wallet to the next nonce of the relayer account on the L1
+func (r *Relayer) syncNonce() {
+	nonce, err := r.ethClient.Nonce(r.wallet.Address())
+	if err != nil {
+		r.logger.Warn("Could not sync the nonce of the relayer account", log.ErrKey, err)
+		return
+	}
+	r.wallet.SetNonce(nonce)
+}
+
+func (r *Relayer) saveState() {
+	if err := r.state.save(r.stateFile); err != nil {
+		r.logger.Error("Could not persist relayer state", log.ErrKey, err)
+	}
+}
@@ -0,0 +1,328 @@
+package relayer
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/ethadapter/messagebuslib"
+	"github.com/obscuronet/go-obscuro/go/wallet"
+	"github.com/obscuronet/go-obscuro/integration/datagenerator"
+	"github.com/obscuronet/go-obscuro/integration/ethereummock"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const timeOfFinality = 100
+
+var (
+	messengerAddr    = datagenerator.RandomAddress()
+	messageBusAddr   = datagenerator.RandomAddress()
+	l2MessageBusAddr = datagenerator.RandomAddress()
+	messageBusABI, _ = abi.JSON(strings.NewReader(messagebuslib.MessageBusABI))
+)
+
+// loopbackNetwork mines the transactions sent to the mock L1 node, except the relay transactions, which it captures
+type loopbackNetwork struct {
+	node       *ethereummock.Node
+	relayedTxs chan *types.Transaction
+}
+
+func (n *loopbackNetwork) BroadcastBlock(common.EncodedL1Block, common.EncodedL1Block) {}
+
+func (n *loopbackNetwork) BroadcastTx(tx *types.Transaction) {
+	if tx.To() != nil && *tx.To() == messengerAddr {
+		n.relayedTxs <- tx
+		return
+	}
+	go n.node.P2PGossipTx(tx)
+}
+
+type noReorgStats struct{}
+
+func (noReorgStats) L1Reorg(gethcommon.Address) {}
+
+// clockedNode is the mock L1 node, whose head block has a timestamp set by the test
+type clockedNode struct {
+	*ethereummock.Node
+	now atomic.Uint64
+}
+
+func (c *clockedNode) FetchHeadBlock() (*types.Block, error) {
+	head, err := c.Node.FetchHeadBlock()
+	if err != nil {
+		return nil, err
+	}
+	header := head.Header()
+	header.Time = c.now.Load()
+	return types.NewBlockWithHeader(header), nil
+}
+
+// fakeL2Client serves the messages published on the L2 and their proofs, which are only available once the test
+// rolls up their batch
+type fakeL2Client struct {
+	mu       sync.Mutex
+	head     uint64
+	batches  map[uint64]*common.BatchHeader
+	logs     []*types.Log
+	rolledUp bool
+}
+
+func (c *fakeL2Client) BatchNumber() (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.head, nil
+}
+
+func (c *fakeL2Client) BatchHeaderByNumber(number *big.Int) (*common.BatchHeader, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	batchHeader, found := c.batches[number.Uint64()]
+	if !found {
+		return nil, ethereum.NotFound
+	}
+	return batchHeader, nil
+}
+
+func (c *fakeL2Client) GetLogs(_ context.Context, filterCriteria common.FilterCriteriaJSON) ([]*types.Log, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var logs []*types.Log
+	for _, l := range c.logs {
+		if l.BlockNumber >= uint64(*filterCriteria.FromBlock) && l.BlockNumber <= uint64(*filterCriteria.ToBlock) {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+func (c *fakeL2Client) GetCrossChainProof(txHash gethcommon.Hash, logIndex uint) (*common.CrossChainProof, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.rolledUp {
+		return nil, ethereum.NotFound
+	}
+	for _, l := range c.logs {
+		if l.TxHash == txHash && l.Index == logIndex {
+			msg, err := messagebuslib.NewMessageBusLib(&l2MessageBusAddr).DecodeMessagePublished(*l)
+			if err != nil {
+				return nil, err
+			}
+			return &common.CrossChainProof{Message: msg, Root: gethcommon.Hash{1}}, nil
+		}
+	}
+	return nil, ethereum.NotFound
+}
+
+// publish adds a batch publishing the messages
+func (c *fakeL2Client) publish(t *testing.T, msgs []common.CrossChainMessage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.head++
+	batchHash := c.newBatch(c.head)
+	event := messageBusABI.Events[messagebuslib.MessagePublishedEvent]
+	for i, msg := range msgs {
+		data, err := event.Inputs.Pack(msg.Sender, msg.Sequence, msg.Nonce, msg.Topic, msg.Payload, msg.ConsistencyLevel)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.logs = append(c.logs, &types.Log{
+			Address:     l2MessageBusAddr,
+			Topics:      []gethcommon.Hash{event.ID},
+			Data:        data,
+			BlockNumber: c.head,
+			BlockHash:   batchHash,
+			TxHash:      gethcommon.BytesToHash(datagenerator.RandomBytes(32)),
+			Index:       uint(i),
+		})
+	}
+}
+
+// reorg replaces the batch at the height with a batch publishing no messages
+func (c *fakeL2Client) reorg(height uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.newBatch(height)
+	var logs []*types.Log
+	for _, l := range c.logs {
+		if l.BlockNumber != height {
+			logs = append(logs, l)
+		}
+	}
+	c.logs = logs
+}
+
+func (c *fakeL2Client) newBatch(height uint64) gethcommon.Hash {
+	if c.batches == nil {
+		c.batches = map[uint64]*common.BatchHeader{}
+	}
+	batchHeader := &common.BatchHeader{
+		ParentHash: gethcommon.BytesToHash(datagenerator.RandomBytes(32)),
+		Number:     new(big.Int).SetUint64(height),
+	}
+	c.batches[height] = batchHeader
+	return batchHeader.Hash()
+}
+
+func (c *fakeL2Client) rollUp() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rolledUp = true
+}
+
+// fakeMessageBusLib returns the same time of finality for all the roots, as the mock L1 does not run contracts
+type fakeMessageBusLib struct {
+	messagebuslib.MessageBusLib
+}
+
+func (fakeMessageBusLib) DecodeTimeOfFinality([]byte) (*big.Int, error) {
+	return big.NewInt(timeOfFinality), nil
+}
+
+type fakeMessengerLib struct{}
+
+func (fakeMessengerLib) CreateRelayMessage(proof *common.CrossChainProof) (types.TxData, error) {
+	return &types.LegacyTx{To: &messengerAddr, Data: proof.Message.Payload}, nil
+}
+
+func TestRelaysFinalMessagesAcrossRestarts(t *testing.T) {
+	network := &loopbackNetwork{relayedTxs: make(chan *types.Transaction, 10)}
+	miningCfg := ethereummock.MiningConfig{
+		PowTime: func() time.Duration { return 20 * time.Millisecond },
+		LogFile: filepath.Join(t.TempDir(), "l1.log"),
+	}
+	network.node = ethereummock.NewMiner(datagenerator.RandomAddress(), miningCfg, network, noReorgStats{})
+	go network.node.Start()
+	defer network.node.Stop()
+	node := &clockedNode{Node: network.node}
+	l2Client := &fakeL2Client{}
+
+	cfg := &Config{
+		StateFile:               filepath.Join(t.TempDir(), "relayer_state.json"),
+		PollInterval:            20 * time.Millisecond,
+		MaxWaitForReceipt:       time.Second,
+		RetryIntervalForReceipt: 100 * time.Millisecond,
+	}
+	stopRelayer := startRelayer(t, cfg, node, l2Client)
+
+	// two messages are published in a batch, which is not rolled up yet
+	msgs := []common.CrossChainMessage{
+		{Sender: datagenerator.RandomAddress(), Sequence: 0, Payload: []byte{1}},
+		{Sender: datagenerator.RandomAddress(), Sequence: 0, Payload: []byte{2}},
+	}
+	l2Client.publish(t, msgs)
+	waitForPendingMessages(t, cfg.StateFile, len(msgs))
+	assertNothingRelayed(t, network.relayedTxs)
+
+	// the batch is rolled up, but the root of the rollup is not final yet
+	l2Client.rollUp()
+	assertNothingRelayed(t, network.relayedTxs)
+
+	// the relayer resumes with the pending messages, and relays them once final
+	stopRelayer()
+	node.now.Store(timeOfFinality)
+	stopRelayer = startRelayer(t, cfg, node, l2Client)
+	defer stopRelayer()
+
+	for i := range msgs {
+		select {
+		case tx := <-network.relayedTxs:
+			if tx.Data()[0] != msgs[i].Payload[0] || tx.Nonce() != uint64(i) {
+				t.Fatalf("expected message %x to be relayed with nonce %d, got message %x with nonce %d", msgs[i].Payload, i, tx.Data(), tx.Nonce())
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("message %x was not relayed", msgs[i].Payload)
+		}
+	}
+	waitForPendingMessages(t, cfg.StateFile, 0)
+	assertNothingRelayed(t, network.relayedTxs)
+}
+
+func TestAbandonsMessagesOfReorgedBatches(t *testing.T) {
+	network := &loopbackNetwork{relayedTxs: make(chan *types.Transaction, 10)}
+	miningCfg := ethereummock.MiningConfig{
+		PowTime: func() time.Duration { return 20 * time.Millisecond },
+		LogFile: filepath.Join(t.TempDir(), "l1.log"),
+	}
+	network.node = ethereummock.NewMiner(datagenerator.RandomAddress(), miningCfg, network, noReorgStats{})
+	go network.node.Start()
+	defer network.node.Stop()
+	node := &clockedNode{Node: network.node}
+	l2Client := &fakeL2Client{}
+
+	cfg := &Config{
+		StateFile:               filepath.Join(t.TempDir(), "relayer_state.json"),
+		PollInterval:            20 * time.Millisecond,
+		MaxWaitForReceipt:       time.Second,
+		RetryIntervalForReceipt: 100 * time.Millisecond,
+	}
+	stopRelayer := startRelayer(t, cfg, node, l2Client)
+	defer stopRelayer()
+
+	// a message is published in a batch, which is not rolled up yet
+	msgs := []common.CrossChainMessage{{Sender: datagenerator.RandomAddress(), Sequence: 0, Payload: []byte{1}}}
+	l2Client.publish(t, msgs)
+	waitForPendingMessages(t, cfg.StateFile, len(msgs))
+
+	// the batch is replaced before being rolled up, so the proof of the message is never found
+	l2Client.reorg(1)
+	waitForPendingMessages(t, cfg.StateFile, 0)
+
+	l2Client.rollUp()
+	node.now.Store(timeOfFinality)
+	assertNothingRelayed(t, network.relayedTxs)
+}
+
+func startRelayer(t *testing.T, cfg *Config, node *clockedNode, l2Client L2Client) func() {
+	logger := gethlog.New()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	relayerWallet := wallet.NewInMemoryWalletFromPK(big.NewInt(1337), key, logger)
+	l2MessageBus := messagebuslib.NewMessageBusLib(&l2MessageBusAddr)
+	l1MessageBus := fakeMessageBusLib{MessageBusLib: messagebuslib.NewMessageBusLib(&messageBusAddr)}
+
+	r, err := NewRelayer(cfg, node, l2Client, l2MessageBus, l1MessageBus, fakeMessengerLib{}, relayerWallet, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = r.Start(); err != nil {
+		t.Fatal(err)
+	}
+	return func() {
+		_ = r.Stop()
+	}
+}
+
+func assertNothingRelayed(t *testing.T, relayedTxs chan *types.Transaction) {
+	select {
+	case tx := <-relayedTxs:
+		t.Fatalf("message %x was relayed unexpectedly", tx.Data())
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func waitForPendingMessages(t *testing.T, stateFile string, count int) {
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(20 * time.Millisecond) {
+		s, err := loadState(stateFile, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(s.Pending) == count {
+			return
+		}
+	}
+	t.Fatalf("expected %d pending messages", count)
+}
@@ -0,0 +1,113 @@
+package relayer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// pendingMessage is an outbound message waiting to be relayed
+type pendingMessage struct {
+	TxHash         gethcommon.Hash          `json:"txHash"`      // The L2 transaction which published the message
+	LogIndex       uint                     `json:"logIndex"`    // The index of the log of the message in its batch
+	BatchHeight    uint64                   `json:"batchHeight"` // The height of the batch which published the message
+	BatchHash      gethcommon.Hash          `json:"batchHash"`   // The hash of the batch which published the message
+	Message        common.CrossChainMessage `json:"message"`
+	Hash           gethcommon.Hash          `json:"hash"`
+	Proof          *common.CrossChainProof  `json:"proof"`          // Nil until the batch of the message is published in a rollup
+	FinalityKnown  bool                     `json:"finalityKnown"`  // Whether the message bus returned the time of finality of the root of the proof
+	TimeOfFinality uint64                   `json:"timeOfFinality"` // The L1 timestamp from which the message can be relayed
+	Attempts       int                      `json:"attempts"`       // The number of failed attempts to relay the message
+}
+
+// state is the progress of the relayer, persisted so it can restart where it stopped
+type state struct {
+	LastBatchHeight uint64            `json:"lastBatchHeight"` // The last L2 batch whose messages were queued
+	Pending         []*pendingMessage `json:"pending"`         // The messages waiting to be relayed, in the order they were published
+}
+
+// loadState reads the progress from the file. If the file does not exist yet, the progress starts after the given batch.
+func loadState(path string, startBatchHeight uint64) (*state, error) {
+	s := &state{LastBatchHeight: startBatchHeight}
+	if path == "" {
+		return s, nil
+	}
+	stateJSON, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("could not read relayer state from %s. Cause: %w", path, err)
+	}
+	if err = json.Unmarshal(stateJSON, s); err != nil {
+		return nil, fmt.Errorf("could not unmarshal relayer state from %s. Cause: %w", path, err)
+	}
+	return s, nil
+}
+
+// save writes the progress to the file. The file is replaced atomically, so it is never left half written.
+func (s *state) save(path string) error {
+	if path == "" {
+		return nil
+	}
+	stateJSON, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("could not marshal relayer state. Cause: %w", err)
+	}
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("could not create relayer state file. Cause: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err = tmpFile.Write(stateJSON); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("could not write relayer state. Cause: %w", err)
+	}
+	if err = tmpFile.Close(); err != nil {
+		return fmt.Errorf("could not write relayer state. Cause: %w", err)
+	}
+	if err = os.Rename(tmpFile.Name(), path); err != nil {
+		return fmt.Errorf("could not replace relayer state file. Cause: %w", err)
+	}
+	return nil
+}
+
+// addPending queues the message published by the log, unless it is already queued. It returns whether the message was
+// queued.
+func (s *state) addPending(l *types.Log, msg common.CrossChainMessage) (bool, error) {
+	msgHash, err := common.CrossChainMessageHash(msg)
+	if err != nil {
+		return false, err
+	}
+	for _, pending := range s.Pending {
+		if pending.Hash == msgHash {
+			return false, nil
+		}
+	}
+	s.Pending = append(s.Pending, &pendingMessage{
+		TxHash:      l.TxHash,
+		LogIndex:    l.Index,
+		BatchHeight: l.BlockNumber,
+		BatchHash:   l.BlockHash,
+		Message:     msg,
+		Hash:        msgHash,
+	})
+	return true, nil
+}
+
+// removePending removes the message from the queue
+func (s *state) removePending(msgHash gethcommon.Hash) {
+	for i, pending := range s.Pending {
+		if pending.Hash == msgHash {
+			s.Pending = append(s.Pending[:i], s.Pending[i+1:]...)
+			return
+		}
+	}
+}
@@ -1,11 +1,12 @@
 package accountmanager
 
 import (
-	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/eth/filters"
@@ -20,11 +21,11 @@ import (
 
 	"github.com/go-kit/kit/transport/http/jsonrpc"
 
-	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/rpc"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/userconn"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 )
 
 const (
@@ -34,6 +35,9 @@ const (
 	ethCallAddrPadding  = "000000000000000000000000"
 
 	ErrNoViewingKey = "method %s cannot be called with an unauthorised client - no signed viewing keys found"
+
+	maxResubscribeAttempts   = 5
+	resubscribeRetryInterval = time.Second
 )
 
 // AccountManager provides a single location for code that helps wallet extension in determining the appropriate
@@ -41,27 +45,89 @@ const (
 type AccountManager struct {
 	unauthedClient rpc.Client
 	// todo (@ziga) - create two types of clients - WS clients, and HTTP clients - to not create WS clients unnecessarily.
-	accountClients map[gethcommon.Address]*rpc.EncRPCClient // An encrypted RPC client per registered account
-	logger         gethlog.Logger
+	accountClients      map[gethcommon.Address]*rpc.EncRPCClient // An encrypted RPC client per registered account
+	accountClientsMutex sync.RWMutex
+	subscriptions       map[gethrpc.ID]*resumableSubscription // The subscriptions being served, including the ones that can be resumed
+	subscriptionsMutex  sync.Mutex
+	subscriptionConfig  SubscriptionConfig
+	activeSubscriptions atomic.Int64 // The number of subscriptions being served, including the ones that can be resumed
+	logger              gethlog.Logger
 }
 
-func NewAccountManager(unauthedClient rpc.Client, logger gethlog.Logger) *AccountManager {
+func NewAccountManager(unauthedClient rpc.Client, subscriptionConfig SubscriptionConfig, logger gethlog.Logger) *AccountManager {
 	return &AccountManager{
-		unauthedClient: unauthedClient,
-		accountClients: make(map[gethcommon.Address]*rpc.EncRPCClient),
-		logger:         logger,
+		unauthedClient:     unauthedClient,
+		accountClients:     make(map[gethcommon.Address]*rpc.EncRPCClient),
+		subscriptions:      make(map[gethrpc.ID]*resumableSubscription),
+		subscriptionConfig: subscriptionConfig,
+		logger:             logger,
 	}
 }
 
 // AddClient adds a client to the list of clients, keyed by account address.
+// The client previously registered for the same account, if any, is stopped.
 func (m *AccountManager) AddClient(address gethcommon.Address, client *rpc.EncRPCClient) {
+	m.accountClientsMutex.Lock()
+	defer m.accountClientsMutex.Unlock()
+
+	if existingClient, found := m.accountClients[address]; found && existingClient != client {
+		existingClient.Stop()
+	}
 	m.accountClients[address] = client
 }
 
+// Close stops the clients of all the registered accounts. The unauthenticated client is shared, so it is not stopped.
+func (m *AccountManager) Close() {
+	m.accountClientsMutex.Lock()
+	defer m.accountClientsMutex.Unlock()
+
+	for address, client := range m.accountClients {
+		client.Stop()
+		delete(m.accountClients, address)
+	}
+}
+
+// RevokeViewingKeys revokes the viewing keys of all the registered accounts in the enclaves, so they can no longer be
+// used to decrypt the data of the accounts, even by someone holding a copy of the keys and their signatures
+func (m *AccountManager) RevokeViewingKeys() error {
+	for address, client := range m.clients() {
+		var revoked bool
+		if err := client.Call(&revoked, rpc.RevokeViewingKey, address.Hex()); err != nil {
+			return fmt.Errorf("could not revoke the viewing key of account %s. Cause: %w", address.Hex(), err)
+		}
+	}
+	return nil
+}
+
+// HasActiveSubscriptions returns whether a subscription made through the account manager is still being served
+func (m *AccountManager) HasActiveSubscriptions() bool {
+	return m.activeSubscriptions.Load() > 0
+}
+
+// ActiveSubscriptions returns the number of subscriptions made through the account manager that are still being served
+func (m *AccountManager) ActiveSubscriptions() int64 {
+	return m.activeSubscriptions.Load()
+}
+
+// clients returns a snapshot of the registered clients, so requests can be served while new accounts are registered
+func (m *AccountManager) clients() map[gethcommon.Address]*rpc.EncRPCClient {
+	m.accountClientsMutex.RLock()
+	defer m.accountClientsMutex.RUnlock()
+
+	accountClients := make(map[gethcommon.Address]*rpc.EncRPCClient, len(m.accountClients))
+	for address, client := range m.accountClients {
+		accountClients[address] = client
+	}
+	return accountClients
+}
+
 // ProxyRequest tries to identify the correct EncRPCClient to proxy the request to the Obscuro node, or it will attempt
 // the request with all clients until it succeeds
 func (m *AccountManager) ProxyRequest(rpcReq *RPCRequest, rpcResp *interface{}, userConn userconn.UserConn) error {
 	if rpcReq.Method == rpc.Subscribe {
+		if len(rpcReq.Params) > 0 && rpcReq.Params[0] == SubscriptionTypeResume {
+			return m.resumeSubscription(rpcReq, rpcResp, userConn)
+		}
 		clients, err := m.suggestSubscriptionClient(rpcReq)
 		if err != nil {
 			return err
@@ -80,10 +146,11 @@ const emptyFilterCriteria = "[]" // This is the value that gets passed for an em
 // determine the client based on the topics
 // if none is found use all clients from current user
 func (m *AccountManager) suggestSubscriptionClient(rpcReq *RPCRequest) ([]rpc.Client, error) {
-	clients := make([]rpc.Client, 0, len(m.accountClients))
+	accountClients := m.clients()
+	clients := make([]rpc.Client, 0, len(accountClients))
 
 	// by default, if no client is identified as a candidate, then subscribe to all accounts
-	for _, c := range m.accountClients {
+	for _, c := range accountClients {
 		clients = append(clients, c)
 	}
 
@@ -115,7 +182,7 @@ func (m *AccountManager) suggestSubscriptionClient(rpcReq *RPCRequest) ([]rpc.Cl
 			potentialAddr := common.ExtractPotentialAddress(topic)
 			m.logger.Info(fmt.Sprintf("Potential address (%s) found for the request %s", potentialAddr, rpcReq))
 			if potentialAddr != nil {
-				cl, found := m.accountClients[*potentialAddr]
+				cl, found := accountClients[*potentialAddr]
 				if found {
 					m.logger.Info("Client found for potential address: ", potentialAddr)
 					return []rpc.Client{cl}, nil
@@ -130,7 +197,8 @@ func (m *AccountManager) suggestSubscriptionClient(rpcReq *RPCRequest) ([]rpc.Cl
 
 func (m *AccountManager) executeCall(rpcReq *RPCRequest, rpcResp *interface{}) error {
 	// for obscuro RPC requests it is important we know the sender account for the viewing key encryption/decryption
-	suggestedClient := m.suggestAccountClient(rpcReq, m.accountClients)
+	accountClients := m.clients()
+	suggestedClient := m.suggestAccountClient(rpcReq, accountClients)
 
 	switch {
 	case suggestedClient != nil: // use the suggested client if there is one
@@ -138,10 +206,10 @@ func (m *AccountManager) executeCall(rpcReq *RPCRequest, rpcResp *interface{}) e
 		// 		The call data guessing won't often be wrong but there could be edge-cases there
 		return submitCall(suggestedClient, rpcReq, rpcResp)
 
-	case len(m.accountClients) > 0: // try registered clients until there's a successful execution
-		m.logger.Info(fmt.Sprintf("appropriate client not found, attempting request with up to %d clients", len(m.accountClients)))
+	case len(accountClients) > 0: // try registered clients until there's a successful execution
+		m.logger.Info(fmt.Sprintf("appropriate client not found, attempting request with up to %d clients", len(accountClients)))
 		var err error
-		for _, client := range m.accountClients {
+		for _, client := range accountClients {
 			err = submitCall(client, rpcReq, rpcResp)
 			if err == nil || errors.Is(err, rpc.ErrNilResponse) {
 				// request didn't fail, we don't need to continue trying the other clients
@@ -168,13 +236,38 @@ func (m *AccountManager) suggestAccountClient(req *RPCRequest, accClients map[ge
 		}
 	}
 
+	if req.Method == rpc.SimulateCalls {
+		// the calls of a simulated bundle share the `from` address, so the first call identifies the client
+		firstCall, err := parseFirstSimulatedCall(req.Params)
+		if err != nil {
+			return nil
+		}
+		fromClient, found := checkForFromField(firstCall, accClients)
+		if found {
+			return fromClient
+		}
+		return nil
+	}
+
+	if req.Method == rpc.GetOutboundMessages {
+		// the messages are requested for the account given as first param
+		if len(req.Params) == 0 {
+			return nil
+		}
+		addressStr, ok := req.Params[0].(string)
+		if !ok || !gethcommon.IsHexAddress(addressStr) {
+			return nil
+		}
+		return accClients[gethcommon.HexToAddress(addressStr)]
+	}
+
 	paramsMap, err := parseParams(req.Params)
 	if err != nil {
 		// no further info to deduce calling client
 		return nil
 	}
 
-	if req.Method == rpc.Call {
+	if req.Method == rpc.Call || req.Method == rpc.CreateAccessList {
 		// check if request params had a "from" address and if we had a client for that address
 		fromClient, found := checkForFromField(paramsMap, accClients)
 		if found {
@@ -214,6 +307,25 @@ func parseParams(args []interface{}) (map[string]interface{}, error) {
 	return params, nil
 }
 
+// obscuro_simulateCalls requests provide the list of calls as first argument
+func parseFirstSimulatedCall(args []interface{}) (map[string]interface{}, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("no params found to unmarshal")
+	}
+
+	calls, ok := args[0].([]interface{})
+	if !ok || len(calls) == 0 {
+		return nil, fmt.Errorf("first arg was not a non-empty list of calls")
+	}
+
+	firstCall, ok := calls[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("first call was not a params map")
+	}
+
+	return firstCall, nil
+}
+
 func checkForFromField(paramsMap map[string]interface{}, accClients map[gethcommon.Address]*rpc.EncRPCClient) (*rpc.EncRPCClient, bool) {
 	fromVal, found := paramsMap[wecommon.JSONKeyFrom]
 	if !found {
@@ -275,67 +387,62 @@ func searchDataFieldForAccount(callParams map[string]interface{}, accClients map
 	return nil, fmt.Errorf("no known account found in data bytes")
 }
 
-func (m *AccountManager) executeSubscribe(client rpc.Client, req *RPCRequest, resp *interface{}, userConn userconn.UserConn) error { //nolint: gocognit
+func (m *AccountManager) executeSubscribe(client rpc.Client, req *RPCRequest, resp *interface{}, userConn userconn.UserConn) error {
 	if len(req.Params) == 0 {
 		return fmt.Errorf("could not subscribe as no subscription namespace was provided")
 	}
 	m.logger.Info(fmt.Sprintf("Subscribing client: %s for request: %s", client, req))
-	ch := make(chan common.IDAndLog)
-	subscription, err := client.Subscribe(context.Background(), resp, rpc.SubscribeNamespace, ch, req.Params...)
-	if err != nil {
+	subscription := newResumableSubscription(client, req, m.subscriptionConfig, userConn, m.logger)
+	if err := subscription.subscribe(); err != nil {
 		return fmt.Errorf("could not call %s with params %v. Cause: %w", req.Method, req.Params, err)
 	}
+	// the user receives the logs under the ID of the gateway subscription, which stays the same if the host
+	// subscription is recreated, possibly on another host
+	*resp = subscription.id
 
-	// We listen for incoming messages on the subscription.
-	go func() {
-		for {
-			select {
-			case idAndLog := <-ch:
-				if userConn.IsClosed() {
-					m.logger.Info("received log but websocket was closed on subscription", log.SubIDKey, idAndLog.SubID)
-					return
-				}
-
-				jsonResponse, err := prepareLogResponse(idAndLog)
-				if err != nil {
-					m.logger.Error("could not marshal log response to JSON on subscription.", log.SubIDKey, idAndLog.SubID, log.ErrKey, err)
-					continue
-				}
-
-				m.logger.Trace(fmt.Sprintf("Forwarding log from Obscuro node: %s", jsonResponse), log.SubIDKey, idAndLog.SubID)
-				err = userConn.WriteResponse(jsonResponse)
-				if err != nil {
-					m.logger.Error("could not write the JSON log to the websocket on subscription %", log.SubIDKey, idAndLog.SubID, log.ErrKey, err)
-					continue
-				}
+	m.subscriptionsMutex.Lock()
+	m.subscriptions[subscription.id] = subscription
+	m.subscriptionsMutex.Unlock()
+	m.activeSubscriptions.Add(1)
 
-			case err = <-subscription.Err():
-				// An error on this channel means the subscription has ended, so we exit the loop.
-				if userConn != nil && err != nil {
-					userConn.HandleError(err.Error())
-				}
+	go func() {
+		defer m.activeSubscriptions.Add(-1)
+		subscription.run()
 
-				return
-			}
-		}
+		m.subscriptionsMutex.Lock()
+		defer m.subscriptionsMutex.Unlock()
+		delete(m.subscriptions, subscription.id)
 	}()
 
-	// We periodically check if the websocket is closed, and terminate the subscription.
-	go func() {
-		for {
-			if userConn.IsClosed() {
-				subscription.Unsubscribe()
-				return
-			}
-			time.Sleep(100 * time.Millisecond)
-		}
-	}()
+	return nil
+}
 
+// resumeSubscription reattaches the websocket to a subscription of the user whose websocket was disconnected less
+// than the grace period ago. The logs emitted in between are sent first.
+func (m *AccountManager) resumeSubscription(req *RPCRequest, resp *interface{}, userConn userconn.UserConn) error {
+	if len(req.Params) < 2 {
+		return fmt.Errorf("could not resume subscription as no subscription ID was provided")
+	}
+	subID, ok := req.Params[1].(string)
+	if !ok {
+		return fmt.Errorf("could not resume subscription as the subscription ID is not a string")
+	}
+
+	m.subscriptionsMutex.Lock()
+	subscription, found := m.subscriptions[gethrpc.ID(subID)]
+	m.subscriptionsMutex.Unlock()
+	if !found {
+		return fmt.Errorf("could not resume subscription %s, it does not exist or its grace period has passed", subID)
+	}
+	if err := subscription.resume(userConn); err != nil {
+		return fmt.Errorf("could not resume subscription %s. Cause: %w", subID, err)
+	}
+	*resp = subscription.id
 	return nil
 }
 
 func submitCall(client *rpc.EncRPCClient, req *RPCRequest, resp *interface{}) error {
-	if req.Method == rpc.Call || req.Method == rpc.EstimateGas {
+	if req.Method == rpc.Call || req.Method == rpc.EstimateGas || req.Method == rpc.CreateAccessList {
 		// Never modify the original request, as it might be reused.
 		req = req.Clone()
 
@@ -349,6 +456,19 @@ func submitCall(client *rpc.EncRPCClient, req *RPCRequest, resp *interface{}) er
 		}
 	}
 
+	if req.Method == rpc.SimulateCalls {
+		// Never modify the original request, as it might be reused.
+		req = req.Clone()
+
+		// Same as for `eth_call`, except that the `from` field has to be populated for each call of the bundle.
+		account := client.Account()
+		var err error
+		req.Params, err = setCallsFromFieldIfMissing(req.Params, *account)
+		if err != nil {
+			return err
+		}
+	}
+
 	if req.Method == rpc.GetLogs {
 		// Never modify the original request, as it might be reused.
 		req = req.Clone()
@@ -391,6 +511,36 @@ func setFromFieldIfMissing(args []interface{}, account gethcommon.Address) ([]in
 	return request, nil
 }
 
+// Applies the same logic as setFromFieldIfMissing to each of the calls of an `obscuro_simulateCalls` request. Requests
+// that do not provide a list of calls are passed through unchanged, and will be rejected by the enclave.
+func setCallsFromFieldIfMissing(args []interface{}, account gethcommon.Address) ([]interface{}, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("no params found to unmarshal")
+	}
+
+	calls, ok := args[0].([]interface{})
+	if !ok {
+		return args, nil
+	}
+
+	updatedCalls := make([]interface{}, 0, len(calls))
+	for _, call := range calls {
+		updatedCall, err := setFromFieldIfMissing([]interface{}{call}, account)
+		if err != nil {
+			return nil, err
+		}
+		updatedCalls = append(updatedCalls, updatedCall[0])
+	}
+
+	// do not modify other existing arguments
+	request := []interface{}{updatedCalls}
+	for i := 1; i < len(args); i++ {
+		request = append(request, args[i])
+	}
+
+	return request, nil
+}
+
 // Formats the log to be sent as an Eth JSON-RPC response.
 func prepareLogResponse(idAndLog common.IDAndLog) ([]byte, error) {
 	paramsMap := make(map[string]interface{})
@@ -0,0 +1,317 @@
+package accountmanager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/rpc"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/userconn"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// SubscriptionTypeResume is the type of the `eth_subscribe` requests reattaching a websocket to an existing
+	// subscription. The second parameter is the ID of the subscription, which doubles as the resume token.
+	SubscriptionTypeResume = "resume"
+
+	connCheckInterval = 100 * time.Millisecond
+
+	filterKeyFromBlock = "fromBlock"
+	filterKeyToBlock   = "toBlock"
+	filterKeyBlockHash = "blockHash"
+)
+
+var errSubscriptionEnded = errors.New("subscription has ended")
+
+// SubscriptionConfig configures how the log subscriptions outlive the websocket connections of the users
+type SubscriptionConfig struct {
+	BufferSize  int           // The maximum number of logs buffered per subscription while its websocket is disconnected. Zero disables the limit.
+	GracePeriod time.Duration // How long a subscription is kept after its websocket is disconnected. Zero disables resuming subscriptions.
+}
+
+// resumableSubscription forwards the logs of a host subscription to the websocket of the user. If the websocket is
+// disconnected, the logs are buffered until the user resumes the subscription or the grace period passes. If the host
+// subscription ends, it is recreated and the logs emitted in between are backfilled with `eth_getLogs`.
+type resumableSubscription struct {
+	id       gethrpc.ID // The ID returned to the user, which is also the token to resume the subscription
+	client   rpc.Client
+	request  *RPCRequest
+	logsCh   chan common.IDAndLog
+	hostSub  *gethrpc.ClientSubscription
+	config   SubscriptionConfig
+	resumeCh chan userconn.UserConn
+	done     chan struct{}
+	logger   gethlog.Logger
+
+	// The fields below are only accessed by the goroutine serving the subscription.
+	userConn       userconn.UserConn // Nil while the websocket is disconnected
+	disconnectedAt time.Time
+	replayPending  bool         // Whether the buffered logs still have to be sent to the resumed websocket
+	buffer         []*types.Log // The logs received while the websocket is disconnected, oldest first
+	overflowed     bool         // Whether logs were dropped from the full buffer, so they have to be backfilled
+	heightKnown    bool         // Whether lastHeight has been set
+	lastHeight     uint64       // The height of the batch of the last log sent to the user
+	sentAtHeight   map[gethcommon.Hash]struct{}
+}
+
+func newResumableSubscription(client rpc.Client, req *RPCRequest, config SubscriptionConfig, userConn userconn.UserConn, logger gethlog.Logger) *resumableSubscription {
+	return &resumableSubscription{
+		id:           gethrpc.NewID(),
+		client:       client,
+		request:      req,
+		logsCh:       make(chan common.IDAndLog),
+		config:       config,
+		resumeCh:     make(chan userconn.UserConn),
+		done:         make(chan struct{}),
+		logger:       logger,
+		userConn:     userConn,
+		sentAtHeight: make(map[gethcommon.Hash]struct{}),
+	}
+}
+
+// subscribe creates the host subscription. The logs of the batches after the current one are backfilled if the host
+// subscription has to be recreated before any log is sent.
+func (s *resumableSubscription) subscribe() error {
+	var hostSubID string
+	hostSub, err := s.client.Subscribe(context.Background(), &hostSubID, rpc.SubscribeNamespace, s.logsCh, s.request.Params...)
+	if err != nil {
+		return err
+	}
+	s.hostSub = hostSub
+
+	var height hexutil.Uint64
+	if err = s.client.Call(&height, rpc.BatchNumber); err == nil {
+		s.heightKnown, s.lastHeight = true, uint64(height)
+	}
+	return nil
+}
+
+// resume reattaches the subscription to the websocket, which receives the buffered logs first
+func (s *resumableSubscription) resume(userConn userconn.UserConn) error {
+	select {
+	case s.resumeCh <- userConn:
+		return nil
+	case <-s.done:
+		return errSubscriptionEnded
+	}
+}
+
+// run serves the subscription until the host subscription is unsubscribed or cannot be recreated
+func (s *resumableSubscription) run() {
+	defer close(s.done)
+	connCheck := time.NewTicker(connCheckInterval)
+	defer connCheck.Stop()
+
+	for {
+		select {
+		case idAndLog := <-s.logsCh:
+			s.handleLog(idAndLog.Log)
+
+		case err := <-s.hostSub.Err():
+			// An error on this channel means the subscription has ended. If the connection to the host was lost, the
+			// subscription is recreated, on another host if the client fails over.
+			if err == nil {
+				return
+			}
+			if err = s.recreate(err); err != nil {
+				if s.userConn != nil {
+					s.userConn.HandleError(err.Error())
+				}
+				return
+			}
+
+		case userConn := <-s.resumeCh:
+			// the buffered logs are sent on the next check, so the response to the resume request is written first
+			s.userConn, s.replayPending = userConn, true
+			s.logger.Info("subscription resumed", log.SubIDKey, s.id)
+
+		case now := <-connCheck.C:
+			if !s.checkConn(now) {
+				s.hostSub.Unsubscribe()
+				return
+			}
+		}
+	}
+}
+
+// checkConn detaches the subscription from a closed websocket and sends the buffered logs to a resumed one. It returns
+// false once the websocket has been disconnected for longer than the grace period.
+func (s *resumableSubscription) checkConn(now time.Time) bool {
+	if s.userConn != nil && s.userConn.IsClosed() {
+		s.userConn, s.replayPending, s.disconnectedAt = nil, false, now
+		s.logger.Info("websocket was closed on subscription", log.SubIDKey, s.id)
+	}
+	if s.userConn == nil {
+		return now.Sub(s.disconnectedAt) < s.config.GracePeriod
+	}
+	if s.replayPending {
+		s.replay()
+	}
+	return true
+}
+
+// replay sends the logs buffered while the websocket was disconnected
+func (s *resumableSubscription) replay() {
+	s.replayPending = false
+	if s.overflowed {
+		// the oldest logs were dropped, so every log since the last one sent is fetched again
+		s.overflowed = false
+		s.backfill()
+	}
+	buffer := s.buffer
+	s.buffer = nil
+	for _, l := range buffer {
+		s.send(l)
+	}
+}
+
+// recreate recreates the host subscription after it ended with an error, retrying until it succeeds or the
+// subscription is abandoned, and backfills the logs emitted while there was no host subscription
+func (s *resumableSubscription) recreate(cause error) error {
+	var err error
+	for attempt := 0; attempt < maxResubscribeAttempts; attempt++ {
+		if !s.checkConn(time.Now()) {
+			return errors.New("websocket was closed")
+		}
+		var hostSubID string
+		var hostSub *gethrpc.ClientSubscription
+		hostSub, err = s.client.Subscribe(context.Background(), &hostSubID, rpc.SubscribeNamespace, s.logsCh, s.request.Params...)
+		if err == nil {
+			s.logger.Info("recreated subscription after it ended", log.SubIDKey, s.id, log.ErrKey, cause)
+			s.hostSub = hostSub
+			s.backfill()
+			return nil
+		}
+		if errors.Is(err, gethrpc.ErrClientQuit) {
+			// the client was stopped by the gateway
+			break
+		}
+		time.Sleep(resubscribeRetryInterval)
+	}
+	return fmt.Errorf("could not recreate subscription. Cause: %w", err)
+}
+
+// backfill fetches the logs since the batch of the last log sent to the user. The logs that were already sent or
+// buffered are skipped when they are handled.
+func (s *resumableSubscription) backfill() {
+	encClient, ok := s.client.(*rpc.EncRPCClient)
+	if !ok || !s.heightKnown {
+		return
+	}
+
+	fromHeight := s.lastHeight
+	if len(s.sentAtHeight) == 0 {
+		// none of the logs of the last batch were sent
+		fromHeight++
+	}
+	filter, err := backfillFilter(s.request.Params, fromHeight)
+	if err != nil {
+		s.logger.Error("could not create the filter to backfill the logs", log.SubIDKey, s.id, log.ErrKey, err)
+		return
+	}
+
+	var logs []*types.Log
+	err = encClient.Call(&logs, rpc.GetLogs, filter, encClient.Account().Hex())
+	if err != nil && !errors.Is(err, rpc.ErrNilResponse) {
+		s.logger.Error("could not backfill the logs", log.SubIDKey, s.id, log.ErrKey, err)
+		return
+	}
+	s.logger.Info(fmt.Sprintf("backfilling %d logs from batch %d", len(logs), fromHeight), log.SubIDKey, s.id)
+	for _, l := range logs {
+		s.handleLog(l)
+	}
+}
+
+// handleLog sends the log to the websocket, or buffers it while the websocket is disconnected
+func (s *resumableSubscription) handleLog(l *types.Log) {
+	if s.userConn != nil && !s.replayPending {
+		s.send(l)
+		return
+	}
+
+	s.buffer = append(s.buffer, l)
+	if s.config.BufferSize > 0 && len(s.buffer) > s.config.BufferSize {
+		s.buffer = s.buffer[1:]
+		s.overflowed = true
+	}
+}
+
+// send writes the log to the websocket, unless it was already sent. The logs arrive in batch order, so it is enough to
+// remember the logs sent for the last batch.
+func (s *resumableSubscription) send(l *types.Log) {
+	if s.heightKnown && l.BlockNumber < s.lastHeight {
+		return
+	}
+	if !s.heightKnown || l.BlockNumber > s.lastHeight {
+		s.heightKnown, s.lastHeight = true, l.BlockNumber
+		s.sentAtHeight = make(map[gethcommon.Hash]struct{})
+	}
+	key, err := logKey(l)
+	if err != nil {
+		s.logger.Error("could not identify log on subscription.", log.SubIDKey, s.id, log.ErrKey, err)
+		return
+	}
+	if _, sent := s.sentAtHeight[key]; sent {
+		return
+	}
+	s.sentAtHeight[key] = struct{}{}
+
+	jsonResponse, err := prepareLogResponse(common.IDAndLog{SubID: s.id, Log: l})
+	if err != nil {
+		s.logger.Error("could not marshal log response to JSON on subscription.", log.SubIDKey, s.id, log.ErrKey, err)
+		return
+	}
+
+	s.logger.Trace(fmt.Sprintf("Forwarding log from Obscuro node: %s", jsonResponse), log.SubIDKey, s.id)
+	err = s.userConn.WriteResponse(jsonResponse)
+	if err != nil {
+		s.logger.Error("could not write the JSON log to the websocket on subscription", log.SubIDKey, s.id, log.ErrKey, err)
+		if s.userConn.IsClosed() {
+			// the log is kept for when the subscription is resumed
+			delete(s.sentAtHeight, key)
+			s.checkConn(time.Now())
+			s.handleLog(l)
+		}
+	}
+}
+
+// logKey identifies a log, whether it is received from a subscription or from `eth_getLogs`
+func logKey(l *types.Log) (gethcommon.Hash, error) {
+	jsonLog, err := json.Marshal(l)
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+	return crypto.Keccak256Hash(jsonLog), nil
+}
+
+// backfillFilter returns the filter of the subscription, restricted to the batches from the given height onwards
+func backfillFilter(params []interface{}, fromHeight uint64) (map[string]interface{}, error) {
+	filter := map[string]interface{}{}
+	if len(params) >= 2 && params[1] != nil {
+		filterJSON, err := json.Marshal(params[1])
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal filter criteria to JSON. Cause: %w", err)
+		}
+		if string(filterJSON) != emptyFilterCriteria {
+			if err = json.Unmarshal(filterJSON, &filter); err != nil {
+				return nil, fmt.Errorf("could not unmarshal filter criteria from the following JSON: `%s`. Cause: %w", string(filterJSON), err)
+			}
+		}
+	}
+
+	delete(filter, filterKeyBlockHash)
+	delete(filter, filterKeyToBlock)
+	filter[filterKeyFromBlock] = hexutil.Uint64(fromHeight)
+	return filter, nil
+}
@@ -0,0 +1,94 @@
+package accountmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type fakeUserConn struct {
+	closed bool
+	msgs   [][]byte
+}
+
+func (c *fakeUserConn) ReadRequest() ([]byte, error)         { return nil, nil }
+func (c *fakeUserConn) ReadRequestParams() map[string]string { return nil }
+func (c *fakeUserConn) WriteResponse(msg []byte) error {
+	c.msgs = append(c.msgs, msg)
+	return nil
+}
+func (c *fakeUserConn) HandleError(string)          {}
+func (c *fakeUserConn) SupportsSubscriptions() bool { return true }
+func (c *fakeUserConn) IsClosed() bool              { return c.closed }
+func (c *fakeUserConn) ClientIP() string            { return "" }
+
+func newTestLog(height uint64, index uint) *types.Log {
+	return &types.Log{BlockNumber: height, Index: index}
+}
+
+func TestLogsAreBufferedWhileDisconnected(t *testing.T) {
+	conn := &fakeUserConn{}
+	sub := newResumableSubscription(nil, &RPCRequest{}, SubscriptionConfig{BufferSize: 2, GracePeriod: time.Minute}, conn, log.New())
+
+	sub.handleLog(newTestLog(1, 0))
+	conn.closed = true
+	if !sub.checkConn(time.Now()) {
+		t.Fatal("expected subscription to be kept during the grace period")
+	}
+	sub.handleLog(newTestLog(2, 0))
+	sub.handleLog(newTestLog(2, 1))
+	sub.handleLog(newTestLog(3, 0))
+	if len(sub.buffer) != 2 || !sub.overflowed {
+		t.Fatalf("expected the oldest log to be dropped from the buffer, got %d logs", len(sub.buffer))
+	}
+	if sub.checkConn(time.Now().Add(2 * time.Minute)) {
+		t.Fatal("expected subscription to end after the grace period")
+	}
+
+	resumedConn := &fakeUserConn{}
+	sub.userConn, sub.replayPending = resumedConn, true
+	sub.checkConn(time.Now())
+	if len(resumedConn.msgs) != 2 || len(sub.buffer) != 0 {
+		t.Fatalf("expected the buffered logs to be replayed, got %d logs", len(resumedConn.msgs))
+	}
+}
+
+func TestLogsAreNotSentTwice(t *testing.T) {
+	conn := &fakeUserConn{}
+	sub := newResumableSubscription(nil, &RPCRequest{}, SubscriptionConfig{}, conn, log.New())
+
+	// the backfilled logs overlap the logs already sent
+	for _, l := range []*types.Log{newTestLog(5, 0), newTestLog(5, 1), newTestLog(4, 0), newTestLog(5, 1), newTestLog(5, 2), newTestLog(6, 0)} {
+		sub.handleLog(l)
+	}
+	if len(conn.msgs) != 4 {
+		t.Fatalf("expected 4 logs to be sent, got %d", len(conn.msgs))
+	}
+}
+
+func TestBackfillFilterStartsFromHeight(t *testing.T) {
+	params := []interface{}{"logs", map[string]interface{}{
+		"address":   "0x71C7656EC7ab88b098defB751B7401B5f6d8976F",
+		"fromBlock": "0x1",
+		"toBlock":   "0x2",
+	}}
+	filter, err := backfillFilter(params, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filter[filterKeyFromBlock] != hexutil.Uint64(7) {
+		t.Errorf("expected the filter to start from height 7, got %v", filter[filterKeyFromBlock])
+	}
+	if _, found := filter[filterKeyToBlock]; found {
+		t.Errorf("expected the filter to have no end height")
+	}
+	if filter["address"] != params[1].(map[string]interface{})["address"] {
+		t.Errorf("expected the filter to keep the address of the subscription")
+	}
+	if params[1].(map[string]interface{})[filterKeyFromBlock] != "0x1" {
+		t.Errorf("expected the filter of the subscription not to be modified")
+	}
+}
@@ -0,0 +1,249 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/tools/walletextension"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/ratelimiter"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const bearerPrefix = "Bearer "
+
+// defaultLimitsResponse is returned when the limits are inspected without selecting a user
+type defaultLimitsResponse struct {
+	UserLimits    ratelimiter.Limits `json:"userLimits"`
+	IPLimits      ratelimiter.Limits `json:"ipLimits"`
+	MethodWeights map[string]uint64  `json:"methodWeights"`
+}
+
+type userLimitsResponse struct {
+	UserID       string             `json:"userID"`
+	Limits       ratelimiter.Limits `json:"limits"`
+	CustomLimits bool               `json:"customLimits"`
+	UsedToday    uint64             `json:"usedToday"`
+}
+
+type userResponse struct {
+	UserID              string               `json:"userID"`
+	Accounts            []gethcommon.Address `json:"accounts"`
+	Requests            uint64               `json:"requests"` // Since the gateway started.
+	ActiveSubscriptions int64                `json:"activeSubscriptions"`
+}
+
+type accountResponse struct {
+	Address gethcommon.Address `json:"address"`
+	UserID  string             `json:"userID"`
+}
+
+type subscriptionsResponse struct {
+	Active int64            `json:"active"`
+	Users  map[string]int64 `json:"users"`
+}
+
+// NewAdminRoutes returns the routes of the admin listener, which are all authenticated with the admin API key
+func NewAdminRoutes(walletExt *walletextension.WalletExtension) []Route {
+	return []Route{
+		{
+			Name: common.APIVersion1 + common.PathAdminLimits,
+			Func: adminHandler(walletExt, limitsRequestHandler),
+		},
+		{
+			Name: common.APIVersion1 + common.PathAdminUsers,
+			Func: adminHandler(walletExt, usersRequestHandler),
+		},
+		{
+			Name: common.APIVersion1 + common.PathAdminAccounts,
+			Func: adminHandler(walletExt, accountsRequestHandler),
+		},
+		{
+			Name: common.APIVersion1 + common.PathAdminMethods,
+			Func: adminHandler(walletExt, methodsRequestHandler),
+		},
+		{
+			Name: common.APIVersion1 + common.PathAdminSubscriptions,
+			Func: adminHandler(walletExt, subscriptionsRequestHandler),
+		},
+		{
+			Name: common.PathMetrics,
+			Func: adminHandler(walletExt, metricsRequestHandler),
+		},
+	}
+}
+
+// adminHandler only passes on the requests carrying the admin API key as a bearer token
+func adminHandler(
+	walletExt *walletextension.WalletExtension,
+	fun func(walletExt *walletextension.WalletExtension, resp http.ResponseWriter, req *http.Request),
+) func(resp http.ResponseWriter, req *http.Request) {
+	return func(resp http.ResponseWriter, req *http.Request) {
+		if walletExt.IsStopping() {
+			return
+		}
+		apiKey := strings.TrimPrefix(req.Header.Get("Authorization"), bearerPrefix)
+		if !walletExt.IsAdmin(apiKey) {
+			http.Error(resp, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		fun(walletExt, resp, req)
+	}
+}
+
+// limitsRequestHandler inspects the rate limits (GET), overrides the limits of a user (PUT) or restores their default
+// limits (DELETE). The user is selected with the `u` query parameter. Without it, the default limits are returned.
+func limitsRequestHandler(walletExt *walletextension.WalletExtension, resp http.ResponseWriter, req *http.Request) {
+	rateLimiter := walletExt.RateLimiter()
+	hexUserID := req.URL.Query().Get(common.UserQueryParameter)
+	if hexUserID == "" {
+		if req.Method != http.MethodGet {
+			http.Error(resp, fmt.Sprintf("the %s query parameter is required", common.UserQueryParameter), http.StatusBadRequest)
+			return
+		}
+		userLimits, ipLimits := rateLimiter.DefaultLimits()
+		writeJSON(walletExt, resp, defaultLimitsResponse{
+			UserLimits:    userLimits,
+			IPLimits:      ipLimits,
+			MethodWeights: rateLimiter.MethodWeights(),
+		})
+		return
+	}
+
+	var err error
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var limits ratelimiter.Limits
+		if err = json.NewDecoder(req.Body).Decode(&limits); err != nil {
+			http.Error(resp, fmt.Sprintf("could not unmarshal limits: %s", err), http.StatusBadRequest)
+			return
+		}
+		err = rateLimiter.SetLimits(hexUserID, &limits)
+	case http.MethodDelete:
+		err = rateLimiter.SetLimits(hexUserID, nil)
+	default:
+		http.Error(resp, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err != nil {
+		walletExt.Logger().Error("could not update the limits of the user", "userID", hexUserID, log.ErrKey, err)
+		http.Error(resp, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	limits, customLimits, usedToday, err := rateLimiter.GetLimits(hexUserID)
+	if err != nil {
+		http.Error(resp, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(walletExt, resp, userLimitsResponse{
+		UserID:       hexUserID,
+		Limits:       limits,
+		CustomLimits: customLimits,
+		UsedToday:    usedToday,
+	})
+}
+
+// usersRequestHandler lists the users with their accounts and request volumes (GET), or deletes the user selected with
+// the `u` query parameter (DELETE). The user is deleted even if their viewing keys can't be revoked in the enclaves.
+func usersRequestHandler(walletExt *walletextension.WalletExtension, resp http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		users, err := walletExt.ListUsers()
+		if err != nil {
+			walletExt.Logger().Error("could not list the users", log.ErrKey, err)
+			http.Error(resp, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		subscriptions := walletExt.ActiveSubscriptions()
+		usersResp := make([]userResponse, len(users))
+		for i, user := range users {
+			usersResp[i] = userResponse{
+				UserID:              user.HexUserID,
+				Accounts:            user.Accounts,
+				Requests:            walletExt.Metrics().UserRequests(user.HexUserID),
+				ActiveSubscriptions: subscriptions[user.HexUserID],
+			}
+		}
+		writeJSON(walletExt, resp, usersResp)
+	case http.MethodDelete:
+		hexUserID := req.URL.Query().Get(common.UserQueryParameter)
+		if hexUserID == "" {
+			http.Error(resp, fmt.Sprintf("the %s query parameter is required", common.UserQueryParameter), http.StatusBadRequest)
+			return
+		}
+		if err := walletExt.ForceDeleteUser(hexUserID); err != nil {
+			http.Error(resp, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		walletExt.Logger().Info("user deleted by an admin", "userID", hexUserID)
+		writeJSON(walletExt, resp, common.SuccessMsg)
+	default:
+		http.Error(resp, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// accountsRequestHandler lists the registered accounts, with the user that registered them
+func accountsRequestHandler(walletExt *walletextension.WalletExtension, resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(resp, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	users, err := walletExt.ListUsers()
+	if err != nil {
+		walletExt.Logger().Error("could not list the users", log.ErrKey, err)
+		http.Error(resp, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	accountsResp := []accountResponse{}
+	for _, user := range users {
+		for _, account := range user.Accounts {
+			accountsResp = append(accountsResp, accountResponse{Address: account, UserID: user.HexUserID})
+		}
+	}
+	writeJSON(walletExt, resp, accountsResp)
+}
+
+// methodsRequestHandler returns the number of requests, the number of errors and the latencies of each method
+func methodsRequestHandler(walletExt *walletextension.WalletExtension, resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(resp, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(walletExt, resp, walletExt.Metrics().MethodStats())
+}
+
+// subscriptionsRequestHandler returns the number of subscriptions still being served, in total and per user
+func subscriptionsRequestHandler(walletExt *walletextension.WalletExtension, resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(resp, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	subscriptionsResp := subscriptionsResponse{Users: walletExt.ActiveSubscriptions()}
+	for _, active := range subscriptionsResp.Users {
+		subscriptionsResp.Active += active
+	}
+	writeJSON(walletExt, resp, subscriptionsResp)
+}
+
+// metricsRequestHandler exports the metrics of the gateway in the Prometheus format
+func metricsRequestHandler(walletExt *walletextension.WalletExtension, resp http.ResponseWriter, req *http.Request) {
+	walletExt.Metrics().PrometheusHandler().ServeHTTP(resp, req)
+}
+
+func writeJSON(walletExt *walletextension.WalletExtension, resp http.ResponseWriter, value interface{}) {
+	msg, err := json.Marshal(value)
+	if err != nil {
+		http.Error(resp, fmt.Sprintf("could not marshal response: %s", err), http.StatusInternalServerError)
+		return
+	}
+	resp.Header().Set("Content-Type", "application/json")
+	if _, err = resp.Write(msg); err != nil {
+		walletExt.Logger().Error("could not write response", log.ErrKey, err)
+	}
+}
@@ -5,26 +5,48 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"github.com/obscuronet/go-obscuro/go/common/httputil"
 	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/rpc"
 	"github.com/obscuronet/go-obscuro/tools/walletextension"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/accountmanager"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/userconn"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/go-kit/kit/transport/http/jsonrpc"
 )
 
+// maxConcurrentBatchRequests - the number of requests of a JSON-RPC batch proxied to the node at the same time
+const maxConcurrentBatchRequests = 10
+
+var errSubscribeInBatch = fmt.Errorf("%s requests are not supported in a JSON-RPC batch", rpc.Subscribe)
+
+// signedRequest is the body of the requests registering an account. The issued-at and expiry timestamps are only set
+// if the viewing key was signed as EIP-712 typed data, rather than as personal-sign text
+type signedRequest struct {
+	Address   string `json:"address"`
+	Signature string `json:"signature"`
+	Message   string `json:"message"`
+	IssuedAt  uint64 `json:"issuedAt"`
+	Expiry    uint64 `json:"expiry"`
+}
+
+func (r *signedRequest) isEIP712() bool {
+	return r.Expiry != 0
+}
+
 // Route defines the path plus handler for a given path
 type Route struct {
 	Name string
 	Func func(resp http.ResponseWriter, req *http.Request)
 }
 
-// NewHTTPRoutes returns the http specific routes
+// NewHTTPRoutes returns the http specific routes. The Sign-In-With-Ethereum routes are only served if it is enabled.
 func NewHTTPRoutes(walletExt *walletextension.WalletExtension) []Route {
-	return []Route{
+	routes := []Route{
 		{
 			Name: common.APIVersion1 + common.PathRoot,
 			Func: httpHandler(walletExt, ethRequestHandler),
@@ -46,6 +68,10 @@ func NewHTTPRoutes(walletExt *walletextension.WalletExtension) []Route {
 			Name: common.APIVersion1 + common.PathJoin,
 			Func: httpHandler(walletExt, joinRequestHandler),
 		},
+		{
+			Name: common.APIVersion1 + common.PathGetMessage,
+			Func: httpHandler(walletExt, getMessageRequestHandler),
+		},
 		{
 			Name: common.APIVersion1 + common.PathAuthenticate,
 			Func: httpHandler(walletExt, authenticateRequestHandler),
@@ -63,6 +89,23 @@ func NewHTTPRoutes(walletExt *walletextension.WalletExtension) []Route {
 			Func: httpHandler(walletExt, healthRequestHandler),
 		},
 	}
+	if walletExt.Sessions() == nil {
+		return routes
+	}
+	return append(routes, []Route{
+		{
+			Name: common.APIVersion1 + common.PathSIWENonce,
+			Func: sessionHandler(walletExt, nonceRequestHandler),
+		},
+		{
+			Name: common.APIVersion1 + common.PathSIWELogin,
+			Func: sessionHandler(walletExt, loginRequestHandler),
+		},
+		{
+			Name: common.APIVersion1 + common.PathSIWELogout,
+			Func: sessionHandler(walletExt, logoutRequestHandler),
+		},
+	}...)
 }
 
 func httpHandler(
@@ -82,6 +125,10 @@ func httpRequestHandler(walletExt *walletextension.WalletExtension, resp http.Re
 	if httputil.EnableCORS(resp, req) {
 		return
 	}
+	req, ok := authenticateSession(walletExt, resp, req)
+	if !ok {
+		return
+	}
 	userConn := userconn.NewUserConnHTTP(resp, req, walletExt.Logger())
 	fun(walletExt, userConn)
 }
@@ -123,6 +170,11 @@ func wsRequestHandler(walletExt *walletextension.WalletExtension, resp http.Resp
 	if walletExt.IsStopping() {
 		return
 	}
+	// the session is checked before the connection is upgraded, so an invalid session is rejected with an HTTP error
+	req, ok := authenticateSession(walletExt, resp, req)
+	if !ok {
+		return
+	}
 
 	userConn, err := userconn.NewUserConnWS(resp, req, walletExt.Logger())
 	if err != nil {
@@ -144,6 +196,11 @@ func ethRequestHandler(walletExt *walletextension.WalletExtension, conn userconn
 		return
 	}
 
+	if isBatchRequest(body) {
+		ethBatchRequestHandler(walletExt, conn, body)
+		return
+	}
+
 	request, err := parseRequest(body)
 	if err != nil {
 		conn.HandleError(err.Error())
@@ -156,26 +213,63 @@ func ethRequestHandler(walletExt *walletextension.WalletExtension, conn userconn
 		return
 	}
 
-	// Get userID
-	hexUserID, err := getQueryParameter(conn.ReadRequestParams(), common.UserQueryParameter)
+	// todo (@pedro) remove this conn dependency
+	response := proxyEthRequest(walletExt, request, conn, getUserID(walletExt, conn))
+
+	rpcResponse, err := json.Marshal(response)
 	if err != nil {
-		walletExt.Logger().Error(fmt.Errorf("user not found in the query params: %w. Using the default user", err).Error())
-		hexUserID = hex.EncodeToString([]byte(common.DefaultUser)) // todo (@ziga) - this can be removed once old WE endpoints are removed
+		conn.HandleError(fmt.Sprintf("failed to remarshal RPC response to return to caller: %s", err))
+		return
 	}
+	walletExt.Logger().Info(fmt.Sprintf("Forwarding %s response from Obscuro node: %s", request.Method, rpcResponse))
 
-	// todo (@pedro) remove this conn dependency
-	response, err := walletExt.ProxyEthRequest(request, conn, hexUserID)
+	err = conn.WriteResponse(rpcResponse)
 	if err != nil {
-		walletExt.Logger().Error("error while proxying request", log.ErrKey, err)
-		response = common.CraftErrorResponse(err)
+		return
 	}
+}
 
-	rpcResponse, err := json.Marshal(response)
+// ethBatchRequestHandler proxies each request of a JSON-RPC batch concurrently, and returns the responses in the order of the requests.
+// A request that fails doesn't affect the others, its error is returned in its own response.
+func ethBatchRequestHandler(walletExt *walletextension.WalletExtension, conn userconn.UserConn, body []byte) {
+	var batch []json.RawMessage
+	err := json.Unmarshal(body, &batch)
 	if err != nil {
-		conn.HandleError(fmt.Sprintf("failed to remarshal RPC response to return to caller: %s", err))
+		conn.HandleError(fmt.Sprintf("could not unmarshal JSON-RPC batch request body to JSON: %s", err))
 		return
 	}
-	walletExt.Logger().Info(fmt.Sprintf("Forwarding %s response from Obscuro node: %s", request.Method, rpcResponse))
+	if len(batch) == 0 {
+		conn.HandleError("received an empty JSON-RPC batch request")
+		return
+	}
+	if maxBatchSize := walletExt.MaxBatchSize(); maxBatchSize > 0 && len(batch) > maxBatchSize {
+		conn.HandleError(fmt.Sprintf("JSON-RPC batch request contains %d requests, the maximum is %d", len(batch), maxBatchSize))
+		return
+	}
+	walletExt.Logger().Debug("BATCH REQUEST", "size", len(batch), "body", string(body))
+
+	hexUserID := getUserID(walletExt, conn)
+	responses := make([]map[string]interface{}, len(batch))
+	// bounds the number of requests of a batch being proxied to the node at the same time
+	semaphore := make(chan struct{}, maxConcurrentBatchRequests)
+	var wg sync.WaitGroup
+	for i, rawRequest := range batch {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, rawRequest json.RawMessage) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			responses[i] = proxyBatchElement(walletExt, conn, rawRequest, hexUserID)
+		}(i, rawRequest)
+	}
+	wg.Wait()
+
+	rpcResponse, err := json.Marshal(responses)
+	if err != nil {
+		conn.HandleError(fmt.Sprintf("failed to remarshal RPC batch response to return to caller: %s", err))
+		return
+	}
+	walletExt.Logger().Info(fmt.Sprintf("Forwarding batch response of %d requests from Obscuro node: %s", len(batch), rpcResponse))
 
 	err = conn.WriteResponse(rpcResponse)
 	if err != nil {
@@ -183,6 +277,48 @@ func ethRequestHandler(walletExt *walletextension.WalletExtension, conn userconn
 	}
 }
 
+// proxyBatchElement proxies a single request of a batch, turning any failure into an error response carrying the request id
+func proxyBatchElement(walletExt *walletextension.WalletExtension, conn userconn.UserConn, rawRequest json.RawMessage, hexUserID string) (response map[string]interface{}) {
+	request, err := parseRequest(rawRequest)
+	if err != nil {
+		return errorResponse(parseRequestID(rawRequest), err)
+	}
+	// the subscription notifications could not be told apart from the batch response
+	if request.Method == rpc.Subscribe {
+		return errorResponse(request.ID, errSubscribeInBatch)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			walletExt.Logger().Error("panic while proxying batch request", "method", request.Method, log.ErrKey, r)
+			response = errorResponse(request.ID, fmt.Errorf("failed to process %s request", request.Method))
+		}
+	}()
+	return proxyEthRequest(walletExt, request, conn, hexUserID)
+}
+
+// proxyEthRequest passes the request on to the WE and returns the response to be sent back to the user
+func proxyEthRequest(walletExt *walletextension.WalletExtension, request *accountmanager.RPCRequest, conn userconn.UserConn, hexUserID string) map[string]interface{} {
+	response, err := walletExt.ProxyEthRequest(request, conn, hexUserID)
+	if err != nil {
+		walletExt.Logger().Error("error while proxying request", log.ErrKey, err)
+		return errorResponse(request.ID, err)
+	}
+	// all responses must contain the request id, so the responses of a batch can be matched to their requests
+	response[common.JSONKeyRPCVersion] = jsonrpc.Version
+	response[common.JSONKeyID] = request.ID
+	return response
+}
+
+func getUserID(walletExt *walletextension.WalletExtension, conn userconn.UserConn) string {
+	hexUserID, err := getQueryParameter(conn.ReadRequestParams(), common.UserQueryParameter)
+	if err != nil {
+		walletExt.Logger().Error(fmt.Errorf("user not found in the query params: %w. Using the default user", err).Error())
+		hexUserID = hex.EncodeToString([]byte(common.DefaultUser)) // todo (@ziga) - this can be removed once old WE endpoints are removed
+	}
+	return hexUserID
+}
+
 // readyRequestHandler is used to check whether the server is ready
 func readyRequestHandler(_ *walletextension.WalletExtension, _ userconn.UserConn) {}
 
@@ -226,21 +362,29 @@ func submitViewingKeyRequestHandler(walletExt *walletextension.WalletExtension,
 		return
 	}
 
-	var reqJSONMap map[string]string
-	err = json.Unmarshal(body, &reqJSONMap)
+	var request signedRequest
+	err = json.Unmarshal(body, &request)
 	if err != nil {
 		userConn.HandleError(fmt.Sprintf("could not unmarshal address and signature from client to JSON: %s", err))
 		return
 	}
-	accAddress := gethcommon.HexToAddress(reqJSONMap[common.JSONKeyAddress])
+	accAddress := gethcommon.HexToAddress(request.Address)
 
-	signature, err := hex.DecodeString(reqJSONMap[common.JSONKeySignature][2:])
+	if len(request.Signature) < 2 {
+		userConn.HandleError("could not find signature in the request")
+		return
+	}
+	signature, err := hex.DecodeString(request.Signature[2:])
 	if err != nil {
 		userConn.HandleError(fmt.Sprintf("could not decode signature from client to hex: %s", err))
 		return
 	}
 
-	err = walletExt.SubmitViewingKey(accAddress, signature)
+	if request.isEIP712() {
+		err = walletExt.SubmitViewingKeyEIP712(accAddress, signature, request.IssuedAt, request.Expiry)
+	} else {
+		err = walletExt.SubmitViewingKey(accAddress, signature)
+	}
 	if err != nil {
 		userConn.HandleError(fmt.Sprintf("could not submit viewing key - %s", err))
 		return
@@ -279,8 +423,48 @@ func joinRequestHandler(walletExt *walletextension.WalletExtension, userConn use
 	}
 }
 
+// This function handles request to /getmessage endpoint.
+// It requires userID as query parameter and returns the EIP-712 typed data the user signs (with eth_signTypedData_v4)
+// to authenticate an account
+func getMessageRequestHandler(walletExt *walletextension.WalletExtension, userConn userconn.UserConn) {
+	// read the request
+	_, err := userConn.ReadRequest()
+	if err != nil {
+		userConn.HandleError("Error: bad request")
+		walletExt.Logger().Error(fmt.Errorf("error reading request: %w", err).Error())
+		return
+	}
+
+	hexUserID, err := getQueryParameter(userConn.ReadRequestParams(), common.UserQueryParameter)
+	if err != nil {
+		userConn.HandleError("user ('u') not found in query parameters")
+		walletExt.Logger().Error(fmt.Errorf("user not found in the query params: %w", err).Error())
+		return
+	}
+
+	typedData, err := walletExt.GenerateEIP712TypedData(hexUserID)
+	if err != nil {
+		userConn.HandleError("Internal error")
+		walletExt.Logger().Error(fmt.Errorf("unable to generate the typed data for user %s: %w", hexUserID, err).Error())
+		return
+	}
+
+	msg, err := json.Marshal(typedData.Map())
+	if err != nil {
+		userConn.HandleError("Internal error")
+		walletExt.Logger().Error(fmt.Errorf("error marshalling: %w", err).Error())
+		return
+	}
+
+	err = userConn.WriteResponse(msg)
+	if err != nil {
+		walletExt.Logger().Error(fmt.Errorf("error writing success response, %w", err).Error())
+	}
+}
+
 // This function handles request to /authenticate endpoint.
-// In the request we receive message, signature and address in JSON as request body and userID and address as query parameters
+// In the request we receive signature and either the signed message (personal-sign text) or the issued-at and expiry
+// timestamps of the signed EIP-712 typed data (see /getmessage) in JSON as request body, and userID as query parameter
 // We then check if message is in correct format and if signature is valid. If all checks pass we save address and signature against userID
 func authenticateRequestHandler(walletExt *walletextension.WalletExtension, userConn userconn.UserConn) {
 	// read the request
@@ -291,9 +475,9 @@ func authenticateRequestHandler(walletExt *walletextension.WalletExtension, user
 		return
 	}
 
-	// get the text that was signed and signature
-	var reqJSONMap map[string]string
-	err = json.Unmarshal(body, &reqJSONMap)
+	// get the text that was signed (or the timestamps of the typed data) and signature
+	var request signedRequest
+	err = json.Unmarshal(body, &request)
 	if err != nil {
 		userConn.HandleError("Internal error")
 		walletExt.Logger().Error(fmt.Errorf("error unmarshaling request to authentcate: %w", err).Error())
@@ -301,7 +485,12 @@ func authenticateRequestHandler(walletExt *walletextension.WalletExtension, user
 	}
 
 	// get signature from the request and remove leading two bytes (0x)
-	signature, err := hex.DecodeString(reqJSONMap[common.JSONKeySignature][2:])
+	if len(request.Signature) < 2 {
+		userConn.HandleError("Error: unable to decode signature")
+		walletExt.Logger().Error("could not find signature in the request")
+		return
+	}
+	signature, err := hex.DecodeString(request.Signature[2:])
 	if err != nil {
 		userConn.HandleError("Error: unable to decode signature")
 		walletExt.Logger().Error(fmt.Errorf("could not find or decode signature from client to hex: %w", err).Error())
@@ -309,10 +498,9 @@ func authenticateRequestHandler(walletExt *walletextension.WalletExtension, user
 	}
 
 	// get message from the request
-	message, ok := reqJSONMap[common.JSONKeyMessage]
-	if !ok || message == "" {
+	if !request.isEIP712() && request.Message == "" {
 		userConn.HandleError("Error: unable to read message field from the request")
-		walletExt.Logger().Error(fmt.Errorf("could not find message in the request: %w", err).Error())
+		walletExt.Logger().Error("could not find message in the request")
 		return
 	}
 
@@ -325,10 +513,14 @@ func authenticateRequestHandler(walletExt *walletextension.WalletExtension, user
 	}
 
 	// check signature and add address and signature for that user
-	err = walletExt.AddAddressToUser(hexUserID, message, signature)
+	if request.isEIP712() {
+		err = walletExt.AddAddressToUserEIP712(hexUserID, signature, request.IssuedAt, request.Expiry)
+	} else {
+		err = walletExt.AddAddressToUser(hexUserID, request.Message, signature)
+	}
 	if err != nil {
 		userConn.HandleError("Internal error")
-		walletExt.Logger().Error(fmt.Errorf("error adding address to user with message: %s, %w", message, err).Error())
+		walletExt.Logger().Error(fmt.Errorf("error adding address to user with message: %s, %w", request.Message, err).Error())
 		return
 	}
 	err = userConn.WriteResponse([]byte(common.SuccessMsg))
@@ -54,6 +54,17 @@ func NewWSServer(address string, routes []Route) *Server {
 	}
 }
 
+// NewAdminServer returns the server of the admin listener, which is kept separate from the public one
+func NewAdminServer(address string, routes []Route) *Server {
+	serveMux := http.NewServeMux()
+	for _, route := range routes {
+		serveMux.HandleFunc(route.Name, route.Func)
+	}
+	return &Server{
+		server: &http.Server{Addr: address, Handler: serveMux, ReadHeaderTimeout: common.ReaderHeadTimeout},
+	}
+}
+
 func createHTTPServer(address string, routes []Route) *http.Server {
 	serveMux := http.NewServeMux()
 
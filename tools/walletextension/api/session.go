@@ -0,0 +1,159 @@
+package api
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/obscuronet/go-obscuro/go/common/httputil"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/tools/walletextension"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/session"
+)
+
+// signInRequest is the body of the requests signing in with Ethereum
+type signInRequest struct {
+	Message   string `json:"message"`
+	Signature string `json:"signature"`
+}
+
+type nonceResponse struct {
+	Nonce string `json:"nonce"`
+}
+
+type signInResponse struct {
+	Token     string `json:"token"`
+	UserID    string `json:"userID"`
+	ExpiresAt int64  `json:"expiresAt"` // Unix timestamp in seconds.
+}
+
+// sessionHandler passes on the requests of the Sign-In-With-Ethereum flow, which read and set the session cookie
+func sessionHandler(
+	walletExt *walletextension.WalletExtension,
+	fun func(walletExt *walletextension.WalletExtension, resp http.ResponseWriter, req *http.Request),
+) func(resp http.ResponseWriter, req *http.Request) {
+	return func(resp http.ResponseWriter, req *http.Request) {
+		if walletExt.IsStopping() {
+			return
+		}
+		if httputil.EnableCORS(resp, req) {
+			return
+		}
+		fun(walletExt, resp, req)
+	}
+}
+
+// nonceRequestHandler issues the nonce to include in the Sign-In-With-Ethereum message
+func nonceRequestHandler(walletExt *walletextension.WalletExtension, resp http.ResponseWriter, _ *http.Request) {
+	nonce, err := walletExt.Sessions().NewNonce()
+	if err != nil {
+		walletExt.Logger().Error("could not issue nonce", log.ErrKey, err)
+		http.Error(resp, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	writeJSON(walletExt, resp, nonceResponse{Nonce: nonce})
+}
+
+// loginRequestHandler verifies the signed Sign-In-With-Ethereum message, and returns the token of the new session. The
+// token is also set as a cookie, for the clients that can't set the bearer token.
+func loginRequestHandler(walletExt *walletextension.WalletExtension, resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(resp, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var request signInRequest
+	if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+		http.Error(resp, fmt.Sprintf("could not unmarshal sign-in request: %s", err), http.StatusBadRequest)
+		return
+	}
+	signature, err := hex.DecodeString(strings.TrimPrefix(request.Signature, "0x"))
+	if err != nil {
+		http.Error(resp, fmt.Sprintf("could not decode signature: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	token, hexUserID, expiry, err := walletExt.Sessions().SignIn(request.Message, signature)
+	if err != nil {
+		walletExt.Logger().Info("could not sign in with Ethereum", log.ErrKey, err)
+		http.Error(resp, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	http.SetCookie(resp, sessionCookie(token, expiry))
+	writeJSON(walletExt, resp, signInResponse{Token: token, UserID: hexUserID, ExpiresAt: expiry.Unix()})
+}
+
+// logoutRequestHandler revokes the session the request is authenticated with
+func logoutRequestHandler(walletExt *walletextension.WalletExtension, resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(resp, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	token := sessionToken(req)
+	if token == "" {
+		http.Error(resp, "no session token in the request", http.StatusBadRequest)
+		return
+	}
+	if err := walletExt.Sessions().SignOut(token); err != nil {
+		walletExt.Logger().Error("could not revoke session", log.ErrKey, err)
+		http.Error(resp, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(resp, sessionCookie("", time.Unix(0, 0)))
+	writeJSON(walletExt, resp, common.SuccessMsg)
+}
+
+// authenticateSession resolves the user of the session token carried by the request, if any, and returns the request
+// with the user set as the `u` query parameter, which the handlers read the user from. The session takes precedence
+// over a `u` query parameter. If the session is invalid, an error is returned to the user and false is returned.
+// The session tokens are ignored if Sign-In-With-Ethereum is disabled.
+func authenticateSession(walletExt *walletextension.WalletExtension, resp http.ResponseWriter, req *http.Request) (*http.Request, bool) {
+	token := sessionToken(req)
+	if token == "" || walletExt.Sessions() == nil {
+		return req, true
+	}
+
+	hexUserID, err := walletExt.Sessions().UserID(token)
+	if err != nil {
+		if !errors.Is(err, session.ErrInvalidSession) {
+			walletExt.Logger().Error("could not get session", log.ErrKey, err)
+		}
+		http.Error(resp, err.Error(), http.StatusUnauthorized)
+		return nil, false
+	}
+
+	req = req.Clone(req.Context())
+	query := req.URL.Query()
+	query.Set(common.UserQueryParameter, hexUserID)
+	req.URL.RawQuery = query.Encode()
+	return req, true
+}
+
+// sessionToken returns the session token sent as a bearer token, or else as a cookie
+func sessionToken(req *http.Request) string {
+	if authorization := req.Header.Get("Authorization"); strings.HasPrefix(authorization, bearerPrefix) {
+		return strings.TrimPrefix(authorization, bearerPrefix)
+	}
+	if cookie, err := req.Cookie(common.SessionCookieName); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
+
+func sessionCookie(token string, expiry time.Time) *http.Cookie {
+	return &http.Cookie{
+		Name:     common.SessionCookieName,
+		Value:    token,
+		Path:     "/",
+		Expires:  expiry,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+	}
+}
@@ -8,6 +8,7 @@ const idStatus = "status";
 const idUserID = "userID";
 const obscuroGatewayVersion = "v1"
 const pathJoin = obscuroGatewayVersion + "/join/";
+const pathGetMessage = obscuroGatewayVersion + "/getmessage/";
 const pathAuthenticate = obscuroGatewayVersion + "/authenticate/";
 const pathQuery = obscuroGatewayVersion + "/query/";
 const pathRevoke = obscuroGatewayVersion + "/revoke/";
@@ -19,7 +20,7 @@ const jsonHeaders = {
     "Content-Type": "application/json"
 };
 const metamaskRequestAccounts = "eth_requestAccounts";
-const metamaskPersonalSign = "personal_sign";
+const metamaskSignTypedData = "eth_signTypedData_v4";
 
 function isValidUserIDFormat(value) {
     return typeof value === 'string' && value.length === 64;
@@ -64,17 +65,30 @@ async function authenticateAccountWithObscuroGateway(ethereum, account, userID)
         return "Account is already authenticated"
     }
 
-    const textToSign = "Register " + userID + " for " + account;
+    const getMessageResp = await fetch(
+        pathGetMessage+"?u="+userID, {
+            method: methodGet,
+            headers: jsonHeaders,
+        }
+    );
+    const typedData = JSON.parse(await getMessageResp.text());
+    // MetaMask expects the chain ID of the domain as a number
+    typedData.domain.chainId = parseInt(typedData.domain.chainId);
+
     const signature = await ethereum.request({
-        method: metamaskPersonalSign,
-        params: [textToSign, account]
+        method: metamaskSignTypedData,
+        params: [account, JSON.stringify(typedData)]
     }).catch(_ => { return -1 })
     if (signature === -1) {
         return "Signing failed"
     }
 
     const authenticateUserURL = pathAuthenticate+"?u="+userID
-    const authenticateFields = {"signature": signature, "message": textToSign}
+    const authenticateFields = {
+        "signature": signature,
+        "issuedAt": typedData.message.issuedAt,
+        "expiry": typedData.message.expiry
+    }
     const authenticateResp = await fetch(
         authenticateUserURL, {
             method: methodPost,
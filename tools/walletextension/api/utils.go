@@ -1,9 +1,11 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
+	"github.com/go-kit/kit/transport/http/jsonrpc"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/accountmanager"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
 )
@@ -38,6 +40,30 @@ func parseRequest(body []byte) (*accountmanager.RPCRequest, error) {
 	}, nil
 }
 
+// isBatchRequest returns whether the body holds a JSON-RPC batch, i.e. an array of requests
+func isBatchRequest(body []byte) bool {
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '['
+}
+
+// parseRequestID extracts the id of a request that could not be parsed, so the error response can still be matched to it.
+// Returns nil (serialised as a JSON null) if the id cannot be found.
+func parseRequestID(body []byte) json.RawMessage {
+	var reqJSONMap map[string]json.RawMessage
+	if err := json.Unmarshal(body, &reqJSONMap); err != nil {
+		return nil
+	}
+	return reqJSONMap[common.JSONKeyID]
+}
+
+// errorResponse crafts the error response to the request with the given id
+func errorResponse(id json.RawMessage, err error) map[string]interface{} {
+	response := common.CraftErrorResponse(err)
+	response[common.JSONKeyRPCVersion] = jsonrpc.Version
+	response[common.JSONKeyID] = id
+	return response
+}
+
 func getQueryParameter(params map[string]string, selectedParameter string) (string, error) {
 	value, exists := params[selectedParameter]
 	if !exists {
@@ -63,7 +63,8 @@ func GetUserIDbyte(userID string) ([]byte, error) {
 	return hex.DecodeString(userID)
 }
 
-func CreateEncClient(hostRPCBindAddr string, addressBytes []byte, privateKeyBytes []byte, signature []byte) (*rpc.EncRPCClient, error) {
+// CreateEncClient returns a client encrypting the requests of the account with its viewing key, and sending them through the given client
+func CreateEncClient(client rpc.Client, addressBytes []byte, privateKeyBytes []byte, signature []byte) (*rpc.EncRPCClient, error) {
 	privateKey, err := BytesToPrivateKey(privateKeyBytes)
 	if err != nil {
 		return nil, fmt.Errorf("unable to convert bytes to ecies private key: %w", err)
@@ -77,7 +78,7 @@ func CreateEncClient(hostRPCBindAddr string, addressBytes []byte, privateKeyByte
 		PublicKey:  PrivateKeyToCompressedPubKey(privateKey),
 		Signature:  signature,
 	}
-	encClient, err := rpc.NewEncNetworkClient(hostRPCBindAddr, vk, nil)
+	encClient, err := rpc.NewEncRPCClient(client, vk, nil)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create EncRPCClient: %w", err)
 	}
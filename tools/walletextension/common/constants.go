@@ -33,11 +33,22 @@ const (
 	PathGenerateViewingKey              = "/generateviewingkey/"
 	PathSubmitViewingKey                = "/submitviewingkey/"
 	PathJoin                            = "/join/"
+	PathGetMessage                      = "/getmessage/"
 	PathAuthenticate                    = "/authenticate/"
 	PathQuery                           = "/query/"
 	PathRevoke                          = "/revoke/"
 	PathObscuroGateway                  = "/"
 	PathHealth                          = "/health/"
+	PathAdminLimits                     = "/admin/limits/"
+	PathAdminUsers                      = "/admin/users/"
+	PathAdminAccounts                   = "/admin/accounts/"
+	PathAdminMethods                    = "/admin/methods/"
+	PathAdminSubscriptions              = "/admin/subscriptions/"
+	PathMetrics                         = "/metrics"
+	PathSIWENonce                       = "/siwe/nonce/"
+	PathSIWELogin                       = "/siwe/login/"
+	PathSIWELogout                      = "/siwe/logout/"
+	SessionCookieName                   = "obscuro_session"
 	WSProtocol                          = "ws://"
 	DefaultUser                         = "defaultUser"
 	UserQueryParameter                  = "u"
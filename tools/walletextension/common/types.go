@@ -9,3 +9,22 @@ type UserDB struct {
 	UserID     []byte
 	PrivateKey []byte
 }
+
+// UserAccountsDB is a user with the addresses of their accounts
+type UserAccountsDB struct {
+	UserID           []byte
+	AccountAddresses [][]byte
+}
+
+// UserLimitsDB holds the rate limits set for a user by an admin, overriding the default ones
+type UserLimitsDB struct {
+	ComputeUnitsPerSecond float64
+	Burst                 uint64
+	DailyQuota            uint64
+}
+
+// SessionDB is a session of a user who signed in with Ethereum. The expiry is a unix timestamp in seconds.
+type SessionDB struct {
+	UserID []byte
+	Expiry int64
+}
@@ -1,15 +1,41 @@
 package config
 
+import "time"
+
 // Config contains the configuration required by the WalletExtension.
 type Config struct {
-	WalletExtensionHost     string
-	WalletExtensionPortHTTP int
-	WalletExtensionPortWS   int
-	NodeRPCHTTPAddress      string
-	NodeRPCWebsocketAddress string
-	LogPath                 string
-	DBPathOverride          string // Overrides the database file location. Used in tests.
-	VerboseFlag             bool
-	DBType                  string
-	DBConnectionURL         string
+	WalletExtensionHost        string
+	WalletExtensionPortHTTP    int
+	WalletExtensionPortWS      int
+	WalletExtensionHostAdmin   string // The host of the admin listener, which is separate from the public one.
+	WalletExtensionPortAdmin   int    // The port of the admin listener. Zero disables it.
+	NodeRPCHTTPAddresses       []string
+	NodeRPCWebsocketAddresses  []string // The requests are spread over these hosts, failing over between them.
+	LogPath                    string
+	DBPathOverride             string // Overrides the database file location. Used in tests.
+	VerboseFlag                bool
+	DBType                     string
+	DBConnectionURL            string
+	MasterKeyPath              string            // The file holding the key encrypting the users' private keys at rest. Overridden by the OBSCURO_GATEWAY_MASTER_KEY env variable.
+	GenerateMasterKey          bool              // Whether the master key file is generated if it doesn't exist. For development only.
+	MaxHydratedUsers           int               // The maximum number of users whose clients are kept in memory. Zero disables the limit.
+	UserIdleTimeout            time.Duration     // The clients of the users that are idle for longer are stopped. Zero disables the eviction.
+	MaxBatchSize               int               // The maximum number of requests accepted in a single JSON-RPC batch. Zero disables the limit.
+	HostHealthCheckInterval    time.Duration     // How often the health and latency of the hosts are checked. Zero disables the health checks.
+	HostFailureThreshold       int               // The number of consecutive failures after which a host is no longer selected.
+	HostCircuitBreakerCooldown time.Duration     // How long a failing host is not selected for.
+	UserRateLimit              float64           // The compute units per second a user can use. Zero disables the limit.
+	UserRateLimitBurst         uint64            // The compute units a user can use at once.
+	UserDailyQuota             uint64            // The compute units a user can use per day. Zero disables the quota.
+	IPRateLimit                float64           // The compute units per second an IP can use. Zero disables the limit.
+	IPRateLimitBurst           uint64            // The compute units an IP can use at once.
+	MethodWeights              map[string]uint64 // The compute units charged per request of the methods, overriding the default weights.
+	AdminAPIKey                string            // The key authenticating the admin requests. Empty disables the admin listener.
+	ObscuroChainID             int64             // The chain ID the EIP-712 signatures of the viewing keys are bound to.
+	ViewingKeyValidity         time.Duration     // How long the EIP-712 signatures of the viewing keys are valid for.
+	AllowLegacySignatures      bool              // Whether viewing keys signed as personal-sign text rather than EIP-712 typed data are accepted.
+	SubscriptionBufferSize     int               // The maximum number of logs buffered per subscription while its websocket is disconnected.
+	SubscriptionGracePeriod    time.Duration     // How long a subscription can be resumed for after its websocket is disconnected. Zero disables resuming subscriptions.
+	SIWEDomain                 string            // The domain the Sign-In-With-Ethereum messages must be addressed to. If empty, Sign-In-With-Ethereum is disabled.
+	SessionTTL                 time.Duration     // How long the sessions of the users who signed in with Ethereum are valid for.
 }
@@ -6,14 +6,18 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/common/stopcontrol"
-	"github.com/obscuronet/go-obscuro/go/rpc"
 	"github.com/obscuronet/go-obscuro/tools/walletextension"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/accountmanager"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/api"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/config"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/hostpool"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/metrics"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/ratelimiter"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/session"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/storage"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/storage/encryption"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/useraccountmanager"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
@@ -21,108 +25,172 @@ import (
 )
 
 type WalletExtensionContainer struct {
-	hostAddr           string
+	hostPool           *hostpool.Pool
 	userAccountManager *useraccountmanager.UserAccountManager
+	rateLimiter        *ratelimiter.RateLimiter
 	storage            storage.Storage
 	stopControl        *stopcontrol.StopControl
 	logger             gethlog.Logger
 	walletExt          *walletextension.WalletExtension
 	httpServer         *api.Server
 	wsServer           *api.Server
+	adminServer        *api.Server // Nil if the admin listener is disabled
 }
 
 func NewWalletExtensionContainerFromConfig(config config.Config, logger gethlog.Logger) *WalletExtensionContainer {
-	// create the account manager with a single unauthenticated connection
-	hostRPCBindAddr := wecommon.WSProtocol + config.NodeRPCWebsocketAddress
-	unAuthedClient, err := rpc.NewNetworkClient(hostRPCBindAddr)
+	// the requests are spread over the hosts, failing over between them
+	hostRPCBindAddrs := make([]string, len(config.NodeRPCWebsocketAddresses))
+	for i, address := range config.NodeRPCWebsocketAddresses {
+		hostRPCBindAddrs[i] = wecommon.WSProtocol + address
+	}
+	hostPool, err := hostpool.New(hostRPCBindAddrs, config.HostHealthCheckInterval, config.HostFailureThreshold, config.HostCircuitBreakerCooldown, logger)
 	if err != nil {
-		logger.Crit("unable to create temporary client for request ", log.ErrKey, err)
+		logger.Crit("unable to create the pool of hosts ", log.ErrKey, err)
 	}
 
-	userAccountManager := useraccountmanager.NewUserAccountManager(unAuthedClient, logger)
+	// create the account manager with a single unauthenticated connection
+	unAuthedClient := hostPool.NewClient("")
+
+	// load the key encrypting the private keys stored in the database
+	masterKey, err := encryption.LoadMasterKey(config.MasterKeyPath, config.GenerateMasterKey)
+	if err != nil {
+		logger.Crit("unable to load the master key of the database ", log.ErrKey, err)
+	}
 
 	// start the database
-	databaseStorage, err := storage.New(config.DBType, config.DBConnectionURL, config.DBPathOverride)
+	databaseStorage, err := storage.New(config.DBType, config.DBConnectionURL, config.DBPathOverride, masterKey)
 	if err != nil {
 		logger.Crit("unable to create database to store viewing keys ", log.ErrKey, err)
 	}
 
-	// Get all the data from the database and add all the clients for all users
-	// todo (@ziga) - implement lazy loading for clients to reduce number of connections and speed up loading
+	// the metrics are only recorded if they can be read through the admin listener
+	adminEnabled := config.AdminAPIKey != "" && config.WalletExtensionPortAdmin != 0
+	gatewayMetrics := metrics.New(adminEnabled)
 
-	// add default user (when no UserID is provided in the query parameter - for WE endpoints)
-	userAccountManager.AddAndReturnAccountManager(hex.EncodeToString([]byte(wecommon.DefaultUser)))
+	// the account managers of the users are loaded from the database on first use
+	userAccountManager := useraccountmanager.NewUserAccountManager(
+		unAuthedClient,
+		databaseStorage,
+		hostPool,
+		config.MaxHydratedUsers,
+		config.UserIdleTimeout,
+		accountmanager.SubscriptionConfig{
+			BufferSize:  config.SubscriptionBufferSize,
+			GracePeriod: config.SubscriptionGracePeriod,
+		},
+		gatewayMetrics.Registry(),
+		logger,
+	)
 
-	// get all users and their private keys from the database
-	allUsers, err := databaseStorage.GetAllUsers()
+	// add default user (when no UserID is provided in the query parameter - for WE endpoints)
+	// it is kept in memory, as its clients are created with viewing keys that are not all stored in the database
+	_, err = userAccountManager.PinAccountManager(hex.EncodeToString([]byte(wecommon.DefaultUser)))
 	if err != nil {
-		logger.Error(fmt.Errorf("error getting all users from database, %w", err).Error())
+		logger.Crit("unable to load the default user ", log.ErrKey, err)
 	}
 
-	// iterate over users create accountManagers and add all accounts to them per user
-	for _, user := range allUsers {
-		currentUserAccountManager := userAccountManager.AddAndReturnAccountManager(hex.EncodeToString(user.UserID))
+	// the requests are throttled per user and per IP, and the daily usage of the users is persisted
+	rateLimiter := ratelimiter.New(
+		ratelimiter.Limits{
+			ComputeUnitsPerSecond: config.UserRateLimit,
+			Burst:                 config.UserRateLimitBurst,
+			DailyQuota:            config.UserDailyQuota,
+		},
+		ratelimiter.Limits{
+			ComputeUnitsPerSecond: config.IPRateLimit,
+			Burst:                 config.IPRateLimitBurst,
+		},
+		config.MethodWeights,
+		databaseStorage,
+		logger,
+	)
 
-		accounts, err := databaseStorage.GetAccounts(user.UserID)
-		if err != nil {
-			logger.Error(fmt.Errorf("error getting accounts for user: %s, %w", hex.EncodeToString(user.UserID), err).Error())
-		}
-		for _, account := range accounts {
-			encClient, err := wecommon.CreateEncClient(hostRPCBindAddr, account.AccountAddress, user.PrivateKey, account.Signature)
-			if err != nil {
-				logger.Error(fmt.Errorf("error creating new client, %w", err).Error())
-			}
-
-			// add client to current userAccountManager
-			currentUserAccountManager.AddClient(common.BytesToAddress(account.AccountAddress), encClient)
-		}
+	// the domain the Sign-In-With-Ethereum messages are addressed to can't be taken from the requests, as their host
+	// is set by the client
+	var sessions *session.Manager
+	if config.SIWEDomain != "" {
+		sessions = session.NewManager(databaseStorage, config.SIWEDomain, config.ObscuroChainID, config.SessionTTL, logger)
 	}
 
 	stopControl := stopcontrol.New()
-	walletExt := walletextension.New(hostRPCBindAddr, &userAccountManager, databaseStorage, stopControl, logger)
+	walletExt := walletextension.New(
+		hostPool,
+		userAccountManager,
+		databaseStorage,
+		stopControl,
+		config.MaxBatchSize,
+		rateLimiter,
+		config.AdminAPIKey,
+		config.ObscuroChainID,
+		config.ViewingKeyValidity,
+		config.AllowLegacySignatures,
+		gatewayMetrics,
+		sessions,
+		logger,
+	)
 	httpRoutes := api.NewHTTPRoutes(walletExt)
 	httpServer := api.NewHTTPServer(fmt.Sprintf("%s:%d", config.WalletExtensionHost, config.WalletExtensionPortHTTP), httpRoutes)
 
 	wsRoutes := api.NewWSRoutes(walletExt)
 	wsServer := api.NewWSServer(fmt.Sprintf("%s:%d", config.WalletExtensionHost, config.WalletExtensionPortWS), wsRoutes)
+
+	var adminServer *api.Server
+	if adminEnabled {
+		adminRoutes := api.NewAdminRoutes(walletExt)
+		adminServer = api.NewAdminServer(fmt.Sprintf("%s:%d", config.WalletExtensionHostAdmin, config.WalletExtensionPortAdmin), adminRoutes)
+	}
 	return NewWalletExtensionContainer(
-		hostRPCBindAddr,
+		hostPool,
 		walletExt,
-		&userAccountManager,
+		userAccountManager,
+		rateLimiter,
 		databaseStorage,
 		stopControl,
 		httpServer,
 		wsServer,
+		adminServer,
 		logger,
 	)
 }
 
 func NewWalletExtensionContainer(
-	hostAddr string,
+	hostPool *hostpool.Pool,
 	walletExt *walletextension.WalletExtension,
 	userAccountManager *useraccountmanager.UserAccountManager,
+	rateLimiter *ratelimiter.RateLimiter,
 	storage storage.Storage,
 	stopControl *stopcontrol.StopControl,
 	httpServer *api.Server,
 	wsServer *api.Server,
+	adminServer *api.Server,
 	logger gethlog.Logger,
 ) *WalletExtensionContainer {
 	return &WalletExtensionContainer{
-		hostAddr:           hostAddr,
+		hostPool:           hostPool,
 		walletExt:          walletExt,
 		userAccountManager: userAccountManager,
+		rateLimiter:        rateLimiter,
 		storage:            storage,
 		stopControl:        stopControl,
 		httpServer:         httpServer,
 		wsServer:           wsServer,
+		adminServer:        adminServer,
 		logger:             logger,
 	}
 }
 
 // TODO Start should not be a locking process
 func (w *WalletExtensionContainer) Start() error {
+	w.hostPool.Start()
+	w.userAccountManager.Start()
+	w.rateLimiter.Start()
 	httpErrChan := w.httpServer.Start()
 	wsErrChan := w.wsServer.Start()
+	// the admin errors are never received if the admin listener is disabled
+	var adminErrChan chan error
+	if w.adminServer != nil {
+		adminErrChan = w.adminServer.Start()
+	}
 
 	select {
 	case err := <-httpErrChan:
@@ -133,6 +201,10 @@ func (w *WalletExtensionContainer) Start() error {
 		if !errors.Is(err, http.ErrServerClosed) {
 			panic(err)
 		}
+	case err := <-adminErrChan:
+		if !errors.Is(err, http.ErrServerClosed) {
+			panic(err)
+		}
 	}
 	return nil
 }
@@ -150,6 +222,17 @@ func (w *WalletExtensionContainer) Stop() error {
 		w.logger.Warn("could not shut down wallet extension", log.ErrKey, err)
 	}
 
+	if w.adminServer != nil {
+		err = w.adminServer.Stop()
+		if err != nil {
+			w.logger.Warn("could not shut down wallet extension admin listener", log.ErrKey, err)
+		}
+	}
+
+	w.userAccountManager.Stop()
+	w.hostPool.Stop()
+	w.rateLimiter.Stop()
+
 	// todo (@pedro) correctly surface shutdown errors
 	return nil
 }
@@ -16,6 +16,8 @@ nodePortHTTP=80
 nodePortWS=81
 logPath="wallet_extension_logs.txt"
 databasePath=".obscuro/gateway_database.db"
+# the master key is generated next to the database if it doesn't exist, which is only suitable for development
+masterKeyPath=".obscuro/gateway_master_key"
 image="obscuronet/obscuro_gateway_testnet:latest"
 
 # Parse the options
@@ -33,6 +35,7 @@ do
             --nodePortWS)      nodePortWS=${value} ;;
             --logPath)         logPath=${value} ;;
             --databasePath)    databasePath=${value} ;;
+            --masterKeyPath)   masterKeyPath=${value} ;;
             --image)           image=${value} ;;
             *)
     esac
@@ -49,4 +52,5 @@ docker run -p 3000:"${port}" --name=obscuro_gateway_testnet \
     --entrypoint ./wallet_extension_linux \
       "${image}" \
       -host="${host}" -port="${port}" -portWS="${portWS}" -nodeHost="${nodeHost}" -nodePortHTTP="${nodePortHTTP}" \
-      -nodePortWS="${nodePortWS}" -logPath="${logPath}" -databasePath="${databasePath}"
\ No newline at end of file
+      -nodePortWS="${nodePortWS}" -logPath="${logPath}" -databasePath="${databasePath}" \
+      -masterKeyPath="${masterKeyPath}" -generateMasterKey=true
\ No newline at end of file
@@ -0,0 +1,141 @@
+package hostpool
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+
+	"github.com/gorilla/websocket"
+	"github.com/obscuronet/go-obscuro/go/rpc"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// failoverClient is an rpc.Client sending the requests of a user to the host the Pool selected for them. A request
+// failing because the host can't be reached is retried once on another host.
+type failoverClient struct {
+	pool    *Pool
+	userID  string
+	address string     // The address of the host the client is connected to
+	client  rpc.Client // The client connected to the host. Nil until the first request
+	stopped bool
+	mutex   sync.Mutex
+}
+
+func (c *failoverClient) Call(result interface{}, method string, args ...interface{}) error {
+	return c.CallContext(context.Background(), result, method, args...)
+}
+
+func (c *failoverClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return c.withFailover(func(client rpc.Client) error {
+		return client.CallContext(ctx, result, method, args...)
+	})
+}
+
+func (c *failoverClient) Subscribe(ctx context.Context, result interface{}, namespace string, channel interface{}, args ...interface{}) (*gethrpc.ClientSubscription, error) {
+	var subscription *gethrpc.ClientSubscription
+	err := c.withFailover(func(client rpc.Client) error {
+		var err error
+		subscription, err = client.Subscribe(ctx, result, namespace, channel, args...)
+		return err
+	})
+	return subscription, err
+}
+
+func (c *failoverClient) Stop() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.stopped = true
+	if c.client != nil {
+		c.client.Stop()
+		c.client = nil
+	}
+}
+
+// withFailover executes the request on the host selected for the user, and retries it on another host if the selected
+// one can't be reached
+func (c *failoverClient) withFailover(request func(client rpc.Client) error) error {
+	client, address, err := c.connect(c.pool.Select(c.userID))
+	if errors.Is(err, gethrpc.ErrClientQuit) {
+		return err
+	}
+	if err == nil {
+		err = request(client)
+		if !isConnectionError(err) {
+			c.pool.ReportSuccess(address)
+			return err
+		}
+	}
+
+	failoverAddress := c.pool.Failover(c.userID, address)
+	if failoverAddress == address {
+		// there is no other host to try
+		return err
+	}
+	client, address, err = c.connect(failoverAddress)
+	if err != nil {
+		c.pool.ReportFailure(address)
+		return err
+	}
+	err = request(client)
+	if isConnectionError(err) {
+		c.pool.ReportFailure(address)
+	} else {
+		c.pool.ReportSuccess(address)
+	}
+	return err
+}
+
+// connect returns a client connected to the given host, replacing the client connected to the previous host. The
+// subscriptions made with the previous client are ended, so they can be recreated on the new host.
+func (c *failoverClient) connect(address string) (rpc.Client, string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.stopped {
+		return nil, address, gethrpc.ErrClientQuit
+	}
+	if c.client != nil && c.address == address {
+		return c.client, address, nil
+	}
+	if c.client != nil {
+		c.client.Stop()
+		c.client = nil
+	}
+	client, err := rpc.NewNetworkClient(address)
+	if err != nil {
+		return nil, address, err
+	}
+	c.client = client
+	c.address = address
+	return client, address, nil
+}
+
+// isConnectionError returns whether the error means that the host could not be reached, as opposed to the host
+// responding with an error
+func isConnectionError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	var httpErr gethrpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= http.StatusInternalServerError
+	}
+	var closeErr *websocket.CloseError
+	if errors.As(err, &closeErr) {
+		return true
+	}
+	return errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET)
+}
@@ -0,0 +1,317 @@
+package hostpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/rpc"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	hostcommon "github.com/obscuronet/go-obscuro/go/common/host"
+)
+
+const (
+	healthCheckTimeout = 5 * time.Second
+	// the weight of the latest health check round trip in the latency average of a host
+	latencySmoothingFactor = 0.3
+	// the routing of the least recently seen users is forgotten above this number of users
+	maxStickyAssignments = 100_000
+)
+
+// Pool spreads the users of the gateway over several Obscuro hosts.
+//
+// The hosts are health-checked periodically with `obscuro_health`, and each user is routed to the healthy host with the
+// lowest latency. A user keeps being routed to the same host for as long as it is available, so their reads (e.g. of the
+// account nonce) are consistent. A host failing several times in a row is no longer selected (its circuit is opened)
+// until the cooldown has elapsed. After the cooldown, the host is selected again and a single failure reopens its circuit.
+// It is safe for concurrent use.
+type Pool struct {
+	endpoints           []*endpoint
+	assignments         lru.BasicLRU[string, *endpoint] // The host each user is routed to
+	mutex               sync.Mutex
+	healthCheckInterval time.Duration
+	failureThreshold    int
+	cooldown            time.Duration
+	stopCh              chan struct{}
+	stopOnce            sync.Once
+	logger              gethlog.Logger
+}
+
+type endpoint struct {
+	address             string
+	healthClient        rpc.Client    // Only used by the health checks
+	latency             time.Duration // The average round trip of the health checks. Zero until the first successful check
+	consecutiveFailures int
+	openUntil           time.Time // The host is not selected until then. Zero if the circuit is closed
+}
+
+// New returns a Pool of the hosts reachable at the given RPC addresses. The first address is preferred until the
+// latencies of the hosts are known.
+func New(addresses []string, healthCheckInterval time.Duration, failureThreshold int, cooldown time.Duration, logger gethlog.Logger) (*Pool, error) {
+	if len(addresses) == 0 {
+		return nil, errors.New("at least one host address is required")
+	}
+	if failureThreshold < 1 {
+		failureThreshold = 1
+	}
+
+	endpoints := make([]*endpoint, len(addresses))
+	for i, address := range addresses {
+		endpoints[i] = &endpoint{address: address}
+	}
+	return &Pool{
+		endpoints:           endpoints,
+		assignments:         lru.NewBasicLRU[string, *endpoint](maxStickyAssignments),
+		healthCheckInterval: healthCheckInterval,
+		failureThreshold:    failureThreshold,
+		cooldown:            cooldown,
+		stopCh:              make(chan struct{}),
+		logger:              logger,
+	}, nil
+}
+
+// Start periodically checks the health and the latency of the hosts. A zero interval disables the health checks, in
+// which case the hosts are only judged on the outcome of the requests sent to them.
+func (p *Pool) Start() {
+	if p.healthCheckInterval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(p.healthCheckInterval)
+		defer ticker.Stop()
+		for {
+			p.checkHealth()
+			select {
+			case <-ticker.C:
+			case <-p.stopCh:
+				for _, e := range p.endpoints {
+					if e.healthClient != nil {
+						e.healthClient.Stop()
+					}
+				}
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the health checks
+func (p *Pool) Stop() {
+	p.stopOnce.Do(func() {
+		close(p.stopCh)
+	})
+}
+
+// NewClient returns a client sending the requests of the user to the host selected for them, and failing over to another
+// host if that one can't be reached.
+func (p *Pool) NewClient(userID string) rpc.Client {
+	return &failoverClient{pool: p, userID: userID}
+}
+
+// Addresses returns the addresses of the hosts in the pool
+func (p *Pool) Addresses() []string {
+	addresses := make([]string, len(p.endpoints))
+	for i, e := range p.endpoints {
+		addresses[i] = e.address
+	}
+	return addresses
+}
+
+// Select returns the address of the host the requests of the user are sent to
+func (p *Pool) Select(userID string) string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	now := time.Now()
+	if assigned, found := p.assignments.Get(userID); found && assigned.isAvailable(now) {
+		return assigned.address
+	}
+	selected := p.best(now, nil)
+	p.assignments.Add(userID, selected)
+	return selected.address
+}
+
+// Failover records the failure of the given host, and routes the user to another host, whose address is returned.
+// The given host is only returned if it is the only host of the pool.
+func (p *Pool) Failover(userID string, failedAddress string) string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	failed := p.endpoint(failedAddress)
+	if failed != nil {
+		p.recordFailure(failed, time.Now())
+	}
+	if assigned, found := p.assignments.Peek(userID); found && assigned != failed {
+		// another request of the user failed over already
+		return assigned.address
+	}
+	selected := p.best(time.Now(), failed)
+	p.assignments.Add(userID, selected)
+	if selected != failed {
+		p.logger.Info("Failing over to another host.", "userID", userID, "from", failedAddress, "to", selected.address)
+	}
+	return selected.address
+}
+
+// ReportSuccess records that the given host served a request
+func (p *Pool) ReportSuccess(address string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if e := p.endpoint(address); e != nil {
+		p.recordSuccess(e, 0)
+	}
+}
+
+// ReportFailure records that the given host could not be reached
+func (p *Pool) ReportFailure(address string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if e := p.endpoint(address); e != nil {
+		p.recordFailure(e, time.Now())
+	}
+}
+
+// best returns the available host with the lowest latency, other than the excluded one. If no host is available, the one
+// whose circuit closes first is returned.
+func (p *Pool) best(now time.Time, excluded *endpoint) *endpoint {
+	var selected *endpoint
+	for _, e := range p.endpoints {
+		if e == excluded || !e.isAvailable(now) {
+			continue
+		}
+		if selected == nil || e.hasLowerLatencyThan(selected) {
+			selected = e
+		}
+	}
+	if selected != nil {
+		return selected
+	}
+
+	for _, e := range p.endpoints {
+		if e == excluded {
+			continue
+		}
+		if selected == nil || e.openUntil.Before(selected.openUntil) {
+			selected = e
+		}
+	}
+	if selected != nil {
+		return selected
+	}
+	return excluded
+}
+
+func (p *Pool) endpoint(address string) *endpoint {
+	for _, e := range p.endpoints {
+		if e.address == address {
+			return e
+		}
+	}
+	return nil
+}
+
+func (p *Pool) recordSuccess(e *endpoint, latency time.Duration) {
+	if !e.openUntil.IsZero() {
+		p.logger.Info("Host recovered, closing its circuit.", "host", e.address)
+	}
+	e.consecutiveFailures = 0
+	e.openUntil = time.Time{}
+	if latency <= 0 {
+		return
+	}
+	if e.latency == 0 {
+		e.latency = latency
+		return
+	}
+	e.latency = time.Duration(latencySmoothingFactor*float64(latency) + (1-latencySmoothingFactor)*float64(e.latency))
+}
+
+func (p *Pool) recordFailure(e *endpoint, now time.Time) {
+	e.consecutiveFailures++
+	halfOpen := !e.openUntil.IsZero()
+	if !halfOpen && e.consecutiveFailures < p.failureThreshold {
+		return
+	}
+	if halfOpen && now.Before(e.openUntil) {
+		// the circuit is open already
+		return
+	}
+	e.openUntil = now.Add(p.cooldown)
+	p.logger.Warn("Host is failing, opening its circuit.", "host", e.address, "failures", e.consecutiveFailures, "until", e.openUntil)
+}
+
+// checkHealth calls `obscuro_health` on all the hosts concurrently
+func (p *Pool) checkHealth() {
+	var wg sync.WaitGroup
+	for _, e := range p.endpoints {
+		wg.Add(1)
+		go func(e *endpoint) {
+			defer wg.Done()
+			latency, err := p.checkEndpointHealth(e)
+
+			p.mutex.Lock()
+			defer p.mutex.Unlock()
+			if err != nil {
+				p.logger.Debug("Host health check failed.", "host", e.address, log.ErrKey, err)
+				p.recordFailure(e, time.Now())
+				return
+			}
+			p.recordSuccess(e, latency)
+		}(e)
+	}
+	wg.Wait()
+}
+
+func (p *Pool) checkEndpointHealth(e *endpoint) (time.Duration, error) {
+	if e.healthClient == nil {
+		client, err := rpc.NewNetworkClient(e.address)
+		if err != nil {
+			return 0, err
+		}
+		e.healthClient = client
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+	start := time.Now()
+	var health *hostcommon.HealthCheck
+	err := e.healthClient.CallContext(ctx, &health, rpc.Health)
+	latency := time.Since(start)
+	if err != nil {
+		if isConnectionError(err) {
+			// the client is recreated, in case it can't reconnect on its own
+			e.healthClient.Stop()
+			e.healthClient = nil
+		}
+		return 0, err
+	}
+	if health == nil || !health.OverallHealth {
+		var healthErrs []string
+		if health != nil {
+			healthErrs = health.Errors
+		}
+		return 0, fmt.Errorf("host is not healthy: %s", strings.Join(healthErrs, ", "))
+	}
+	return latency, nil
+}
+
+func (e *endpoint) isAvailable(now time.Time) bool {
+	return e.openUntil.IsZero() || !now.Before(e.openUntil)
+}
+
+// hasLowerLatencyThan treats hosts whose latency is unknown as the slowest
+func (e *endpoint) hasLowerLatencyThan(other *endpoint) bool {
+	if e.latency == 0 {
+		return false
+	}
+	return other.latency == 0 || e.latency < other.latency
+}
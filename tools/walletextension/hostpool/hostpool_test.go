@@ -0,0 +1,145 @@
+package hostpool
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	hostcommon "github.com/obscuronet/go-obscuro/go/common/host"
+)
+
+const cooldown = 200 * time.Millisecond
+
+// testHostAPI serves the `obscuro_health` and `obscuro_name` methods of a fake host
+type testHostAPI struct {
+	name    string
+	healthy bool
+	delay   time.Duration
+}
+
+func (api *testHostAPI) Health() *hostcommon.HealthCheck {
+	time.Sleep(api.delay)
+	return &hostcommon.HealthCheck{OverallHealth: api.healthy}
+}
+
+func (api *testHostAPI) Name() string {
+	return api.name
+}
+
+func startTestHost(t *testing.T, api *testHostAPI) *httptest.Server {
+	server := gethrpc.NewServer()
+	require.NoError(t, server.RegisterName("obscuro", api))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	return httpServer
+}
+
+func newTestPool(t *testing.T, addresses ...string) *Pool {
+	pool, err := New(addresses, 0, 2, cooldown, log.New())
+	require.NoError(t, err)
+	return pool
+}
+
+func TestSelectsTheHealthyHostWithTheLowestLatency(t *testing.T) {
+	slowHost := startTestHost(t, &testHostAPI{healthy: true, delay: 50 * time.Millisecond})
+	fastHost := startTestHost(t, &testHostAPI{healthy: true})
+	unhealthyHost := startTestHost(t, &testHostAPI{healthy: false})
+
+	pool := newTestPool(t, slowHost.URL, unhealthyHost.URL, fastHost.URL)
+	// the first host is selected until the latencies are known
+	require.Equal(t, slowHost.URL, pool.Select("user1"))
+
+	pool.checkHealth()
+	pool.checkHealth()
+	require.Equal(t, fastHost.URL, pool.Select("user2"))
+}
+
+func TestUsersStickToTheirHost(t *testing.T) {
+	pool := newTestPool(t, "http://host1", "http://host2")
+	require.Equal(t, "http://host1", pool.Select("user1"))
+
+	// host2 becoming faster does not move the user that is already routed to host1
+	pool.endpoints[0].latency = time.Second
+	pool.endpoints[1].latency = time.Millisecond
+	require.Equal(t, "http://host1", pool.Select("user1"))
+	require.Equal(t, "http://host2", pool.Select("user2"))
+}
+
+func TestFailingHostsAreNotSelectedUntilTheCooldownHasElapsed(t *testing.T) {
+	pool := newTestPool(t, "http://host1", "http://host2")
+	require.Equal(t, "http://host1", pool.Select("user1"))
+
+	// a single failure does not open the circuit
+	pool.ReportFailure("http://host1")
+	require.Equal(t, "http://host1", pool.Select("user1"))
+
+	pool.ReportFailure("http://host1")
+	require.Equal(t, "http://host2", pool.Select("user1"))
+	require.Equal(t, "http://host2", pool.Select("user2"))
+
+	// after the cooldown the host is selected again, and a single failure reopens its circuit
+	time.Sleep(cooldown)
+	require.Equal(t, "http://host1", pool.Select("user3"))
+	pool.ReportFailure("http://host1")
+	require.Equal(t, "http://host2", pool.Select("user3"))
+
+	// a success closes the circuit
+	time.Sleep(cooldown)
+	pool.ReportSuccess("http://host1")
+	pool.ReportFailure("http://host1")
+	require.Equal(t, "http://host1", pool.Select("user4"))
+}
+
+func TestHealthChecksOpenAndCloseTheCircuit(t *testing.T) {
+	api := &testHostAPI{healthy: false}
+	host := startTestHost(t, api)
+	otherHost := startTestHost(t, &testHostAPI{healthy: true})
+
+	pool := newTestPool(t, host.URL, otherHost.URL)
+	pool.checkHealth()
+	pool.checkHealth()
+	require.Equal(t, otherHost.URL, pool.Select("user1"))
+
+	api.healthy = true
+	pool.checkHealth()
+	require.Equal(t, host.URL, pool.Select("user2"))
+}
+
+func TestClientFailsOverToAnotherHost(t *testing.T) {
+	host1 := startTestHost(t, &testHostAPI{name: "host1", healthy: true})
+	host2 := startTestHost(t, &testHostAPI{name: "host2", healthy: true})
+
+	pool := newTestPool(t, host1.URL, host2.URL)
+	client := pool.NewClient("user1")
+	defer client.Stop()
+
+	var name string
+	require.NoError(t, client.Call(&name, "obscuro_name"))
+	require.Equal(t, "host1", name)
+
+	// the request is retried on the other host, where the user keeps being routed
+	host1.Close()
+	require.NoError(t, client.Call(&name, "obscuro_name"))
+	require.Equal(t, "host2", name)
+	require.Equal(t, host2.URL, pool.Select("user1"))
+
+	// errors returned by the host are not retried
+	err := client.Call(&name, "obscuro_unknownMethod")
+	var rpcErr gethrpc.Error
+	require.True(t, errors.As(err, &rpcErr))
+	require.Equal(t, host2.URL, pool.Select("user1"))
+}
+
+func TestStoppedClientIsNotReconnected(t *testing.T) {
+	host := startTestHost(t, &testHostAPI{name: "host", healthy: true})
+
+	client := newTestPool(t, host.URL).NewClient("user1")
+	client.Stop()
+	var name string
+	require.ErrorIs(t, client.Call(&name, "obscuro_name"), gethrpc.ErrClientQuit)
+}
@@ -3,6 +3,9 @@ package main
 import (
 	"flag"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/obscuronet/go-obscuro/tools/walletextension/config"
 )
@@ -20,9 +23,17 @@ const (
 	walletExtensionPortWSDefault = 3001
 	walletExtensionPortWSUsage   = "The port on which to serve websocket JSON RPC requests. Default: 3001."
 
+	walletExtensionHostAdminName    = "hostAdmin"
+	walletExtensionHostAdminDefault = "127.0.0.1"
+	walletExtensionHostAdminUsage   = "The host where the wallet extension should open the admin port. Default: 127.0.0.1."
+
+	walletExtensionPortAdminName    = "portAdmin"
+	walletExtensionPortAdminDefault = 3002
+	walletExtensionPortAdminUsage   = "The port on which to serve the admin and metrics endpoints, if the adminAPIKey flag is set. Zero disables them. Default: 3002."
+
 	nodeHostName    = "nodeHost"
 	nodeHostDefault = "testnet.obscu.ro"
-	nodeHostUsage   = "The host on which to connect to the Obscuro node. Default: `testnet.obscu.ro`."
+	nodeHostUsage   = "The host on which to connect to the Obscuro node. Several comma-separated hosts can be given, in which case the requests are spread over them, failing over between them. Default: `testnet.obscu.ro`."
 
 	nodeHTTPPortName    = "nodePortHTTP"
 	nodeHTTPPortDefault = 80
@@ -51,12 +62,102 @@ const (
 	dbConnectionURLFlagName    = "dbConnectionURL"
 	dbConnectionURLFlagDefault = ""
 	dbConnectionURLFlagUsage   = "If dbType is set to mariaDB, this must be set. ex: obscurouser:password@tcp(127.0.0.1:3306)/ogdb"
+
+	masterKeyPathFlagName    = "masterKeyPath"
+	masterKeyPathFlagDefault = ""
+	masterKeyPathFlagUsage   = "The path of the file holding the key encrypting the private keys stored in the database. Overridden by the OBSCURO_GATEWAY_MASTER_KEY environment variable. The gateway does not start without a master key."
+
+	generateMasterKeyFlagName    = "generateMasterKey"
+	generateMasterKeyFlagDefault = false
+	generateMasterKeyFlagUsage   = "Flag to generate the master key file if it doesn't exist. For development only, as the key is stored in plaintext. Default: false"
+
+	maxHydratedUsersFlagName    = "maxHydratedUsers"
+	maxHydratedUsersFlagDefault = 10_000
+	maxHydratedUsersFlagUsage   = "The maximum number of users whose RPC clients are kept in memory. The other users' clients are created on first use. Default: 10000."
+
+	userIdleTimeoutFlagName    = "userIdleTimeout"
+	userIdleTimeoutFlagDefault = 30 * time.Minute
+	userIdleTimeoutFlagUsage   = "The RPC clients of the users that are idle for longer than this are stopped. Default: 30m."
+
+	maxBatchSizeFlagName    = "maxBatchSize"
+	maxBatchSizeFlagDefault = 100
+	maxBatchSizeFlagUsage   = "The maximum number of requests accepted in a single JSON-RPC batch. Default: 100."
+
+	hostHealthCheckIntervalFlagName    = "hostHealthCheckInterval"
+	hostHealthCheckIntervalFlagDefault = 10 * time.Second
+	hostHealthCheckIntervalFlagUsage   = "How often the health and the latency of the Obscuro nodes are checked. Default: 10s."
+
+	hostFailureThresholdFlagName    = "hostFailureThreshold"
+	hostFailureThresholdFlagDefault = 3
+	hostFailureThresholdFlagUsage   = "The number of consecutive failures after which an Obscuro node is no longer sent requests. Default: 3."
+
+	hostCircuitBreakerCooldownFlagName    = "hostCircuitBreakerCooldown"
+	hostCircuitBreakerCooldownFlagDefault = 30 * time.Second
+	hostCircuitBreakerCooldownFlagUsage   = "How long a failing Obscuro node is not sent requests for. Default: 30s."
+
+	userRateLimitFlagName    = "userRateLimit"
+	userRateLimitFlagDefault = 100.0
+	userRateLimitFlagUsage   = "The compute units per second a user can use. Zero disables the limit. Default: 100."
+
+	userRateLimitBurstFlagName    = "userRateLimitBurst"
+	userRateLimitBurstFlagDefault = 500
+	userRateLimitBurstFlagUsage   = "The compute units a user can use at once. Default: 500."
+
+	userDailyQuotaFlagName    = "userDailyQuota"
+	userDailyQuotaFlagDefault = 5_000_000
+	userDailyQuotaFlagUsage   = "The compute units a user can use per day (UTC). Zero disables the quota. Default: 5000000."
+
+	ipRateLimitFlagName    = "ipRateLimit"
+	ipRateLimitFlagDefault = 200.0
+	ipRateLimitFlagUsage   = "The compute units per second the users of an IP can use. Zero disables the limit. Default: 200."
+
+	ipRateLimitBurstFlagName    = "ipRateLimitBurst"
+	ipRateLimitBurstFlagDefault = 1000
+	ipRateLimitBurstFlagUsage   = "The compute units the users of an IP can use at once. Default: 1000."
+
+	methodWeightsFlagName    = "methodWeights"
+	methodWeightsFlagDefault = ""
+	methodWeightsFlagUsage   = "The compute units charged per request of some methods, overriding the default weights. Ex: eth_getLogs=20,eth_call=5"
+
+	adminAPIKeyFlagName    = "adminAPIKey"
+	adminAPIKeyFlagDefault = ""
+	adminAPIKeyFlagUsage   = "The key authenticating the requests to the admin and metrics endpoints, sent as a bearer token. The admin port is not opened if it is empty."
+
+	obscuroChainIDFlagName    = "obscuroChainID"
+	obscuroChainIDFlagDefault = 777
+	obscuroChainIDFlagUsage   = "The chain ID of the Obscuro network, which the EIP-712 signatures of the viewing keys are bound to. Default: 777."
+
+	viewingKeyValidityFlagName    = "viewingKeyValidity"
+	viewingKeyValidityFlagDefault = 30 * 24 * time.Hour
+	viewingKeyValidityFlagUsage   = "How long the EIP-712 signatures of the viewing keys are valid for. Default: 720h."
+
+	allowLegacySignaturesFlagName    = "allowLegacySignatures"
+	allowLegacySignaturesFlagDefault = true
+	allowLegacySignaturesFlagUsage   = "Whether viewing keys signed as personal-sign text rather than EIP-712 typed data are accepted. Default: true."
+
+	subscriptionBufferSizeFlagName    = "subscriptionBufferSize"
+	subscriptionBufferSizeFlagDefault = 1000
+	subscriptionBufferSizeFlagUsage   = "The maximum number of logs buffered per subscription while its websocket is disconnected. The older logs are fetched again when the subscription is resumed. Default: 1000."
+
+	subscriptionGracePeriodFlagName    = "subscriptionGracePeriod"
+	subscriptionGracePeriodFlagDefault = time.Minute
+	subscriptionGracePeriodFlagUsage   = "How long a subscription can be resumed for after its websocket is disconnected. Zero disables resuming subscriptions. Default: 1m."
+
+	siweDomainFlagName    = "siweDomain"
+	siweDomainFlagDefault = ""
+	siweDomainFlagUsage   = "The domain the Sign-In-With-Ethereum messages must be addressed to, e.g. gateway.obscu.ro. Sign-In-With-Ethereum is disabled if it is not set."
+
+	sessionTTLFlagName    = "sessionTTL"
+	sessionTTLFlagDefault = time.Hour
+	sessionTTLFlagUsage   = "How long the sessions of the users who signed in with Ethereum are valid for. Default: 1h."
 )
 
 func parseCLIArgs() config.Config {
 	walletExtensionHost := flag.String(walletExtensionHostName, walletExtensionHostDefault, walletExtensionHostUsage)
 	walletExtensionPort := flag.Int(walletExtensionPortName, walletExtensionPortDefault, walletExtensionPortUsage)
 	walletExtensionPortWS := flag.Int(walletExtensionPortWSName, walletExtensionPortWSDefault, walletExtensionPortWSUsage)
+	walletExtensionHostAdmin := flag.String(walletExtensionHostAdminName, walletExtensionHostAdminDefault, walletExtensionHostAdminUsage)
+	walletExtensionPortAdmin := flag.Int(walletExtensionPortAdminName, walletExtensionPortAdminDefault, walletExtensionPortAdminUsage)
 	nodeHost := flag.String(nodeHostName, nodeHostDefault, nodeHostUsage)
 	nodeHTTPPort := flag.Int(nodeHTTPPortName, nodeHTTPPortDefault, nodeHTTPPortUsage)
 	nodeWebsocketPort := flag.Int(nodeWebsocketPortName, nodeWebsocketPortDefault, nodeWebsocketPortUsage)
@@ -65,18 +166,100 @@ func parseCLIArgs() config.Config {
 	verboseFlag := flag.Bool(verboseFlagName, verboseFlagDefault, verboseFlagUsage)
 	dbType := flag.String(dbTypeFlagName, dbTypeFlagDefault, dbTypeFlagUsage)
 	dbConnectionURL := flag.String(dbConnectionURLFlagName, dbConnectionURLFlagDefault, dbConnectionURLFlagUsage)
+	masterKeyPath := flag.String(masterKeyPathFlagName, masterKeyPathFlagDefault, masterKeyPathFlagUsage)
+	generateMasterKey := flag.Bool(generateMasterKeyFlagName, generateMasterKeyFlagDefault, generateMasterKeyFlagUsage)
+	maxHydratedUsers := flag.Int(maxHydratedUsersFlagName, maxHydratedUsersFlagDefault, maxHydratedUsersFlagUsage)
+	userIdleTimeout := flag.Duration(userIdleTimeoutFlagName, userIdleTimeoutFlagDefault, userIdleTimeoutFlagUsage)
+	maxBatchSize := flag.Int(maxBatchSizeFlagName, maxBatchSizeFlagDefault, maxBatchSizeFlagUsage)
+	hostHealthCheckInterval := flag.Duration(hostHealthCheckIntervalFlagName, hostHealthCheckIntervalFlagDefault, hostHealthCheckIntervalFlagUsage)
+	hostFailureThreshold := flag.Int(hostFailureThresholdFlagName, hostFailureThresholdFlagDefault, hostFailureThresholdFlagUsage)
+	hostCircuitBreakerCooldown := flag.Duration(hostCircuitBreakerCooldownFlagName, hostCircuitBreakerCooldownFlagDefault, hostCircuitBreakerCooldownFlagUsage)
+	userRateLimit := flag.Float64(userRateLimitFlagName, userRateLimitFlagDefault, userRateLimitFlagUsage)
+	userRateLimitBurst := flag.Uint64(userRateLimitBurstFlagName, userRateLimitBurstFlagDefault, userRateLimitBurstFlagUsage)
+	userDailyQuota := flag.Uint64(userDailyQuotaFlagName, userDailyQuotaFlagDefault, userDailyQuotaFlagUsage)
+	ipRateLimit := flag.Float64(ipRateLimitFlagName, ipRateLimitFlagDefault, ipRateLimitFlagUsage)
+	ipRateLimitBurst := flag.Uint64(ipRateLimitBurstFlagName, ipRateLimitBurstFlagDefault, ipRateLimitBurstFlagUsage)
+	methodWeightsFlag := flag.String(methodWeightsFlagName, methodWeightsFlagDefault, methodWeightsFlagUsage)
+	adminAPIKey := flag.String(adminAPIKeyFlagName, adminAPIKeyFlagDefault, adminAPIKeyFlagUsage)
+	obscuroChainID := flag.Int64(obscuroChainIDFlagName, obscuroChainIDFlagDefault, obscuroChainIDFlagUsage)
+	viewingKeyValidity := flag.Duration(viewingKeyValidityFlagName, viewingKeyValidityFlagDefault, viewingKeyValidityFlagUsage)
+	allowLegacySignatures := flag.Bool(allowLegacySignaturesFlagName, allowLegacySignaturesFlagDefault, allowLegacySignaturesFlagUsage)
+	subscriptionBufferSize := flag.Int(subscriptionBufferSizeFlagName, subscriptionBufferSizeFlagDefault, subscriptionBufferSizeFlagUsage)
+	subscriptionGracePeriod := flag.Duration(subscriptionGracePeriodFlagName, subscriptionGracePeriodFlagDefault, subscriptionGracePeriodFlagUsage)
+	siweDomain := flag.String(siweDomainFlagName, siweDomainFlagDefault, siweDomainFlagUsage)
+	sessionTTL := flag.Duration(sessionTTLFlagName, sessionTTLFlagDefault, sessionTTLFlagUsage)
 	flag.Parse()
 
+	methodWeights, err := parseMethodWeights(*methodWeightsFlag)
+	if err != nil {
+		panic(fmt.Sprintf("could not parse the %s flag. Cause: %s", methodWeightsFlagName, err))
+	}
+
+	var nodeHTTPAddresses, nodeWebsocketAddresses []string
+	for _, host := range strings.Split(*nodeHost, ",") {
+		host = strings.TrimSpace(host)
+		if host == "" {
+			continue
+		}
+		nodeHTTPAddresses = append(nodeHTTPAddresses, fmt.Sprintf("%s:%d", host, *nodeHTTPPort))
+		nodeWebsocketAddresses = append(nodeWebsocketAddresses, fmt.Sprintf("%s:%d", host, *nodeWebsocketPort))
+	}
+
 	return config.Config{
-		WalletExtensionHost:     *walletExtensionHost,
-		WalletExtensionPortHTTP: *walletExtensionPort,
-		WalletExtensionPortWS:   *walletExtensionPortWS,
-		NodeRPCHTTPAddress:      fmt.Sprintf("%s:%d", *nodeHost, *nodeHTTPPort),
-		NodeRPCWebsocketAddress: fmt.Sprintf("%s:%d", *nodeHost, *nodeWebsocketPort),
-		LogPath:                 *logPath,
-		DBPathOverride:          *databasePath,
-		VerboseFlag:             *verboseFlag,
-		DBType:                  *dbType,
-		DBConnectionURL:         *dbConnectionURL,
+		WalletExtensionHost:        *walletExtensionHost,
+		WalletExtensionPortHTTP:    *walletExtensionPort,
+		WalletExtensionPortWS:      *walletExtensionPortWS,
+		WalletExtensionHostAdmin:   *walletExtensionHostAdmin,
+		WalletExtensionPortAdmin:   *walletExtensionPortAdmin,
+		NodeRPCHTTPAddresses:       nodeHTTPAddresses,
+		NodeRPCWebsocketAddresses:  nodeWebsocketAddresses,
+		LogPath:                    *logPath,
+		DBPathOverride:             *databasePath,
+		VerboseFlag:                *verboseFlag,
+		DBType:                     *dbType,
+		DBConnectionURL:            *dbConnectionURL,
+		MasterKeyPath:              *masterKeyPath,
+		GenerateMasterKey:          *generateMasterKey,
+		MaxHydratedUsers:           *maxHydratedUsers,
+		UserIdleTimeout:            *userIdleTimeout,
+		MaxBatchSize:               *maxBatchSize,
+		HostHealthCheckInterval:    *hostHealthCheckInterval,
+		HostFailureThreshold:       *hostFailureThreshold,
+		HostCircuitBreakerCooldown: *hostCircuitBreakerCooldown,
+		UserRateLimit:              *userRateLimit,
+		UserRateLimitBurst:         *userRateLimitBurst,
+		UserDailyQuota:             *userDailyQuota,
+		IPRateLimit:                *ipRateLimit,
+		IPRateLimitBurst:           *ipRateLimitBurst,
+		MethodWeights:              methodWeights,
+		AdminAPIKey:                *adminAPIKey,
+		ObscuroChainID:             *obscuroChainID,
+		ViewingKeyValidity:         *viewingKeyValidity,
+		AllowLegacySignatures:      *allowLegacySignatures,
+		SubscriptionBufferSize:     *subscriptionBufferSize,
+		SubscriptionGracePeriod:    *subscriptionGracePeriod,
+		SIWEDomain:                 *siweDomain,
+		SessionTTL:                 *sessionTTL,
+	}
+}
+
+// parseMethodWeights parses a comma-separated list of method=weight pairs
+func parseMethodWeights(flagValue string) (map[string]uint64, error) {
+	weights := map[string]uint64{}
+	for _, pair := range strings.Split(flagValue, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		method, weight, found := strings.Cut(pair, "=")
+		if !found {
+			return nil, fmt.Errorf("expected a method=weight pair, got %s", pair)
+		}
+		parsedWeight, err := strconv.ParseUint(strings.TrimSpace(weight), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight for method %s: %w", method, err)
+		}
+		weights[strings.TrimSpace(method)] = parsedWeight
 	}
+	return weights, nil
 }
@@ -2,9 +2,11 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/obscuronet/go-obscuro/go/common/log"
@@ -24,21 +26,18 @@ func main() {
 	fmt.Printf("Welcome to the Obscuro wallet extension. \n\n")
 	fmt.Printf("Starting with following config: \n%s\n", string(jsonConfig))
 
-	// We wait thirty seconds for a connection to the node. If we cannot establish one, we exit the program.
-	fmt.Printf("Waiting up to thirty seconds for connection to host at %s...\n", config.NodeRPCWebsocketAddress)
+	// We wait thirty seconds for a connection to one of the nodes. If we cannot establish one, we exit the program.
+	fmt.Printf("Waiting up to thirty seconds for connection to hosts at %s...\n", strings.Join(config.NodeRPCWebsocketAddresses, ", "))
 	counter := 30
 	for {
-		conn, err := net.Dial(tcp, config.NodeRPCWebsocketAddress)
-		if conn != nil {
-			conn.Close()
-		}
+		err := dialAnyHost(config.NodeRPCWebsocketAddresses)
 		if err == nil {
 			break
 		}
 
 		counter--
 		if counter <= 0 {
-			fmt.Printf("Exiting. Could not establish connection to host at %s. Cause: %s\n", config.NodeRPCWebsocketAddress, err)
+			fmt.Printf("Exiting. Could not establish connection to hosts at %s. Cause: %s\n", strings.Join(config.NodeRPCWebsocketAddresses, ", "), err)
 			return
 		}
 		time.Sleep(time.Second)
@@ -79,3 +78,19 @@ func main() {
 
 	select {}
 }
+
+// dialAnyHost returns an error if none of the hosts accepts a connection
+func dialAnyHost(addresses []string) error {
+	err := errors.New("no host address was provided")
+	for _, address := range addresses {
+		var conn net.Conn
+		conn, err = net.Dial(tcp, address)
+		if conn != nil {
+			conn.Close()
+		}
+		if err == nil {
+			return nil
+		}
+	}
+	return err
+}
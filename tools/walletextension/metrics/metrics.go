@@ -0,0 +1,147 @@
+package metrics
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics/prometheus"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+)
+
+const (
+	// maxTrackedMethods - the requests of the methods seen after this many methods are counted together, so the
+	// number of metrics can't be grown without bounds by requesting made-up methods
+	maxTrackedMethods = 100
+	otherMethods      = "other"
+)
+
+// Metrics records the volume and the latency of the requests proxied by the gateway, per method and per user.
+// The per-method metrics are kept in the gethmetrics Registry, so they can be exported in the Prometheus format.
+// It is safe for concurrent use.
+type Metrics struct {
+	enabled      bool
+	registry     gethmetrics.Registry
+	methods      map[string]*methodMetrics
+	userRequests map[string]uint64 // The number of requests of each user since the gateway started
+	mutex        sync.Mutex
+}
+
+type methodMetrics struct {
+	latency gethmetrics.Timer
+	errors  gethmetrics.Counter
+}
+
+// MethodStats are the statistics of the requests of a method. The latencies are in milliseconds.
+type MethodStats struct {
+	Requests        int64   `json:"requests"`
+	Errors          int64   `json:"errors"`
+	MeanLatencyMs   float64 `json:"meanLatencyMs"`
+	MedianLatencyMs float64 `json:"medianLatencyMs"`
+	P99LatencyMs    float64 `json:"p99LatencyMs"`
+}
+
+// New returns the metrics of the gateway. The metrics are not recorded unless they are enabled.
+// gethmetrics only creates working metrics when they are enabled globally, so enabling the metrics of the gateway
+// enables them for the process. Disabled gateway metrics leave the global flag as it is, so they never turn off the
+// metrics of the other components running in the same process.
+func New(enabled bool) *Metrics {
+	if enabled {
+		gethmetrics.Enabled = true
+	}
+	return &Metrics{
+		enabled:      enabled,
+		registry:     gethmetrics.NewRegistry(),
+		methods:      make(map[string]*methodMetrics),
+		userRequests: make(map[string]uint64),
+	}
+}
+
+// Registry returns the registry holding the metrics of the gateway
+func (m *Metrics) Registry() gethmetrics.Registry {
+	return m.registry
+}
+
+// PrometheusHandler returns the handler exporting the metrics in the Prometheus format
+func (m *Metrics) PrometheusHandler() http.Handler {
+	return prometheus.Handler(m.registry)
+}
+
+// RecordRequest records the latency of a request of the method, and whether it failed
+func (m *Metrics) RecordRequest(method string, latency time.Duration, failed bool) {
+	if !m.enabled {
+		return
+	}
+	metrics := m.methodMetrics(method)
+	metrics.latency.Update(latency)
+	if failed {
+		metrics.errors.Inc(1)
+	}
+}
+
+// RecordUserRequest counts a request of the user. It is only called for the users stored by the gateway, so the number
+// of users tracked is bounded.
+func (m *Metrics) RecordUserRequest(hexUserID string) {
+	if !m.enabled {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.userRequests[hexUserID]++
+}
+
+// UserRequests returns the number of requests of the user since the gateway started
+func (m *Metrics) UserRequests(hexUserID string) uint64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.userRequests[hexUserID]
+}
+
+// RemoveUser stops tracking the requests of the deleted user
+func (m *Metrics) RemoveUser(hexUserID string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.userRequests, hexUserID)
+}
+
+// MethodStats returns the statistics of the requests of each method
+func (m *Metrics) MethodStats() map[string]MethodStats {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	stats := make(map[string]MethodStats, len(m.methods))
+	for method, metrics := range m.methods {
+		latency := metrics.latency.Snapshot()
+		percentiles := latency.Percentiles([]float64{0.5, 0.99})
+		stats[method] = MethodStats{
+			Requests:        latency.Count(),
+			Errors:          metrics.errors.Snapshot().Count(),
+			MeanLatencyMs:   latency.Mean() / float64(time.Millisecond),
+			MedianLatencyMs: percentiles[0] / float64(time.Millisecond),
+			P99LatencyMs:    percentiles[1] / float64(time.Millisecond),
+		}
+	}
+	return stats
+}
+
+func (m *Metrics) methodMetrics(method string) *methodMetrics {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if metrics, found := m.methods[method]; found {
+		return metrics
+	}
+	if len(m.methods) >= maxTrackedMethods {
+		method = otherMethods
+		if metrics, found := m.methods[method]; found {
+			return metrics
+		}
+	}
+	metrics := &methodMetrics{
+		latency: gethmetrics.GetOrRegisterTimer("gateway/requests/"+method, m.registry),
+		errors:  gethmetrics.GetOrRegisterCounter("gateway/requests/"+method+"/errors", m.registry),
+	}
+	m.methods[method] = metrics
+	return metrics
+}
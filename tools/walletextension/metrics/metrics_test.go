@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/obscuronet/go-obscuro/go/rpc"
+	"github.com/stretchr/testify/require"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+)
+
+func TestRequestsAreRecordedPerMethod(t *testing.T) {
+	m := New(true)
+	m.RecordRequest(rpc.Call, 10*time.Millisecond, false)
+	m.RecordRequest(rpc.Call, 30*time.Millisecond, true)
+	m.RecordRequest(rpc.GetBalance, time.Millisecond, false)
+
+	stats := m.MethodStats()
+	require.Len(t, stats, 2)
+	require.Equal(t, int64(2), stats[rpc.Call].Requests)
+	require.Equal(t, int64(1), stats[rpc.Call].Errors)
+	require.InDelta(t, 20, stats[rpc.Call].MeanLatencyMs, 0.001)
+	require.Equal(t, int64(1), stats[rpc.GetBalance].Requests)
+	require.Zero(t, stats[rpc.GetBalance].Errors)
+}
+
+func TestUnknownMethodsAreCountedTogetherAboveTheLimit(t *testing.T) {
+	m := New(true)
+	for i := 0; i < maxTrackedMethods+10; i++ {
+		m.RecordRequest(fmt.Sprintf("made_up_%d", i), time.Millisecond, true)
+	}
+
+	stats := m.MethodStats()
+	require.Len(t, stats, maxTrackedMethods+1)
+	require.Equal(t, int64(10), stats[otherMethods].Requests)
+}
+
+func TestUserRequestsAreCountedUntilTheUserIsRemoved(t *testing.T) {
+	m := New(true)
+	m.RecordUserRequest("aa01")
+	m.RecordUserRequest("aa01")
+	require.Equal(t, uint64(2), m.UserRequests("aa01"))
+
+	m.RemoveUser("aa01")
+	require.Zero(t, m.UserRequests("aa01"))
+}
+
+func TestDisabledMetricsAreNotRecorded(t *testing.T) {
+	// the metrics of the other components of the process are left enabled
+	gethmetrics.Enabled = true
+	m := New(false)
+	require.True(t, gethmetrics.Enabled)
+
+	m.RecordRequest(rpc.Call, time.Millisecond, false)
+	m.RecordUserRequest("aa01")
+	require.Empty(t, m.MethodStats())
+	require.Zero(t, m.UserRequests("aa01"))
+}
@@ -0,0 +1,365 @@
+package ratelimiter
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/rpc"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/storage"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// ErrorCodeLimitExceeded is the JSON-RPC error code of the requests that are throttled, as defined by EIP-1474
+	ErrorCodeLimitExceeded = -32005
+
+	defaultMethodWeight = 1
+	// the state of the users and IPs that did not make requests for longer is dropped. The token buckets are full by then,
+	// and the usage of the users is reloaded from the database
+	idleStateTimeout = 10 * time.Minute
+	// how often the compute units used by the users are persisted
+	flushInterval = 10 * time.Second
+	dayFormat     = "2006-01-02"
+)
+
+// DefaultMethodWeights are the compute units charged for the methods that are more expensive for the host than
+// the others, which are charged a single compute unit
+var DefaultMethodWeights = map[string]uint64{
+	rpc.Call:                5,
+	rpc.CreateAccessList:    10,
+	rpc.EstimateGas:         10,
+	rpc.GetLogs:             20,
+	rpc.GetOutboundMessages: 20,
+	rpc.SendRawTransaction:  5,
+	rpc.SimulateCalls:       20,
+	rpc.Subscribe:           10,
+}
+
+// Limits are the rate limits applied to a user or an IP
+type Limits struct {
+	ComputeUnitsPerSecond float64 `json:"computeUnitsPerSecond"` // Zero disables the rate limit.
+	Burst                 uint64  `json:"burst"`                 // The compute units that can be used at once.
+	DailyQuota            uint64  `json:"dailyQuota"`            // The compute units that can be used per day (UTC). Zero disables the quota. Not applied to IPs.
+}
+
+// LimitExceededError is returned for the requests that are throttled
+type LimitExceededError struct {
+	message    string
+	retryAfter time.Duration
+}
+
+func (e *LimitExceededError) Error() string {
+	return e.message
+}
+
+func (e *LimitExceededError) ErrorCode() int {
+	return ErrorCodeLimitExceeded
+}
+
+func (e *LimitExceededError) ErrorData() interface{} {
+	return map[string]interface{}{"retryAfterSeconds": math.Ceil(e.retryAfter.Seconds())}
+}
+
+// RateLimiter throttles the requests of the users with token buckets keyed by user ID and by client IP. Each request is
+// charged compute units depending on its method, and the compute units used by each user per day are persisted in the
+// database and capped by a quota. The limits of the users can be overridden by the admins.
+// It is safe for concurrent use.
+type RateLimiter struct {
+	userLimits    Limits // The limits of the users that have no limits of their own
+	ipLimits      Limits
+	methodWeights map[string]uint64
+	storage       storage.Storage
+	users         map[string]*userState
+	ips           map[string]*tokenBucket
+	pendingUsage  []usage // The compute units of the previous days that are not persisted yet
+	mutex         sync.Mutex
+	stopCh        chan struct{}
+	stopOnce      sync.Once
+	logger        gethlog.Logger
+}
+
+type userState struct {
+	userID       []byte
+	limits       Limits
+	customLimits bool // Whether the limits were set by an admin
+	bucket       tokenBucket
+	day          string
+	used         uint64 // The compute units used during the day, including the unflushed ones
+	unflushed    uint64 // The compute units used during the day that are not persisted yet
+}
+
+type usage struct {
+	userID []byte
+	day    string
+	units  uint64
+}
+
+// New returns a RateLimiter applying the given default limits. The weights override the default weights of the methods.
+func New(
+	userLimits Limits,
+	ipLimits Limits,
+	methodWeights map[string]uint64,
+	storage storage.Storage,
+	logger gethlog.Logger,
+) *RateLimiter {
+	weights := make(map[string]uint64, len(DefaultMethodWeights)+len(methodWeights))
+	for method, weight := range DefaultMethodWeights {
+		weights[method] = weight
+	}
+	for method, weight := range methodWeights {
+		weights[method] = weight
+	}
+
+	return &RateLimiter{
+		userLimits:    userLimits,
+		ipLimits:      ipLimits,
+		methodWeights: weights,
+		storage:       storage,
+		users:         make(map[string]*userState),
+		ips:           make(map[string]*tokenBucket),
+		stopCh:        make(chan struct{}),
+		logger:        logger,
+	}
+}
+
+// Start periodically persists the compute units used by the users
+func (r *RateLimiter) Start() {
+	go func() {
+		ticker := time.NewTicker(flushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				r.flush(time.Now())
+			case <-r.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop persists the compute units used by the users that are not persisted yet
+func (r *RateLimiter) Stop() {
+	r.stopOnce.Do(func() {
+		close(r.stopCh)
+	})
+	r.flush(time.Now())
+}
+
+// Weight returns the compute units charged for a request of the given method
+func (r *RateLimiter) Weight(method string) uint64 {
+	if weight, found := r.methodWeights[method]; found {
+		return weight
+	}
+	return defaultMethodWeight
+}
+
+// MethodWeights returns the compute units charged for the methods that are not charged a single compute unit
+func (r *RateLimiter) MethodWeights() map[string]uint64 {
+	weights := make(map[string]uint64, len(r.methodWeights))
+	for method, weight := range r.methodWeights {
+		weights[method] = weight
+	}
+	return weights
+}
+
+// DefaultLimits returns the limits applied to the users that have no limits of their own, and to the IPs
+func (r *RateLimiter) DefaultLimits() (Limits, Limits) {
+	return r.userLimits, r.ipLimits
+}
+
+// Allow charges the compute units of the request to the user and to the IP, or returns a LimitExceededError if either
+// can't afford them. The requests of the default user are only limited by IP, as it is shared by the users of the legacy
+// endpoints.
+func (r *RateLimiter) Allow(hexUserID string, ip string, method string) error {
+	return r.allow(hexUserID, ip, r.Weight(method), time.Now())
+}
+
+func (r *RateLimiter) allow(hexUserID string, ip string, units uint64, now time.Time) error {
+	var user *userState
+	if userID, err := common.GetUserIDbyte(hexUserID); err == nil && string(userID) != common.DefaultUser {
+		user, err = r.loadUser(hexUserID, userID, now)
+		if err != nil {
+			r.logger.Error("could not load the usage of the user, only limiting by IP", "userID", hexUserID, log.ErrKey, err)
+		}
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	ipBucket, found := r.ips[ip]
+	if !found {
+		ipBucket = newTokenBucket(r.ipLimits, now)
+		r.ips[ip] = ipBucket
+	}
+	if retryAfter, ok := ipBucket.check(r.ipLimits, units, now); !ok {
+		return &LimitExceededError{message: fmt.Sprintf("rate limit exceeded for IP %s", ip), retryAfter: retryAfter}
+	}
+
+	if user != nil {
+		if current, found := r.users[hexUserID]; found {
+			user = current
+		} else {
+			// the state was dropped by a flush in the meantime
+			r.users[hexUserID] = user
+		}
+		if user.limits.DailyQuota > 0 && user.used+units > user.limits.DailyQuota {
+			return &LimitExceededError{
+				message:    fmt.Sprintf("daily quota of %d compute units exceeded", user.limits.DailyQuota),
+				retryAfter: startOfNextDay(now).Sub(now),
+			}
+		}
+		if retryAfter, ok := user.bucket.check(user.limits, units, now); !ok {
+			return &LimitExceededError{message: "rate limit exceeded for user", retryAfter: retryAfter}
+		}
+		user.bucket.take(user.limits, units)
+		user.used += units
+		user.unflushed += units
+	}
+	ipBucket.take(r.ipLimits, units)
+	return nil
+}
+
+// GetLimits returns the limits applied to the user, whether they were set by an admin, and the compute units the user used today
+func (r *RateLimiter) GetLimits(hexUserID string) (Limits, bool, uint64, error) {
+	userID, err := common.GetUserIDbyte(hexUserID)
+	if err != nil {
+		return Limits{}, false, 0, fmt.Errorf("userID is not hex encoded. Cause: %w", err)
+	}
+	user, err := r.loadUser(hexUserID, userID, time.Now())
+	if err != nil {
+		return Limits{}, false, 0, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return user.limits, user.customLimits, user.used, nil
+}
+
+// SetLimits overrides the limits of the user. Nil limits restore the default ones.
+func (r *RateLimiter) SetLimits(hexUserID string, limits *Limits) error {
+	userID, err := common.GetUserIDbyte(hexUserID)
+	if err != nil {
+		return fmt.Errorf("userID is not hex encoded. Cause: %w", err)
+	}
+	if limits == nil {
+		err = r.storage.DeleteUserLimits(userID)
+	} else {
+		err = r.storage.SetUserLimits(userID, common.UserLimitsDB{
+			ComputeUnitsPerSecond: limits.ComputeUnitsPerSecond,
+			Burst:                 limits.Burst,
+			DailyQuota:            limits.DailyQuota,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("could not store the limits of the user. Cause: %w", err)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if user, found := r.users[hexUserID]; found {
+		user.limits, user.customLimits = r.userLimits, false
+		if limits != nil {
+			user.limits, user.customLimits = *limits, true
+		}
+		// the user can use the new burst straight away
+		user.bucket = *newTokenBucket(user.limits, time.Now())
+	}
+	return nil
+}
+
+// loadUser returns the state of the user, loading their limits and usage from the database if they are not in memory
+// or if the day changed
+func (r *RateLimiter) loadUser(hexUserID string, userID []byte, now time.Time) (*userState, error) {
+	day := now.UTC().Format(dayFormat)
+
+	r.mutex.Lock()
+	user, found := r.users[hexUserID]
+	if found && user.day != day {
+		// the usage of the previous day is persisted, and the usage of the new day starts from zero
+		r.pendingUsage = append(r.pendingUsage, usage{userID: user.userID, day: user.day, units: user.unflushed})
+		user.day, user.used, user.unflushed = day, 0, 0
+	}
+	r.mutex.Unlock()
+	if found {
+		return user, nil
+	}
+
+	// the database is queried without holding the lock, so the requests of the other users are not blocked
+	limits, customLimits := r.userLimits, false
+	storedLimits, err := r.storage.GetUserLimits(userID)
+	switch {
+	case err == nil:
+		limits, customLimits = Limits{
+			ComputeUnitsPerSecond: storedLimits.ComputeUnitsPerSecond,
+			Burst:                 storedLimits.Burst,
+			DailyQuota:            storedLimits.DailyQuota,
+		}, true
+	case !errors.Is(err, errutil.ErrNotFound):
+		return nil, err
+	}
+	used, err := r.storage.GetComputeUnits(userID, day)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if existing, found := r.users[hexUserID]; found {
+		// another request of the user loaded it in the meantime
+		return existing, nil
+	}
+	user = &userState{
+		userID:       userID,
+		limits:       limits,
+		customLimits: customLimits,
+		bucket:       *newTokenBucket(limits, now),
+		day:          day,
+		used:         used,
+	}
+	r.users[hexUserID] = user
+	return user, nil
+}
+
+// flush persists the compute units used by the users since the previous flush, and drops the state of the idle users and IPs
+func (r *RateLimiter) flush(now time.Time) {
+	r.mutex.Lock()
+	pending := r.pendingUsage
+	r.pendingUsage = nil
+	for hexUserID, user := range r.users {
+		if user.unflushed > 0 {
+			pending = append(pending, usage{userID: user.userID, day: user.day, units: user.unflushed})
+			user.unflushed = 0
+		}
+		if now.Sub(user.bucket.last) > idleStateTimeout {
+			delete(r.users, hexUserID)
+		}
+	}
+	for ip, bucket := range r.ips {
+		if now.Sub(bucket.last) > idleStateTimeout {
+			delete(r.ips, ip)
+		}
+	}
+	r.mutex.Unlock()
+
+	for _, u := range pending {
+		if u.units == 0 {
+			continue
+		}
+		if err := r.storage.AddComputeUnits(u.userID, u.day, u.units); err != nil {
+			r.logger.Error("could not persist the compute units used by the user", "userID", hex.EncodeToString(u.userID), log.ErrKey, err)
+		}
+	}
+}
+
+func startOfNextDay(now time.Time) time.Time {
+	return now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+}
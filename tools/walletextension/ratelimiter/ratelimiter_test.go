@@ -0,0 +1,145 @@
+package ratelimiter
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/obscuronet/go-obscuro/go/rpc"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/storage"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/storage/encryption"
+	"github.com/stretchr/testify/require"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	userID1 = "aa01"
+	userID2 = "aa02"
+	ip1     = "10.0.0.1"
+	ip2     = "10.0.0.2"
+)
+
+var (
+	unlimited = Limits{}
+	start     = time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+)
+
+func newTestStorage(t *testing.T) storage.Storage {
+	masterKey, err := encryption.GenerateMasterKey()
+	require.NoError(t, err)
+	s, err := storage.New("sqlite", "", "", masterKey)
+	require.NoError(t, err)
+	return s
+}
+
+func requireLimitExceeded(t *testing.T, err error) {
+	var rpcErr gethrpc.Error
+	require.True(t, errors.As(err, &rpcErr), "expected a JSON-RPC error, got %v", err)
+	require.Equal(t, ErrorCodeLimitExceeded, rpcErr.ErrorCode())
+}
+
+func TestUsersAreRateLimited(t *testing.T) {
+	r := New(Limits{ComputeUnitsPerSecond: 10, Burst: 20}, unlimited, nil, newTestStorage(t), log.New())
+
+	for i := 0; i < 4; i++ {
+		require.NoError(t, r.allow(userID1, ip1, 5, start))
+	}
+	requireLimitExceeded(t, r.allow(userID1, ip1, 5, start))
+	// the other users have their own bucket
+	require.NoError(t, r.allow(userID2, ip1, 5, start))
+
+	// the bucket is refilled over time
+	require.NoError(t, r.allow(userID1, ip1, 5, start.Add(500*time.Millisecond)))
+	requireLimitExceeded(t, r.allow(userID1, ip1, 5, start.Add(500*time.Millisecond)))
+
+	// the requests costing more than the burst are allowed when the bucket is full
+	require.NoError(t, r.allow(userID1, ip1, 100, start.Add(time.Minute)))
+}
+
+func TestIPsAreRateLimitedAcrossUsers(t *testing.T) {
+	r := New(unlimited, Limits{ComputeUnitsPerSecond: 1, Burst: 10}, nil, newTestStorage(t), log.New())
+
+	require.NoError(t, r.allow(userID1, ip1, 5, start))
+	require.NoError(t, r.allow(userID2, ip1, 5, start))
+	requireLimitExceeded(t, r.allow(userID2, ip1, 5, start))
+	require.NoError(t, r.allow(userID2, ip2, 5, start))
+}
+
+func TestDefaultUserIsOnlyLimitedByIP(t *testing.T) {
+	r := New(Limits{ComputeUnitsPerSecond: 1, Burst: 1, DailyQuota: 1}, unlimited, nil, newTestStorage(t), log.New())
+
+	defaultUserID := hex.EncodeToString([]byte(common.DefaultUser))
+	for i := 0; i < 10; i++ {
+		require.NoError(t, r.allow(defaultUserID, ip1, 5, start))
+	}
+}
+
+func TestMethodsAreWeighted(t *testing.T) {
+	r := New(unlimited, unlimited, map[string]uint64{rpc.GetLogs: 50}, newTestStorage(t), log.New())
+
+	require.Equal(t, uint64(50), r.Weight(rpc.GetLogs))
+	require.Equal(t, DefaultMethodWeights[rpc.EstimateGas], r.Weight(rpc.EstimateGas))
+	require.Equal(t, uint64(defaultMethodWeight), r.Weight(rpc.ChainID))
+}
+
+func TestDailyQuotaIsPersisted(t *testing.T) {
+	s := newTestStorage(t)
+	r := New(Limits{DailyQuota: 100}, unlimited, nil, s, log.New())
+
+	require.NoError(t, r.allow(userID1, ip1, 60, start))
+	requireLimitExceeded(t, r.allow(userID1, ip1, 60, start))
+	r.flush(start)
+
+	// the usage of the day is loaded from the database
+	r = New(Limits{DailyQuota: 100}, unlimited, nil, s, log.New())
+	require.NoError(t, r.allow(userID1, ip1, 40, start))
+	requireLimitExceeded(t, r.allow(userID1, ip1, 1, start))
+
+	// the quota is reset the next day, and the usage of the previous day is still persisted
+	nextDay := start.Add(24 * time.Hour)
+	require.NoError(t, r.allow(userID1, ip1, 100, nextDay))
+	r.flush(nextDay)
+	userID, err := common.GetUserIDbyte(userID1)
+	require.NoError(t, err)
+	used, err := s.GetComputeUnits(userID, start.Format(dayFormat))
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), used)
+	used, err = s.GetComputeUnits(userID, nextDay.Format(dayFormat))
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), used)
+}
+
+func TestAdminsCanOverrideTheLimitsOfUsers(t *testing.T) {
+	s := newTestStorage(t)
+	defaultLimits := Limits{ComputeUnitsPerSecond: 1, Burst: 1, DailyQuota: 1000}
+	r := New(defaultLimits, unlimited, nil, s, log.New())
+
+	require.NoError(t, r.allow(userID1, ip1, 1, start))
+	requireLimitExceeded(t, r.allow(userID1, ip1, 1, start))
+	limits, custom, used, err := r.GetLimits(userID1)
+	require.NoError(t, err)
+	require.Equal(t, defaultLimits, limits)
+	require.False(t, custom)
+	require.Equal(t, uint64(0), used)
+
+	customLimits := Limits{ComputeUnitsPerSecond: 100, Burst: 100, DailyQuota: 10}
+	require.NoError(t, r.SetLimits(userID1, &customLimits))
+	require.NoError(t, r.allow(userID1, ip1, 10, start))
+	requireLimitExceeded(t, r.allow(userID1, ip1, 1, start))
+
+	// the custom limits are persisted
+	limits, custom, _, err = New(defaultLimits, unlimited, nil, s, log.New()).GetLimits(userID1)
+	require.NoError(t, err)
+	require.Equal(t, customLimits, limits)
+	require.True(t, custom)
+
+	require.NoError(t, r.SetLimits(userID1, nil))
+	limits, custom, _, err = r.GetLimits(userID1)
+	require.NoError(t, err)
+	require.Equal(t, defaultLimits, limits)
+	require.False(t, custom)
+}
@@ -0,0 +1,44 @@
+package ratelimiter
+
+import (
+	"math"
+	"time"
+)
+
+// tokenBucket holds compute units, refilled continuously at the rate of the limits up to their burst
+type tokenBucket struct {
+	tokens float64
+	last   time.Time // The last time the bucket was refilled
+}
+
+func newTokenBucket(limits Limits, now time.Time) *tokenBucket {
+	return &tokenBucket{tokens: float64(limits.Burst), last: now}
+}
+
+// check refills the bucket and returns whether it holds enough compute units for the request, or how long it will take
+// until it does
+func (b *tokenBucket) check(limits Limits, units uint64, now time.Time) (time.Duration, bool) {
+	if now.After(b.last) {
+		b.tokens = math.Min(float64(limits.Burst), b.tokens+now.Sub(b.last).Seconds()*limits.ComputeUnitsPerSecond)
+		b.last = now
+	}
+	if limits.ComputeUnitsPerSecond <= 0 {
+		return 0, true
+	}
+
+	// the requests costing more than the burst are allowed once the bucket is full, or they could never be made
+	needed := math.Min(float64(units), float64(limits.Burst))
+	if b.tokens >= needed {
+		return 0, true
+	}
+	missing := needed - b.tokens
+	return time.Duration(missing / limits.ComputeUnitsPerSecond * float64(time.Second)), false
+}
+
+// take removes the compute units of a request that passed the check
+func (b *tokenBucket) take(limits Limits, units uint64) {
+	if limits.ComputeUnitsPerSecond <= 0 {
+		return
+	}
+	b.tokens -= math.Min(float64(units), float64(limits.Burst))
+}
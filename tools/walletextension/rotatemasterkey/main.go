@@ -0,0 +1,76 @@
+// rotatemasterkey re-encrypts the private keys stored in the database of the Obscuro gateway with a new master key.
+// It works on the database directly, so the gateway must be stopped while it runs, and restarted with the new master key.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/obscuronet/go-obscuro/tools/walletextension/storage"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/storage/encryption"
+)
+
+const (
+	// Flag names, defaults and usages.
+	databasePathName    = "databasePath"
+	databasePathDefault = ".obscuro/gateway_database.db"
+	databasePathUsage   = "The path for the wallet extension's database file. Default: .obscuro/gateway_database.db"
+
+	dbTypeFlagName    = "dbType"
+	dbTypeFlagDefault = "sqlite"
+	dbTypeFlagUsage   = "Defined the db type (sqlite or mariaDB)"
+
+	dbConnectionURLFlagName    = "dbConnectionURL"
+	dbConnectionURLFlagDefault = ""
+	dbConnectionURLFlagUsage   = "If dbType is set to mariaDB, this must be set. ex: obscurouser:password@tcp(127.0.0.1:3306)/ogdb"
+
+	masterKeyPathFlagName    = "masterKeyPath"
+	masterKeyPathFlagDefault = ".obscuro/gateway_master_key"
+	masterKeyPathFlagUsage   = "The path of the file holding the current master key. Overridden by the OBSCURO_GATEWAY_MASTER_KEY environment variable. Default: .obscuro/gateway_master_key"
+
+	newMasterKeyPathFlagName    = "newMasterKeyPath"
+	newMasterKeyPathFlagDefault = ""
+	newMasterKeyPathFlagUsage   = "The path of the file holding the new master key. A new master key is generated and written to the file if it doesn't exist."
+)
+
+func main() {
+	databasePath := flag.String(databasePathName, databasePathDefault, databasePathUsage)
+	dbType := flag.String(dbTypeFlagName, dbTypeFlagDefault, dbTypeFlagUsage)
+	dbConnectionURL := flag.String(dbConnectionURLFlagName, dbConnectionURLFlagDefault, dbConnectionURLFlagUsage)
+	masterKeyPath := flag.String(masterKeyPathFlagName, masterKeyPathFlagDefault, masterKeyPathFlagUsage)
+	newMasterKeyPath := flag.String(newMasterKeyPathFlagName, newMasterKeyPathFlagDefault, newMasterKeyPathFlagUsage)
+	flag.Parse()
+
+	err := rotateMasterKey(*dbType, *dbConnectionURL, *databasePath, *masterKeyPath, *newMasterKeyPath)
+	if err != nil {
+		fmt.Printf("Could not rotate the master key. Cause: %s\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Master key rotated. Restart the gateway with the master key in %s.\n", *newMasterKeyPath)
+}
+
+func rotateMasterKey(dbType, dbConnectionURL, databasePath, masterKeyPath, newMasterKeyPath string) error {
+	if newMasterKeyPath == "" {
+		return fmt.Errorf("the %s flag must be set", newMasterKeyPathFlagName)
+	}
+	masterKey, err := encryption.LoadMasterKey(masterKeyPath, false)
+	if err != nil {
+		return fmt.Errorf("could not load the current master key. Cause: %w", err)
+	}
+	newMasterKey, err := encryption.LoadMasterKeyFile(newMasterKeyPath, true)
+	if err != nil {
+		return fmt.Errorf("could not load the new master key. Cause: %w", err)
+	}
+	if bytes.Equal(masterKey, newMasterKey) {
+		return fmt.Errorf("the new master key is the same as the current one")
+	}
+
+	// opening the storage also encrypts any private key still stored in plaintext
+	databaseStorage, err := storage.New(dbType, dbConnectionURL, databasePath, masterKey)
+	if err != nil {
+		return fmt.Errorf("could not open the database. Cause: %w", err)
+	}
+	return databaseStorage.RotateMasterKey(newMasterKey)
+}
@@ -0,0 +1,184 @@
+package session
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/storage"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// NonceValidity - the nonces must be used in a signed message within this time
+	NonceValidity = 5 * time.Minute
+	// maxPendingNonces - the nonces issued after this many are pending are refused, so they can't exhaust the memory
+	maxPendingNonces = 100_000
+
+	nonceLen = 16
+	tokenLen = 32
+)
+
+var (
+	ErrInvalidSession = errors.New("invalid or expired session")
+	ErrUnknownNonce   = errors.New("nonce was not issued by the gateway, or it was already used")
+)
+
+// Manager issues the nonces of the Sign-In-With-Ethereum (EIP-4361) messages, and the session tokens of the users who
+// signed such a message with one of their registered accounts. The sessions are stored in the database, hashed, so they
+// can be revoked. The nonces are only held in memory, so a message must be signed in with the gateway that issued its
+// nonce. It is safe for concurrent use.
+type Manager struct {
+	storage storage.Storage
+	domain  string // The domain the messages must be addressed to
+	chainID int64
+	ttl     time.Duration // How long the sessions are valid for
+	nonces  map[string]time.Time
+	mutex   sync.Mutex
+	logger  gethlog.Logger
+}
+
+func NewManager(storage storage.Storage, domain string, chainID int64, ttl time.Duration, logger gethlog.Logger) *Manager {
+	return &Manager{
+		storage: storage,
+		domain:  domain,
+		chainID: chainID,
+		ttl:     ttl,
+		nonces:  make(map[string]time.Time),
+		logger:  logger,
+	}
+}
+
+// NewNonce issues a nonce to include in a Sign-In-With-Ethereum message
+func (m *Manager) NewNonce() (string, error) {
+	nonceBytes := make([]byte, nonceLen)
+	if _, err := rand.Read(nonceBytes); err != nil {
+		return "", fmt.Errorf("could not generate nonce. Cause: %w", err)
+	}
+	nonce := hex.EncodeToString(nonceBytes)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	now := time.Now()
+	if len(m.nonces) >= maxPendingNonces {
+		for pendingNonce, expiry := range m.nonces {
+			if now.After(expiry) {
+				delete(m.nonces, pendingNonce)
+			}
+		}
+		if len(m.nonces) >= maxPendingNonces {
+			return "", errors.New("too many nonces pending, try again later")
+		}
+	}
+	m.nonces[nonce] = now.Add(NonceValidity)
+	return nonce, nil
+}
+
+// SignIn verifies the signed Sign-In-With-Ethereum message and starts a session for the user that registered its
+// account. If the account is registered for several users, the message must select one with its request ID.
+// It returns the session token, the user and the expiry of the session.
+func (m *Manager) SignIn(text string, signature []byte) (string, string, time.Time, error) {
+	msg, err := ParseMessage(text)
+	if err != nil {
+		return "", "", time.Time{}, fmt.Errorf("invalid Sign-In-With-Ethereum message. Cause: %w", err)
+	}
+	now := time.Now()
+	if err = msg.Verify(text, signature, m.domain, m.chainID, now); err != nil {
+		return "", "", time.Time{}, fmt.Errorf("could not verify Sign-In-With-Ethereum message. Cause: %w", err)
+	}
+	if err = m.useNonce(msg.Nonce, now); err != nil {
+		return "", "", time.Time{}, err
+	}
+
+	hexUserID, err := m.userOfAccount(msg)
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+
+	expiry := now.Add(m.ttl)
+	if msg.ExpirationTime != nil && msg.ExpirationTime.Before(expiry) {
+		expiry = *msg.ExpirationTime
+	}
+	tokenBytes := make([]byte, tokenLen)
+	if _, err = rand.Read(tokenBytes); err != nil {
+		return "", "", time.Time{}, fmt.Errorf("could not generate session token. Cause: %w", err)
+	}
+	token := hex.EncodeToString(tokenBytes)
+
+	userID, _ := hex.DecodeString(hexUserID)
+	err = m.storage.AddSession(hashToken(token), common.SessionDB{UserID: userID, Expiry: expiry.Unix()}, now.Unix())
+	if err != nil {
+		return "", "", time.Time{}, fmt.Errorf("could not store session. Cause: %w", err)
+	}
+	m.logger.Info("user signed in with Ethereum", "userID", hexUserID, "account", msg.Address)
+	return token, hexUserID, expiry, nil
+}
+
+// UserID returns the user of the session, if it has not expired or been revoked
+func (m *Manager) UserID(token string) (string, error) {
+	session, err := m.storage.GetSession(hashToken(token))
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return "", ErrInvalidSession
+		}
+		return "", fmt.Errorf("could not get session. Cause: %w", err)
+	}
+	if time.Now().Unix() >= session.Expiry {
+		return "", ErrInvalidSession
+	}
+	return hex.EncodeToString(session.UserID), nil
+}
+
+// SignOut revokes the session
+func (m *Manager) SignOut(token string) error {
+	return m.storage.DeleteSession(hashToken(token))
+}
+
+func (m *Manager) useNonce(nonce string, now time.Time) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	expiry, found := m.nonces[nonce]
+	if !found {
+		return ErrUnknownNonce
+	}
+	delete(m.nonces, nonce)
+	if now.After(expiry) {
+		return errors.New("nonce has expired")
+	}
+	return nil
+}
+
+// userOfAccount returns the user the account of the message is registered for
+func (m *Manager) userOfAccount(msg *Message) (string, error) {
+	userIDs, err := m.storage.GetUsersOfAccount(msg.Address.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("could not get the users of account %s. Cause: %w", msg.Address, err)
+	}
+	for _, userID := range userIDs {
+		hexUserID := hex.EncodeToString(userID)
+		if (msg.RequestID == "" && len(userIDs) == 1) || msg.RequestID == hexUserID {
+			return hexUserID, nil
+		}
+	}
+	switch {
+	case len(userIDs) == 0:
+		return "", fmt.Errorf("account %s is not registered with the gateway", msg.Address)
+	case msg.RequestID == "":
+		return "", fmt.Errorf("account %s is registered for several users, the request ID must be set to the user ID", msg.Address)
+	default:
+		return "", fmt.Errorf("account %s is not registered for user %s", msg.Address, msg.RequestID)
+	}
+}
+
+// hashToken returns the hash of the session token stored in the database, so the tokens can't be read from it
+func hashToken(token string) []byte {
+	hash := sha256.Sum256([]byte(token))
+	return hash[:]
+}
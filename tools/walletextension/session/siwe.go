@@ -0,0 +1,159 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	siweHeaderSuffix = " wants you to sign in with your Ethereum account:"
+	siweVersion      = "1"
+
+	siweFieldURI            = "URI: "
+	siweFieldVersion        = "Version: "
+	siweFieldChainID        = "Chain ID: "
+	siweFieldNonce          = "Nonce: "
+	siweFieldIssuedAt       = "Issued At: "
+	siweFieldExpirationTime = "Expiration Time: "
+	siweFieldNotBefore      = "Not Before: "
+	siweFieldRequestID      = "Request ID: "
+	siweFieldResources      = "Resources:"
+
+	signatureLen = 65
+	// maxClockSkew - the issued-at and not-before times of the messages can be this far in the future
+	maxClockSkew = time.Minute
+)
+
+// Message is a Sign-In-With-Ethereum message, as defined by EIP-4361
+type Message struct {
+	Domain         string
+	Address        gethcommon.Address
+	Statement      string
+	URI            string
+	Version        string
+	ChainID        int64
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime *time.Time
+	NotBefore      *time.Time
+	RequestID      string
+	Resources      []string
+}
+
+// ParseMessage parses the text of a Sign-In-With-Ethereum message
+func ParseMessage(text string) (*Message, error) {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	if len(lines) < 2 || !strings.HasSuffix(lines[0], siweHeaderSuffix) {
+		return nil, errors.New("message does not start with the Sign-In-With-Ethereum header")
+	}
+
+	msg := &Message{Domain: strings.TrimSuffix(lines[0], siweHeaderSuffix)}
+	if !gethcommon.IsHexAddress(lines[1]) {
+		return nil, fmt.Errorf("invalid address %s", lines[1])
+	}
+	msg.Address = gethcommon.HexToAddress(lines[1])
+
+	var statement []string
+	var err error
+	inResources := false
+	for _, line := range lines[2:] {
+		switch {
+		case inResources && strings.HasPrefix(line, "- "):
+			msg.Resources = append(msg.Resources, strings.TrimPrefix(line, "- "))
+		case strings.HasPrefix(line, siweFieldURI):
+			msg.URI = strings.TrimPrefix(line, siweFieldURI)
+		case strings.HasPrefix(line, siweFieldVersion):
+			msg.Version = strings.TrimPrefix(line, siweFieldVersion)
+		case strings.HasPrefix(line, siweFieldChainID):
+			msg.ChainID, err = strconv.ParseInt(strings.TrimPrefix(line, siweFieldChainID), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid chain ID. Cause: %w", err)
+			}
+		case strings.HasPrefix(line, siweFieldNonce):
+			msg.Nonce = strings.TrimPrefix(line, siweFieldNonce)
+		case strings.HasPrefix(line, siweFieldIssuedAt):
+			msg.IssuedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(line, siweFieldIssuedAt))
+			if err != nil {
+				return nil, fmt.Errorf("invalid issued-at time. Cause: %w", err)
+			}
+		case strings.HasPrefix(line, siweFieldExpirationTime):
+			expirationTime, err := time.Parse(time.RFC3339, strings.TrimPrefix(line, siweFieldExpirationTime))
+			if err != nil {
+				return nil, fmt.Errorf("invalid expiration time. Cause: %w", err)
+			}
+			msg.ExpirationTime = &expirationTime
+		case strings.HasPrefix(line, siweFieldNotBefore):
+			notBefore, err := time.Parse(time.RFC3339, strings.TrimPrefix(line, siweFieldNotBefore))
+			if err != nil {
+				return nil, fmt.Errorf("invalid not-before time. Cause: %w", err)
+			}
+			msg.NotBefore = &notBefore
+		case strings.HasPrefix(line, siweFieldRequestID):
+			msg.RequestID = strings.TrimPrefix(line, siweFieldRequestID)
+		case line == siweFieldResources:
+			inResources = true
+		case msg.URI == "" && line != "":
+			// the statement is the only free text, between the address and the fields
+			statement = append(statement, line)
+		}
+	}
+	msg.Statement = strings.Join(statement, "\n")
+
+	switch {
+	case msg.URI == "":
+		return nil, errors.New("message has no URI")
+	case msg.Version != siweVersion:
+		return nil, fmt.Errorf("unsupported message version %s", msg.Version)
+	case len(msg.Nonce) < 8:
+		return nil, errors.New("message has no nonce of at least 8 characters")
+	case msg.IssuedAt.IsZero():
+		return nil, errors.New("message has no issued-at time")
+	}
+	return msg, nil
+}
+
+// Verify checks that the message is addressed to the domain and the chain, that it is valid at the given time, and that
+// the signature (produced with personal_sign) was produced by the account of the message
+func (m *Message) Verify(text string, signature []byte, domain string, chainID int64, now time.Time) error {
+	if m.Domain != domain {
+		return fmt.Errorf("message is for domain %s, expected %s", m.Domain, domain)
+	}
+	if m.ChainID != chainID {
+		return fmt.Errorf("message is for chain %d, expected %d", m.ChainID, chainID)
+	}
+	if m.IssuedAt.After(now.Add(maxClockSkew)) {
+		return errors.New("message is issued in the future")
+	}
+	if m.ExpirationTime != nil && !now.Before(*m.ExpirationTime) {
+		return errors.New("message has expired")
+	}
+	if m.NotBefore != nil && m.NotBefore.After(now.Add(maxClockSkew)) {
+		return errors.New("message is not valid yet")
+	}
+
+	if len(signature) != signatureLen {
+		return errors.New("incorrect signature length")
+	}
+	// We transform the V from 27/28 to 0/1, as produced by personal_sign.
+	sig := make([]byte, signatureLen)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pubKey, err := crypto.SigToPub(accounts.TextHash([]byte(text)), sig)
+	if err != nil {
+		return fmt.Errorf("could not recover the signer of the message. Cause: %w", err)
+	}
+	if crypto.PubkeyToAddress(*pubKey) != m.Address {
+		return errors.New("message was not signed by its account")
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package session
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	testDomain  = "gateway.obscu.ro"
+	testChainID = 777
+)
+
+func newTestMessage(address string, issuedAt time.Time, extraFields ...string) string {
+	lines := []string{
+		testDomain + siweHeaderSuffix,
+		address,
+		"",
+		"Sign in to the Obscuro gateway.",
+		"",
+		"URI: https://" + testDomain,
+		"Version: 1",
+		fmt.Sprintf("Chain ID: %d", testChainID),
+		"Nonce: 0123456789abcdef",
+		"Issued At: " + issuedAt.UTC().Format(time.RFC3339),
+	}
+	return strings.Join(append(lines, extraFields...), "\n")
+}
+
+func TestCanParseMessage(t *testing.T) {
+	issuedAt := time.Now().Truncate(time.Second)
+	text := newTestMessage("0x71C7656EC7ab88b098defB751B7401B5f6d8976F", issuedAt,
+		"Request ID: abc",
+		"Resources:",
+		"- https://obscu.ro/a",
+		"- https://obscu.ro/b",
+	)
+
+	msg, err := ParseMessage(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Domain != testDomain || msg.Statement != "Sign in to the Obscuro gateway." || msg.ChainID != testChainID {
+		t.Errorf("unexpected message fields: %+v", msg)
+	}
+	if !msg.IssuedAt.Equal(issuedAt) || msg.RequestID != "abc" || len(msg.Resources) != 2 {
+		t.Errorf("unexpected message fields: %+v", msg)
+	}
+}
+
+func TestCannotParseMessageWithoutNonce(t *testing.T) {
+	text := strings.Replace(newTestMessage("0x71C7656EC7ab88b098defB751B7401B5f6d8976F", time.Now()), "Nonce: 0123456789abcdef", "Nonce: 1", 1)
+	if _, err := ParseMessage(text); err == nil {
+		t.Fatal("expected the message with a short nonce to be refused")
+	}
+}
+
+func TestVerifyChecksSignatureDomainAndTime(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	text := newTestMessage(crypto.PubkeyToAddress(key.PublicKey).Hex(), now, "Expiration Time: "+now.Add(time.Hour).UTC().Format(time.RFC3339))
+	signature, err := crypto.Sign(accounts.TextHash([]byte(text)), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+
+	msg, err := ParseMessage(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = msg.Verify(text, signature, testDomain, testChainID, now); err != nil {
+		t.Fatalf("expected the message to be verified, got %s", err)
+	}
+	if err = msg.Verify(text, signature, "evil.com", testChainID, now); err == nil {
+		t.Error("expected the message for another domain to be refused")
+	}
+	if err = msg.Verify(text, signature, testDomain, testChainID+1, now); err == nil {
+		t.Error("expected the message for another chain to be refused")
+	}
+	if err = msg.Verify(text, signature, testDomain, testChainID, now.Add(2*time.Hour)); err == nil {
+		t.Error("expected the expired message to be refused")
+	}
+
+	otherKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherSignature, err := crypto.Sign(accounts.TextHash([]byte(text)), otherKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = msg.Verify(text, otherSignature, testDomain, testChainID, now); err == nil {
+		t.Error("expected the message signed by another account to be refused")
+	}
+}
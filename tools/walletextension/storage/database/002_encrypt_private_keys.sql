@@ -0,0 +1,3 @@
+-- The private keys are encrypted at rest, which makes them larger than the 32 bytes of a plaintext key.
+-- The gateway encrypts the existing plaintext keys in place when it starts.
+ALTER TABLE ogdb.users MODIFY private_key varbinary(256);
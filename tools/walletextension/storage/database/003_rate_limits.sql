@@ -0,0 +1,13 @@
+-- The rate limits set by the admins for some users, and the compute units used by each user per day (UTC).
+CREATE TABLE IF NOT EXISTS ogdb.user_limits (
+    user_id varbinary(32) PRIMARY KEY,
+    compute_units_per_second double,
+    burst bigint unsigned,
+    daily_quota bigint unsigned
+    );
+CREATE TABLE IF NOT EXISTS ogdb.compute_units (
+    user_id varbinary(32),
+    day char(10),
+    used bigint unsigned,
+    PRIMARY KEY (user_id, day)
+    );
@@ -0,0 +1,3 @@
+-- The EIP-712 signatures of the viewing keys are stored with the issued-at and expiry timestamps of the signed message,
+-- which makes them larger than the 65 bytes of a personal-sign signature.
+ALTER TABLE ogdb.accounts MODIFY signature varbinary(81);
@@ -0,0 +1,8 @@
+-- The sessions of the users who signed in with Ethereum (EIP-4361). Only the hashes of the session tokens are stored,
+-- and the expiry is a unix timestamp in seconds.
+CREATE TABLE IF NOT EXISTS ogdb.sessions (
+    token_hash binary(32) PRIMARY KEY,
+    user_id varbinary(32),
+    expiry bigint,
+    INDEX (user_id)
+    );
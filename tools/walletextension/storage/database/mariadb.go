@@ -7,30 +7,43 @@ import (
 	_ "github.com/go-sql-driver/mysql" // Importing MariaDB driver
 	"github.com/obscuronet/go-obscuro/go/common/errutil"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/storage/encryption"
 )
 
 type MariaDB struct {
-	db *sql.DB
+	db        *sql.DB
+	encryptor *encryption.Encryptor // encrypts the private keys of the users at rest
 }
 
 // NewMariaDB creates a new MariaDB connection instance
-func NewMariaDB(dbURL string) (*MariaDB, error) {
+func NewMariaDB(dbURL string, encryptor *encryption.Encryptor) (*MariaDB, error) {
 	db, err := sql.Open("mysql", dbURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
-	return &MariaDB{db: db}, nil
+	// encrypt the private keys stored before the encryption at rest was introduced (see 002_encrypt_private_keys.sql)
+	err = encryptPlaintextPrivateKeys(db, encryptor)
+	if err != nil {
+		return nil, fmt.Errorf("could not encrypt the stored private keys. Cause: %w", err)
+	}
+
+	return &MariaDB{db: db, encryptor: encryptor}, nil
 }
 
 func (m *MariaDB) AddUser(userID []byte, privateKey []byte) error {
+	encryptedPrivateKey, err := m.encryptor.Encrypt(userID, privateKey)
+	if err != nil {
+		return fmt.Errorf("could not encrypt private key. Cause: %w", err)
+	}
+
 	stmt, err := m.db.Prepare("REPLACE INTO users(user_id, private_key) VALUES (?, ?)")
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
 
-	_, err = stmt.Exec(userID, privateKey)
+	_, err = stmt.Exec(userID, encryptedPrivateKey)
 	if err != nil {
 		return err
 	}
@@ -39,18 +52,26 @@ func (m *MariaDB) AddUser(userID []byte, privateKey []byte) error {
 }
 
 func (m *MariaDB) DeleteUser(userID []byte) error {
-	stmt, err := m.db.Prepare("DELETE FROM users WHERE user_id = ?")
+	tx, err := m.db.Begin()
 	if err != nil {
 		return err
 	}
-	defer stmt.Close()
+	defer tx.Rollback() //nolint:errcheck
 
-	_, err = stmt.Exec(userID)
+	_, err = tx.Exec("DELETE FROM users WHERE user_id = ?", userID)
+	if err != nil {
+		return err
+	}
+	err = deleteUserUsage(tx, userID)
+	if err != nil {
+		return err
+	}
+	err = deleteUserSessions(tx, userID)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return tx.Commit()
 }
 
 func (m *MariaDB) GetUserPrivateKey(userID []byte) ([]byte, error) {
@@ -64,7 +85,7 @@ func (m *MariaDB) GetUserPrivateKey(userID []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	return privateKey, nil
+	return m.encryptor.Decrypt(userID, privateKey)
 }
 
 func (m *MariaDB) AddAccount(userID []byte, accountAddress []byte, signature []byte) error {
@@ -106,25 +127,63 @@ func (m *MariaDB) GetAccounts(userID []byte) ([]common.AccountDB, error) {
 }
 
 func (m *MariaDB) GetAllUsers() ([]common.UserDB, error) {
-	rows, err := m.db.Query("SELECT user_id, private_key FROM users")
+	users, err := queryUsers(m.db)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
+	return decryptUsers(m.encryptor, users)
+}
 
-	var users []common.UserDB
-	for rows.Next() {
-		var user common.UserDB
-		err = rows.Scan(&user.UserID, &user.PrivateKey)
-		if err != nil {
-			return nil, err
-		}
-		users = append(users, user)
-	}
+func (m *MariaDB) GetAllUserAccounts() ([]common.UserAccountsDB, error) {
+	return getAllUserAccounts(m.db)
+}
 
-	if err = rows.Err(); err != nil {
-		return nil, err
-	}
+func (m *MariaDB) GetUserLimits(userID []byte) (*common.UserLimitsDB, error) {
+	return getUserLimits(m.db, userID)
+}
+
+func (m *MariaDB) SetUserLimits(userID []byte, limits common.UserLimitsDB) error {
+	return setUserLimits(m.db, userID, limits)
+}
+
+func (m *MariaDB) DeleteUserLimits(userID []byte) error {
+	return deleteUserLimits(m.db, userID)
+}
+
+func (m *MariaDB) AddComputeUnits(userID []byte, day string, units uint64) error {
+	_, err := m.db.Exec(mariaDBAddComputeUnitsQuery, userID, day, units)
+	return err
+}
+
+func (m *MariaDB) GetComputeUnits(userID []byte, day string) (uint64, error) {
+	return getComputeUnits(m.db, userID, day)
+}
 
-	return users, nil
+func (m *MariaDB) GetUsersOfAccount(accountAddress []byte) ([][]byte, error) {
+	return getUsersOfAccount(m.db, accountAddress)
+}
+
+func (m *MariaDB) AddSession(tokenHash []byte, session common.SessionDB, now int64) error {
+	return addSession(m.db, tokenHash, session, now)
+}
+
+func (m *MariaDB) GetSession(tokenHash []byte) (*common.SessionDB, error) {
+	return getSession(m.db, tokenHash)
+}
+
+func (m *MariaDB) DeleteSession(tokenHash []byte) error {
+	return deleteSession(m.db, tokenHash)
+}
+
+// RotateMasterKey re-wraps the data keys of the private keys with the new master key. The gateway must not be running.
+func (m *MariaDB) RotateMasterKey(newMasterKey []byte) error {
+	newEncryptor, err := encryption.NewEncryptor(newMasterKey)
+	if err != nil {
+		return err
+	}
+	if err = rotateMasterKey(m.db, m.encryptor, newEncryptor); err != nil {
+		return err
+	}
+	m.encryptor = newEncryptor
+	return nil
 }
@@ -0,0 +1,97 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/storage/encryption"
+)
+
+// encryptPlaintextPrivateKeys encrypts in place the private keys stored before the encryption at rest was introduced
+func encryptPlaintextPrivateKeys(db *sql.DB, encryptor *encryption.Encryptor) error {
+	return updatePrivateKeys(db, func(user common.UserDB) ([]byte, error) {
+		if encryption.IsEncrypted(user.PrivateKey) {
+			return nil, nil
+		}
+		return encryptor.Encrypt(user.UserID, user.PrivateKey)
+	})
+}
+
+// rotateMasterKey re-wraps the data key of every private key with the new master key
+func rotateMasterKey(db *sql.DB, encryptor *encryption.Encryptor, newEncryptor *encryption.Encryptor) error {
+	return updatePrivateKeys(db, func(user common.UserDB) ([]byte, error) {
+		return encryptor.Rewrap(user.UserID, user.PrivateKey, newEncryptor)
+	})
+}
+
+// updatePrivateKeys replaces the stored private key of each user with the value returned by update, in a single transaction.
+// The users for which update returns nil are left unchanged.
+func updatePrivateKeys(db *sql.DB, update func(user common.UserDB) ([]byte, error)) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	users, err := queryUsers(tx)
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		privateKey, err := update(user)
+		if err != nil {
+			return fmt.Errorf("could not update private key of user %x. Cause: %w", user.UserID, err)
+		}
+		if privateKey == nil {
+			continue
+		}
+		_, err = tx.Exec("UPDATE users SET private_key = ? WHERE user_id = ?", privateKey, user.UserID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// decryptUsers returns the users with their private keys decrypted
+func decryptUsers(encryptor *encryption.Encryptor, users []common.UserDB) ([]common.UserDB, error) {
+	for i := range users {
+		privateKey, err := encryptor.Decrypt(users[i].UserID, users[i].PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt private key of user %x. Cause: %w", users[i].UserID, err)
+		}
+		users[i].PrivateKey = privateKey
+	}
+	return users, nil
+}
+
+type querier interface {
+	Query(query string, args ...any) (*sql.Rows, error)
+}
+
+func queryUsers(q querier) ([]common.UserDB, error) {
+	rows, err := q.Query("SELECT user_id, private_key FROM users")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []common.UserDB
+	for rows.Next() {
+		var user common.UserDB
+		err = rows.Scan(&user.UserID, &user.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
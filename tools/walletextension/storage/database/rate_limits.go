@@ -0,0 +1,62 @@
+package database
+
+import (
+	"database/sql"
+
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
+)
+
+// The queries adding compute units to the daily usage of a user, which differ between the databases
+const (
+	sqliteAddComputeUnitsQuery = "INSERT INTO compute_units(user_id, day, used) VALUES (?, ?, ?) " +
+		"ON CONFLICT(user_id, day) DO UPDATE SET used = used + excluded.used"
+	mariaDBAddComputeUnitsQuery = "INSERT INTO compute_units(user_id, day, used) VALUES (?, ?, ?) " +
+		"ON DUPLICATE KEY UPDATE used = used + VALUES(used)"
+)
+
+func getUserLimits(db *sql.DB, userID []byte) (*common.UserLimitsDB, error) {
+	var limits common.UserLimitsDB
+	err := db.QueryRow("SELECT compute_units_per_second, burst, daily_quota FROM user_limits WHERE user_id = ?", userID).
+		Scan(&limits.ComputeUnitsPerSecond, &limits.Burst, &limits.DailyQuota)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errutil.ErrNotFound
+		}
+		return nil, err
+	}
+	return &limits, nil
+}
+
+func setUserLimits(db *sql.DB, userID []byte, limits common.UserLimitsDB) error {
+	_, err := db.Exec("REPLACE INTO user_limits(user_id, compute_units_per_second, burst, daily_quota) VALUES (?, ?, ?, ?)",
+		userID, limits.ComputeUnitsPerSecond, limits.Burst, limits.DailyQuota)
+	return err
+}
+
+func deleteUserLimits(db *sql.DB, userID []byte) error {
+	_, err := db.Exec("DELETE FROM user_limits WHERE user_id = ?", userID)
+	return err
+}
+
+func getComputeUnits(db *sql.DB, userID []byte, day string) (uint64, error) {
+	var used uint64
+	err := db.QueryRow("SELECT used FROM compute_units WHERE user_id = ? AND day = ?", userID, day).Scan(&used)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return used, nil
+}
+
+// deleteUserUsage removes the rate limits and the usage of a user being deleted
+func deleteUserUsage(tx *sql.Tx, userID []byte) error {
+	_, err := tx.Exec("DELETE FROM user_limits WHERE user_id = ?", userID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("DELETE FROM compute_units WHERE user_id = ?", userID)
+	return err
+}
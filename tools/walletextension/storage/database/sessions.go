@@ -0,0 +1,60 @@
+package database
+
+import (
+	"database/sql"
+
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
+)
+
+// addSession stores the session, and removes the sessions that expired before the given time
+func addSession(db *sql.DB, tokenHash []byte, session common.SessionDB, now int64) error {
+	_, err := db.Exec("DELETE FROM sessions WHERE expiry < ?", now)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec("INSERT INTO sessions(token_hash, user_id, expiry) VALUES (?, ?, ?)", tokenHash, session.UserID, session.Expiry)
+	return err
+}
+
+func getSession(db *sql.DB, tokenHash []byte) (*common.SessionDB, error) {
+	var session common.SessionDB
+	err := db.QueryRow("SELECT user_id, expiry FROM sessions WHERE token_hash = ?", tokenHash).Scan(&session.UserID, &session.Expiry)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errutil.ErrNotFound
+		}
+		return nil, err
+	}
+	return &session, nil
+}
+
+func deleteSession(db *sql.DB, tokenHash []byte) error {
+	_, err := db.Exec("DELETE FROM sessions WHERE token_hash = ?", tokenHash)
+	return err
+}
+
+// deleteUserSessions revokes the sessions of a user being deleted
+func deleteUserSessions(tx *sql.Tx, userID []byte) error {
+	_, err := tx.Exec("DELETE FROM sessions WHERE user_id = ?", userID)
+	return err
+}
+
+// getUsersOfAccount returns the users the account is registered for
+func getUsersOfAccount(db *sql.DB, accountAddress []byte) ([][]byte, error) {
+	rows, err := db.Query("SELECT DISTINCT user_id FROM accounts WHERE account_address = ?", accountAddress)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs [][]byte
+	for rows.Next() {
+		var userID []byte
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, rows.Err()
+}
@@ -11,13 +11,15 @@ import (
 
 	_ "github.com/mattn/go-sqlite3" // sqlite driver for sql.Open()
 	common "github.com/obscuronet/go-obscuro/tools/walletextension/common"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/storage/encryption"
 )
 
 type SqliteDatabase struct {
-	db *sql.DB
+	db        *sql.DB
+	encryptor *encryption.Encryptor // encrypts the private keys of the users at rest
 }
 
-func NewSqliteDatabase(dbPath string) (*SqliteDatabase, error) {
+func NewSqliteDatabase(dbPath string, encryptor *encryption.Encryptor) (*SqliteDatabase, error) {
 	// load the db file
 	dbFilePath, err := createOrLoad(dbPath)
 	if err != nil {
@@ -40,7 +42,7 @@ func NewSqliteDatabase(dbPath string) (*SqliteDatabase, error) {
 	// create users table
 	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS users (
 		user_id binary(32) PRIMARY KEY,
-		private_key binary(32)
+		private_key varbinary(256)
 	);`)
 
 	if err != nil {
@@ -51,7 +53,7 @@ func NewSqliteDatabase(dbPath string) (*SqliteDatabase, error) {
 	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS accounts (
 		user_id binary(32),
 		account_address binary(20),
-		signature binary(65),
+		signature varbinary(81),
     	FOREIGN KEY(user_id) REFERENCES users(user_id) ON DELETE CASCADE
 	);`)
 
@@ -59,17 +61,62 @@ func NewSqliteDatabase(dbPath string) (*SqliteDatabase, error) {
 		return nil, err
 	}
 
-	return &SqliteDatabase{db: db}, nil
+	// create the tables of the rate limits set by the admins, and of the daily usage of the users
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS user_limits (
+		user_id binary(32) PRIMARY KEY,
+		compute_units_per_second double,
+		burst bigint,
+		daily_quota bigint
+	);`)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS compute_units (
+		user_id binary(32),
+		day char(10),
+		used bigint,
+		PRIMARY KEY (user_id, day)
+	);`)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// create the table of the sessions of the users who signed in with Ethereum
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS sessions (
+		token_hash binary(32) PRIMARY KEY,
+		user_id binary(32),
+		expiry bigint
+	);`)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// encrypt the private keys stored before the encryption at rest was introduced
+	err = encryptPlaintextPrivateKeys(db, encryptor)
+	if err != nil {
+		return nil, fmt.Errorf("could not encrypt the stored private keys. Cause: %w", err)
+	}
+
+	return &SqliteDatabase{db: db, encryptor: encryptor}, nil
 }
 
 func (s *SqliteDatabase) AddUser(userID []byte, privateKey []byte) error {
+	encryptedPrivateKey, err := s.encryptor.Encrypt(userID, privateKey)
+	if err != nil {
+		return fmt.Errorf("could not encrypt private key. Cause: %w", err)
+	}
+
 	stmt, err := s.db.Prepare("INSERT OR REPLACE INTO users(user_id, private_key) VALUES (?, ?)")
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
 
-	_, err = stmt.Exec(userID, privateKey)
+	_, err = stmt.Exec(userID, encryptedPrivateKey)
 	if err != nil {
 		return err
 	}
@@ -78,18 +125,26 @@ func (s *SqliteDatabase) AddUser(userID []byte, privateKey []byte) error {
 }
 
 func (s *SqliteDatabase) DeleteUser(userID []byte) error {
-	stmt, err := s.db.Prepare("DELETE FROM users WHERE user_id = ?")
+	tx, err := s.db.Begin()
 	if err != nil {
 		return err
 	}
-	defer stmt.Close()
+	defer tx.Rollback() //nolint:errcheck
 
-	_, err = stmt.Exec(userID)
+	_, err = tx.Exec("DELETE FROM users WHERE user_id = ?", userID)
+	if err != nil {
+		return err
+	}
+	err = deleteUserUsage(tx, userID)
+	if err != nil {
+		return err
+	}
+	err = deleteUserSessions(tx, userID)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return tx.Commit()
 }
 
 func (s *SqliteDatabase) GetUserPrivateKey(userID []byte) ([]byte, error) {
@@ -103,7 +158,7 @@ func (s *SqliteDatabase) GetUserPrivateKey(userID []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	return privateKey, nil
+	return s.encryptor.Decrypt(userID, privateKey)
 }
 
 func (s *SqliteDatabase) AddAccount(userID []byte, accountAddress []byte, signature []byte) error {
@@ -144,27 +199,65 @@ func (s *SqliteDatabase) GetAccounts(userID []byte) ([]common.AccountDB, error)
 }
 
 func (s *SqliteDatabase) GetAllUsers() ([]common.UserDB, error) {
-	rows, err := s.db.Query("SELECT user_id, private_key FROM users")
+	users, err := queryUsers(s.db)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
+	return decryptUsers(s.encryptor, users)
+}
 
-	var users []common.UserDB
-	for rows.Next() {
-		var user common.UserDB
-		err = rows.Scan(&user.UserID, &user.PrivateKey)
-		if err != nil {
-			return nil, err
-		}
-		users = append(users, user)
-	}
+func (s *SqliteDatabase) GetAllUserAccounts() ([]common.UserAccountsDB, error) {
+	return getAllUserAccounts(s.db)
+}
 
-	if err = rows.Err(); err != nil {
-		return nil, err
-	}
+func (s *SqliteDatabase) GetUserLimits(userID []byte) (*common.UserLimitsDB, error) {
+	return getUserLimits(s.db, userID)
+}
+
+func (s *SqliteDatabase) SetUserLimits(userID []byte, limits common.UserLimitsDB) error {
+	return setUserLimits(s.db, userID, limits)
+}
+
+func (s *SqliteDatabase) DeleteUserLimits(userID []byte) error {
+	return deleteUserLimits(s.db, userID)
+}
+
+func (s *SqliteDatabase) AddComputeUnits(userID []byte, day string, units uint64) error {
+	_, err := s.db.Exec(sqliteAddComputeUnitsQuery, userID, day, units)
+	return err
+}
+
+func (s *SqliteDatabase) GetComputeUnits(userID []byte, day string) (uint64, error) {
+	return getComputeUnits(s.db, userID, day)
+}
+
+func (s *SqliteDatabase) GetUsersOfAccount(accountAddress []byte) ([][]byte, error) {
+	return getUsersOfAccount(s.db, accountAddress)
+}
+
+func (s *SqliteDatabase) AddSession(tokenHash []byte, session common.SessionDB, now int64) error {
+	return addSession(s.db, tokenHash, session, now)
+}
+
+func (s *SqliteDatabase) GetSession(tokenHash []byte) (*common.SessionDB, error) {
+	return getSession(s.db, tokenHash)
+}
 
-	return users, nil
+func (s *SqliteDatabase) DeleteSession(tokenHash []byte) error {
+	return deleteSession(s.db, tokenHash)
+}
+
+// RotateMasterKey re-wraps the data keys of the private keys with the new master key. The gateway must not be running.
+func (s *SqliteDatabase) RotateMasterKey(newMasterKey []byte) error {
+	newEncryptor, err := encryption.NewEncryptor(newMasterKey)
+	if err != nil {
+		return err
+	}
+	if err = rotateMasterKey(s.db, s.encryptor, newEncryptor); err != nil {
+		return err
+	}
+	s.encryptor = newEncryptor
+	return nil
 }
 
 func createOrLoad(dbPath string) (string, error) {
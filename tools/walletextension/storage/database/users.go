@@ -0,0 +1,36 @@
+package database
+
+import (
+	"bytes"
+	"database/sql"
+
+	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
+)
+
+// getAllUserAccounts returns the users with the addresses of their accounts. The private keys are not read, so they
+// are never decrypted.
+func getAllUserAccounts(db *sql.DB) ([]common.UserAccountsDB, error) {
+	rows, err := db.Query("SELECT users.user_id, accounts.account_address FROM users " +
+		"LEFT JOIN accounts ON accounts.user_id = users.user_id ORDER BY users.user_id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []common.UserAccountsDB
+	for rows.Next() {
+		var userID, accountAddress []byte
+		if err := rows.Scan(&userID, &accountAddress); err != nil {
+			return nil, err
+		}
+		if len(users) == 0 || !bytes.Equal(users[len(users)-1].UserID, userID) {
+			users = append(users, common.UserAccountsDB{UserID: userID})
+		}
+		// the users without accounts have a single row with a NULL address
+		if accountAddress != nil {
+			last := &users[len(users)-1]
+			last.AccountAddresses = append(last.AccountAddresses, accountAddress)
+		}
+	}
+	return users, rows.Err()
+}
@@ -0,0 +1,216 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// MasterKeyEnvVar - the environment variable holding the hex-encoded master key. It takes precedence over the master key file.
+const MasterKeyEnvVar = "OBSCURO_GATEWAY_MASTER_KEY"
+
+const (
+	keySize       = 32 // AES-256
+	nonceSize     = 12 // the standard GCM nonce size
+	tagSize       = 16 // the standard GCM tag size
+	formatVersion = byte(1)
+
+	wrappedDataKeySize = nonceSize + keySize + tagSize
+	headerSize         = 1 + wrappedDataKeySize
+	// minEncryptedSize - the size of an encrypted empty value. The private keys are 32 bytes long, so they can't be mistaken for encrypted values.
+	minEncryptedSize = headerSize + nonceSize + tagSize
+)
+
+// Encryptor encrypts the values stored by the gateway with envelope encryption.
+// Each value is encrypted with its own random data key, and the data key is encrypted (wrapped) with the master key.
+// The user ID is used as associated data for both, so an encrypted value cannot be moved to another user's row.
+//
+// An encrypted value has the following layout:
+// version (1 byte) | data key nonce | wrapped data key + tag | value nonce | encrypted value + tag
+type Encryptor struct {
+	masterKey cipher.AEAD
+}
+
+func NewEncryptor(masterKey []byte) (*Encryptor, error) {
+	if len(masterKey) != keySize {
+		return nil, fmt.Errorf("master key must be %d bytes long, got %d bytes", keySize, len(masterKey))
+	}
+	aead, err := newAEAD(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Encryptor{masterKey: aead}, nil
+}
+
+// Encrypt encrypts the value of the given user with a new data key
+func (e *Encryptor) Encrypt(userID []byte, plaintext []byte) ([]byte, error) {
+	dataKey, err := randomBytes(keySize)
+	if err != nil {
+		return nil, err
+	}
+	dataKeyAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := e.wrapDataKey(userID, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := randomBytes(nonceSize)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted := make([]byte, 0, len(header)+nonceSize+len(plaintext)+tagSize)
+	encrypted = append(encrypted, header...)
+	encrypted = append(encrypted, nonce...)
+	return dataKeyAEAD.Seal(encrypted, nonce, plaintext, userID), nil
+}
+
+// Decrypt decrypts the value of the given user
+func (e *Encryptor) Decrypt(userID []byte, encrypted []byte) ([]byte, error) {
+	if !IsEncrypted(encrypted) {
+		return nil, errors.New("value is not encrypted")
+	}
+	dataKey, err := e.unwrapDataKey(userID, encrypted[:headerSize])
+	if err != nil {
+		return nil, err
+	}
+	dataKeyAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := encrypted[headerSize : headerSize+nonceSize]
+	plaintext, err := dataKeyAEAD.Open(nil, nonce, encrypted[headerSize+nonceSize:], userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt value. Cause: %w", err)
+	}
+	return plaintext, nil
+}
+
+// Rewrap re-encrypts the data key of the value with the master key of the given encryptor.
+// The value itself is not re-encrypted, as its data key doesn't change.
+func (e *Encryptor) Rewrap(userID []byte, encrypted []byte, newEncryptor *Encryptor) ([]byte, error) {
+	if !IsEncrypted(encrypted) {
+		return nil, errors.New("value is not encrypted")
+	}
+	dataKey, err := e.unwrapDataKey(userID, encrypted[:headerSize])
+	if err != nil {
+		return nil, err
+	}
+	header, err := newEncryptor.wrapDataKey(userID, dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rewrapped := make([]byte, 0, len(encrypted))
+	rewrapped = append(rewrapped, header...)
+	return append(rewrapped, encrypted[headerSize:]...), nil
+}
+
+// IsEncrypted returns whether the value was produced by an Encryptor, as opposed to a plaintext value stored before the
+// encryption was introduced
+func IsEncrypted(value []byte) bool {
+	return len(value) >= minEncryptedSize && value[0] == formatVersion
+}
+
+func (e *Encryptor) wrapDataKey(userID []byte, dataKey []byte) ([]byte, error) {
+	nonce, err := randomBytes(nonceSize)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, 0, headerSize)
+	header = append(header, formatVersion)
+	header = append(header, nonce...)
+	return e.masterKey.Seal(header, nonce, dataKey, userID), nil
+}
+
+func (e *Encryptor) unwrapDataKey(userID []byte, header []byte) ([]byte, error) {
+	nonce := header[1 : 1+nonceSize]
+	dataKey, err := e.masterKey.Open(nil, nonce, header[1+nonceSize:], userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt data key, the master key may be wrong. Cause: %w", err)
+	}
+	return dataKey, nil
+}
+
+// GenerateMasterKey returns a new random master key
+func GenerateMasterKey() ([]byte, error) {
+	return randomBytes(keySize)
+}
+
+// LoadMasterKey returns the master key held by the MasterKeyEnvVar environment variable if it is set, or the one in the
+// file at the given path otherwise. Both hold the key hex-encoded.
+// If createIfMissing is set and the file doesn't exist, a new master key is generated and written to the file.
+func LoadMasterKey(path string, createIfMissing bool) ([]byte, error) {
+	if hexKey, found := os.LookupEnv(MasterKeyEnvVar); found {
+		return decodeMasterKey(hexKey)
+	}
+	return LoadMasterKeyFile(path, createIfMissing)
+}
+
+// LoadMasterKeyFile - same as LoadMasterKey, ignoring the environment variable
+func LoadMasterKeyFile(path string, createIfMissing bool) ([]byte, error) {
+	if path == "" {
+		return nil, fmt.Errorf("no master key provided, either set %s or provide a master key file", MasterKeyEnvVar)
+	}
+
+	hexKey, err := os.ReadFile(path)
+	if err == nil {
+		return decodeMasterKey(string(hexKey))
+	}
+	if !errors.Is(err, os.ErrNotExist) || !createIfMissing {
+		return nil, fmt.Errorf("could not read master key file %s. Cause: %w", path, err)
+	}
+
+	masterKey, err := GenerateMasterKey()
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("could not create master key directory. Cause: %w", err)
+	}
+	if err = os.WriteFile(path, []byte(hex.EncodeToString(masterKey)), 0o600); err != nil {
+		return nil, fmt.Errorf("could not write master key file %s. Cause: %w", path, err)
+	}
+	return masterKey, nil
+}
+
+func decodeMasterKey(hexKey string) ([]byte, error) {
+	masterKey, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(hexKey), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("master key is not hex-encoded. Cause: %w", err)
+	}
+	if len(masterKey) != keySize {
+		return nil, fmt.Errorf("master key must be %d bytes long, got %d bytes", keySize, len(masterKey))
+	}
+	return masterKey, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("could not create cipher. Cause: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("could not create AEAD. Cause: %w", err)
+	}
+	return aead, nil
+}
+
+func randomBytes(size int) ([]byte, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("could not generate random bytes. Cause: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,88 @@
+package encryption
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptionIsBoundToTheUser(t *testing.T) {
+	encryptor := newTestEncryptor(t)
+	userID := []byte("userID")
+	privateKey := []byte("0123456789abcdef0123456789abcdef")
+
+	encrypted, err := encryptor.Encrypt(userID, privateKey)
+	require.NoError(t, err)
+	require.True(t, IsEncrypted(encrypted))
+	require.False(t, IsEncrypted(privateKey))
+
+	decrypted, err := encryptor.Decrypt(userID, encrypted)
+	require.NoError(t, err)
+	require.Equal(t, privateKey, decrypted)
+
+	// a value copied to the row of another user cannot be decrypted
+	_, err = encryptor.Decrypt([]byte("anotherUserID"), encrypted)
+	require.Error(t, err)
+
+	// neither can a value encrypted with another master key
+	_, err = newTestEncryptor(t).Decrypt(userID, encrypted)
+	require.Error(t, err)
+}
+
+func TestRewrapOnlyChangesTheMasterKey(t *testing.T) {
+	oldEncryptor := newTestEncryptor(t)
+	newEncryptor := newTestEncryptor(t)
+	userID := []byte("userID")
+	privateKey := []byte("0123456789abcdef0123456789abcdef")
+
+	encrypted, err := oldEncryptor.Encrypt(userID, privateKey)
+	require.NoError(t, err)
+	rewrapped, err := oldEncryptor.Rewrap(userID, encrypted, newEncryptor)
+	require.NoError(t, err)
+
+	// the encrypted value is unchanged, only the wrapped data key is
+	require.Equal(t, encrypted[headerSize:], rewrapped[headerSize:])
+
+	decrypted, err := newEncryptor.Decrypt(userID, rewrapped)
+	require.NoError(t, err)
+	require.Equal(t, privateKey, decrypted)
+	_, err = oldEncryptor.Decrypt(userID, rewrapped)
+	require.Error(t, err)
+}
+
+func TestLoadMasterKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "master_key")
+
+	_, err := LoadMasterKey(path, false)
+	require.Error(t, err)
+
+	// the key file is created on first use, and read afterwards
+	createdKey, err := LoadMasterKey(path, true)
+	require.NoError(t, err)
+	loadedKey, err := LoadMasterKey(path, false)
+	require.NoError(t, err)
+	require.Equal(t, createdKey, loadedKey)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// the environment variable takes precedence over the file
+	envKey, err := GenerateMasterKey()
+	require.NoError(t, err)
+	t.Setenv(MasterKeyEnvVar, hex.EncodeToString(envKey))
+	loadedKey, err = LoadMasterKey(path, false)
+	require.NoError(t, err)
+	require.Equal(t, envKey, loadedKey)
+}
+
+func newTestEncryptor(t *testing.T) *Encryptor {
+	masterKey, err := GenerateMasterKey()
+	require.NoError(t, err)
+	encryptor, err := NewEncryptor(masterKey)
+	require.NoError(t, err)
+	return encryptor
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/storage/database"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/storage/encryption"
 )
 
 type Storage interface {
@@ -14,14 +15,32 @@ type Storage interface {
 	AddAccount(userID []byte, accountAddress []byte, signature []byte) error
 	GetAccounts(userID []byte) ([]common.AccountDB, error)
 	GetAllUsers() ([]common.UserDB, error)
+	GetAllUserAccounts() ([]common.UserAccountsDB, error)
+	RotateMasterKey(newMasterKey []byte) error
+	GetUserLimits(userID []byte) (*common.UserLimitsDB, error)
+	SetUserLimits(userID []byte, limits common.UserLimitsDB) error
+	DeleteUserLimits(userID []byte) error
+	AddComputeUnits(userID []byte, day string, units uint64) error
+	GetComputeUnits(userID []byte, day string) (uint64, error)
+	GetUsersOfAccount(accountAddress []byte) ([][]byte, error)
+	AddSession(tokenHash []byte, session common.SessionDB, now int64) error
+	GetSession(tokenHash []byte) (*common.SessionDB, error)
+	DeleteSession(tokenHash []byte) error
 }
 
-func New(dbType string, dbConnectionURL, dbPath string) (Storage, error) {
+// New opens the storage of the given type. The private keys of the users are encrypted at rest with the master key,
+// and the private keys stored in plaintext by previous versions are encrypted in place.
+func New(dbType string, dbConnectionURL, dbPath string, masterKey []byte) (Storage, error) {
+	encryptor, err := encryption.NewEncryptor(masterKey)
+	if err != nil {
+		return nil, err
+	}
+
 	switch dbType {
 	case "mariaDB":
-		return database.NewMariaDB(dbConnectionURL)
+		return database.NewMariaDB(dbConnectionURL, encryptor)
 	case "sqlite":
-		return database.NewSqliteDatabase(dbPath)
+		return database.NewSqliteDatabase(dbPath, encryptor)
 	}
 	return nil, fmt.Errorf("unknown db %s", dbType)
 }
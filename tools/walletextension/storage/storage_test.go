@@ -2,25 +2,35 @@ package storage
 
 import (
 	"bytes"
+	"database/sql"
 	"errors"
+	"path/filepath"
 	"testing"
 
 	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/storage/encryption"
 	"github.com/stretchr/testify/require"
 )
 
 var tests = map[string]func(storage Storage, t *testing.T){
-	"testAddAndGetUser":     testAddAndGetUser,
-	"testAddAndGetAccounts": testAddAndGetAccounts,
-	"testDeleteUser":        testDeleteUser,
-	"testGetAllUsers":       testGetAllUsers,
+	"testAddAndGetUser":      testAddAndGetUser,
+	"testAddAndGetAccounts":  testAddAndGetAccounts,
+	"testDeleteUser":         testDeleteUser,
+	"testGetAllUsers":        testGetAllUsers,
+	"testGetAllUserAccounts": testGetAllUserAccounts,
+	"testRotateMasterKey":    testRotateMasterKey,
+	"testUserLimits":         testUserLimits,
+	"testComputeUnits":       testComputeUnits,
+	"testSessions":           testSessions,
+	"testUsersOfAccount":     testUsersOfAccount,
 }
 
 func TestSQLiteGatewayDB(t *testing.T) {
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			// storage, err := New("mariaDB", "obscurouser:password@tcp(127.0.0.1:3306)/ogdb", "") allows to run tests against a local instance of MariaDB
-			storage, err := New("sqlite", "", "")
+			// storage, err := New("mariaDB", "obscurouser:password@tcp(127.0.0.1:3306)/ogdb", "", masterKey(t)) allows to run tests against a local instance of MariaDB
+			storage, err := New("sqlite", "", "", masterKey(t))
 			require.NoError(t, err)
 
 			test(storage, t)
@@ -144,3 +154,174 @@ func testGetAllUsers(storage Storage, t *testing.T) {
 		t.Errorf("Expected user count to increase by 1. Got %d initially and %d after insert", len(initialUsers), len(afterInsertUsers))
 	}
 }
+
+func testGetAllUserAccounts(storage Storage, t *testing.T) {
+	userWithAccounts := []byte("userWithAccountsID")
+	userWithoutAccounts := []byte("userWithoutAccountsID")
+	accountAddresses := [][]byte{[]byte("accountAddress1"), []byte("accountAddress2")}
+	require.NoError(t, storage.AddUser(userWithAccounts, []byte("privateKey1")))
+	require.NoError(t, storage.AddUser(userWithoutAccounts, []byte("privateKey2")))
+	for _, accountAddress := range accountAddresses {
+		require.NoError(t, storage.AddAccount(userWithAccounts, accountAddress, []byte("signature")))
+	}
+
+	users, err := storage.GetAllUserAccounts()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []common.UserAccountsDB{
+		{UserID: userWithAccounts, AccountAddresses: accountAddresses},
+		{UserID: userWithoutAccounts},
+	}, users)
+}
+
+func testRotateMasterKey(storage Storage, t *testing.T) {
+	userID := []byte("rotateMasterKeyUserID")
+	privateKey := []byte("rotateMasterKeyPrivateKey")
+
+	err := storage.AddUser(userID, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = storage.RotateMasterKey(masterKey(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	returnedPrivateKey, err := storage.GetUserPrivateKey(userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(returnedPrivateKey, privateKey) {
+		t.Errorf("privateKey mismatch after rotation: got %v, want %v", returnedPrivateKey, privateKey)
+	}
+}
+
+func testUserLimits(storage Storage, t *testing.T) {
+	userID := []byte("userLimitsUserID")
+
+	_, err := storage.GetUserLimits(userID)
+	require.ErrorIs(t, err, errutil.ErrNotFound)
+
+	limits := common.UserLimitsDB{ComputeUnitsPerSecond: 2.5, Burst: 10, DailyQuota: 1000}
+	require.NoError(t, storage.SetUserLimits(userID, limits))
+	returnedLimits, err := storage.GetUserLimits(userID)
+	require.NoError(t, err)
+	require.Equal(t, limits, *returnedLimits)
+
+	require.NoError(t, storage.DeleteUserLimits(userID))
+	_, err = storage.GetUserLimits(userID)
+	require.ErrorIs(t, err, errutil.ErrNotFound)
+}
+
+func testComputeUnits(storage Storage, t *testing.T) {
+	userID := []byte("computeUnitsUserID")
+	day := "2023-10-01"
+	require.NoError(t, storage.AddUser(userID, []byte("computeUnitsPrivateKey")))
+	require.NoError(t, storage.SetUserLimits(userID, common.UserLimitsDB{DailyQuota: 1000}))
+
+	used, err := storage.GetComputeUnits(userID, day)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), used)
+
+	require.NoError(t, storage.AddComputeUnits(userID, day, 10))
+	require.NoError(t, storage.AddComputeUnits(userID, day, 15))
+	used, err = storage.GetComputeUnits(userID, day)
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), used)
+
+	// the limits and the usage of a deleted user are deleted too
+	require.NoError(t, storage.DeleteUser(userID))
+	used, err = storage.GetComputeUnits(userID, day)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), used)
+	_, err = storage.GetUserLimits(userID)
+	require.ErrorIs(t, err, errutil.ErrNotFound)
+}
+
+func testSessions(storage Storage, t *testing.T) {
+	userID := []byte("sessionsUserID")
+	tokenHash := bytes.Repeat([]byte{1}, 32)
+	expiredTokenHash := bytes.Repeat([]byte{2}, 32)
+	require.NoError(t, storage.AddUser(userID, []byte("sessionsPrivateKey")))
+
+	_, err := storage.GetSession(tokenHash)
+	require.ErrorIs(t, err, errutil.ErrNotFound)
+
+	session := common.SessionDB{UserID: userID, Expiry: 2000}
+	require.NoError(t, storage.AddSession(expiredTokenHash, common.SessionDB{UserID: userID, Expiry: 500}, 0))
+	require.NoError(t, storage.AddSession(tokenHash, session, 1000))
+	returnedSession, err := storage.GetSession(tokenHash)
+	require.NoError(t, err)
+	require.Equal(t, session, *returnedSession)
+
+	// the sessions that expired are removed when a session is added
+	_, err = storage.GetSession(expiredTokenHash)
+	require.ErrorIs(t, err, errutil.ErrNotFound)
+
+	require.NoError(t, storage.DeleteSession(tokenHash))
+	_, err = storage.GetSession(tokenHash)
+	require.ErrorIs(t, err, errutil.ErrNotFound)
+
+	// the sessions of a deleted user are deleted too
+	require.NoError(t, storage.AddSession(tokenHash, session, 1000))
+	require.NoError(t, storage.DeleteUser(userID))
+	_, err = storage.GetSession(tokenHash)
+	require.ErrorIs(t, err, errutil.ErrNotFound)
+}
+
+func testUsersOfAccount(storage Storage, t *testing.T) {
+	userID1 := []byte("usersOfAccountUserID1")
+	userID2 := []byte("usersOfAccountUserID2")
+	accountAddress := []byte("usersOfAccountAddr1")
+	require.NoError(t, storage.AddUser(userID1, []byte("privateKey1")))
+	require.NoError(t, storage.AddUser(userID2, []byte("privateKey2")))
+
+	userIDs, err := storage.GetUsersOfAccount(accountAddress)
+	require.NoError(t, err)
+	require.Empty(t, userIDs)
+
+	require.NoError(t, storage.AddAccount(userID1, accountAddress, []byte("signature1")))
+	require.NoError(t, storage.AddAccount(userID2, accountAddress, []byte("signature2")))
+	userIDs, err = storage.GetUsersOfAccount(accountAddress)
+	require.NoError(t, err)
+	require.ElementsMatch(t, [][]byte{userID1, userID2}, userIDs)
+}
+
+func TestPlaintextPrivateKeysAreEncryptedInPlace(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "gateway.db")
+	key := masterKey(t)
+	userID := []byte("plaintextUserID")
+	privateKey := []byte("plaintextPrivateKey")
+
+	// create the schema, then store a private key in plaintext, as the previous versions did
+	_, err := New("sqlite", "", dbPath, key)
+	require.NoError(t, err)
+	db, err := sql.Open("sqlite3", dbPath)
+	require.NoError(t, err)
+	defer db.Close()
+	_, err = db.Exec("INSERT INTO users(user_id, private_key) VALUES (?, ?)", userID, privateKey)
+	require.NoError(t, err)
+
+	storage, err := New("sqlite", "", dbPath, key)
+	require.NoError(t, err)
+
+	var storedPrivateKey []byte
+	require.NoError(t, db.QueryRow("SELECT private_key FROM users WHERE user_id = ?", userID).Scan(&storedPrivateKey))
+	require.True(t, encryption.IsEncrypted(storedPrivateKey))
+
+	returnedPrivateKey, err := storage.GetUserPrivateKey(userID)
+	require.NoError(t, err)
+	require.Equal(t, privateKey, returnedPrivateKey)
+
+	// the private keys cannot be read without the master key
+	storageWithWrongKey, err := New("sqlite", "", dbPath, masterKey(t))
+	require.NoError(t, err)
+	_, err = storageWithWrongKey.GetUserPrivateKey(userID)
+	require.Error(t, err)
+}
+
+func masterKey(t *testing.T) []byte {
+	key, err := encryption.GenerateMasterKey()
+	require.NoError(t, err)
+	return key
+}
@@ -164,6 +164,26 @@ func (api *DummyAPI) GetStorageAt(_ context.Context, encryptedParams common.Encr
 	return api.reEncryptParams(encryptedParams)
 }
 
+func (api *DummyAPI) CreateAccessList(_ context.Context, encryptedParams common.EncryptedParamsAccessList) (*responses.EnclaveResponse, error) {
+	return api.reEncryptParams(encryptedParams)
+}
+
+func (api *DummyAPI) SimulateCalls(_ context.Context, encryptedParams common.EncryptedParamsSimulateCalls) (*responses.EnclaveResponse, error) {
+	return api.reEncryptParams(encryptedParams)
+}
+
+func (api *DummyAPI) RevokeViewingKey(encryptedParams common.EncryptedParamsRevokeViewingKey) (*responses.EnclaveResponse, error) {
+	return api.reEncryptParams(encryptedParams)
+}
+
+func (api *DummyAPI) GetInboundMessageStatus(encryptedParams common.EncryptedParamsInboundMessages) (*responses.EnclaveResponse, error) {
+	return api.reEncryptParams(encryptedParams)
+}
+
+func (api *DummyAPI) GetOutboundMessages(encryptedParams common.EncryptedParamsOutboundMessages) (*responses.EnclaveResponse, error) {
+	return api.reEncryptParams(encryptedParams)
+}
+
 // Decrypts the params with the enclave key, and returns them encrypted with the viewing key set via `setViewingKey`.
 func (api *DummyAPI) reEncryptParams(encryptedParams []byte) (*responses.EnclaveResponse, error) {
 	params, err := api.enclavePrivateKey.Decrypt(encryptedParams, nil, nil)
@@ -171,7 +191,7 @@ func (api *DummyAPI) reEncryptParams(encryptedParams []byte) (*responses.Enclave
 		return responses.AsEmptyResponse(), fmt.Errorf("could not decrypt params with enclave private key. Cause: %w", err)
 	}
 
-	encryptor, err := vkhandler.New(api.address, api.viewingKey, api.signature)
+	encryptor, err := vkhandler.New(api.address, api.viewingKey, api.signature, obscuroChainID, true)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create vk encryption for request - %w", err)
 	}
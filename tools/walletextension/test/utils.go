@@ -15,6 +15,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/go-kit/kit/transport/http/jsonrpc"
 	"github.com/gorilla/websocket"
 	"github.com/obscuronet/go-obscuro/go/common/log"
@@ -30,7 +31,12 @@ import (
 	hostcontainer "github.com/obscuronet/go-obscuro/go/host/container"
 )
 
-const jsonID = "1"
+const (
+	jsonID         = "1"
+	maxBatchSize   = 10
+	adminAPIKey    = "testAdminAPIKey"
+	obscuroChainID = 777
+)
 
 func createWalExtCfg(connectPort, wallHTTPPort, wallWSPort int) *config.Config {
 	testDBPath, err := os.CreateTemp("", "")
@@ -38,11 +44,26 @@ func createWalExtCfg(connectPort, wallHTTPPort, wallWSPort int) *config.Config {
 		panic("could not create persistence file for wallet extension tests")
 	}
 	return &config.Config{
-		NodeRPCWebsocketAddress: fmt.Sprintf("localhost:%d", connectPort),
-		DBPathOverride:          testDBPath.Name(),
-		WalletExtensionPortHTTP: wallHTTPPort,
-		WalletExtensionPortWS:   wallWSPort,
-		DBType:                  "sqlite",
+		NodeRPCWebsocketAddresses:  []string{fmt.Sprintf("localhost:%d", connectPort)},
+		DBPathOverride:             testDBPath.Name(),
+		MasterKeyPath:              testDBPath.Name() + ".key",
+		GenerateMasterKey:          true,
+		WalletExtensionPortHTTP:    wallHTTPPort,
+		WalletExtensionPortWS:      wallWSPort,
+		WalletExtensionPortAdmin:   wallWSPort + 1,
+		DBType:                     "sqlite",
+		MaxBatchSize:               maxBatchSize,
+		AdminAPIKey:                adminAPIKey,
+		HostHealthCheckInterval:    time.Second,
+		HostFailureThreshold:       3,
+		HostCircuitBreakerCooldown: time.Second,
+		ObscuroChainID:             obscuroChainID,
+		SIWEDomain:                 fmt.Sprintf("%s:%d", common.Localhost, wallHTTPPort),
+		ViewingKeyValidity:         time.Hour,
+		AllowLegacySignatures:      true,
+		SubscriptionBufferSize:     1000,
+		SubscriptionGracePeriod:    5 * time.Second,
+		SessionTTL:                 time.Hour,
 	}
 }
 
@@ -169,6 +190,18 @@ func prepareRequestBody(method string, params interface{}) []byte {
 	return reqBodyBytes
 }
 
+// Formats the requests as a JSON RPC batch, using the position of each request as its ID.
+func prepareBatchRequestBody(requests []map[string]interface{}) []byte {
+	for i, request := range requests {
+		request[common.JSONKeyID] = i
+	}
+	reqBodyBytes, err := json.Marshal(requests)
+	if err != nil {
+		panic(fmt.Errorf("failed to prepare batch request body. Cause: %w", err))
+	}
+	return reqBodyBytes
+}
+
 // Generates a new account and registers it with the node.
 func simulateViewingKeyRegister(t *testing.T, walletHTTPPort, walletWSPort int, useWS bool) (*gethcommon.Address, []byte, []byte) {
 	accountPrivateKey, err := crypto.GenerateKey()
@@ -238,6 +271,22 @@ func signViewingKey(privateKey *ecdsa.PrivateKey, compressedHexVKBytes []byte) [
 	return signatureWithLeadBytes
 }
 
+// Signs the typed data like metamask's eth_signTypedData_v4
+func signTypedData(t *testing.T, privateKey *ecdsa.PrivateKey, typedData apitypes.TypedData) []byte {
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		t.Fatalf("could not hash the typed data. Cause: %s", err)
+	}
+	signature, err := crypto.Sign(hash, privateKey)
+	if err != nil {
+		t.Fatalf("could not sign the typed data. Cause: %s", err)
+	}
+
+	// We have to transform the V from 0/1 to 27/28
+	signature[64] += 27
+	return signature
+}
+
 // Submits a viewing key.
 func submitViewingKey(accountAddr string, wallHTTPPort, wallWSPort int, signature []byte, useWS bool) {
 	submitViewingKeyBodyBytes, err := json.Marshal(map[string]interface{}{
@@ -272,6 +321,36 @@ func makeRequestHTTP(url string, body []byte) []byte {
 	return viewingKey
 }
 
+// Sends the admin request to the URL over HTTP, authenticated with the API key if it is not empty, and returns the status
+// code and the result.
+func makeAdminRequestHTTP(method string, url string, apiKey string, body []byte) (int, []byte) {
+	req, err := http.NewRequest(method, url, bytes.NewBuffer(body)) //nolint:noctx
+	if err != nil {
+		panic(err)
+	}
+	if apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		panic(err)
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		panic(err)
+	}
+	return resp.StatusCode, respBody
+}
+
+// Sends the request to the URL over HTTP, authenticated with the session token, and returns the status code and the result.
+// The session token is sent as a bearer token, like the admin API key.
+func makeSessionRequestHTTP(method string, url string, token string, body []byte) (int, []byte) {
+	return makeAdminRequestHTTP(method, url, token, body)
+}
+
 // Sends the body to the URL over a websocket connection, and returns the result.
 func makeRequestWS(url string, body []byte) ([]byte, *websocket.Conn) {
 	conn, dialResp, err := websocket.DefaultDialer.Dial(url, nil)
@@ -302,6 +381,16 @@ func issueRequestWS(conn *websocket.Conn, body []byte) []byte {
 	return reqResp
 }
 
+// Signs the Sign-In-With-Ethereum message like personal_sign
+func signSIWEMessage(t *testing.T, privateKey *ecdsa.PrivateKey, message string) []byte {
+	signature, err := crypto.Sign(accounts.TextHash([]byte(message)), privateKey)
+	if err != nil {
+		t.Fatalf("could not sign the message. Cause: %s", err)
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature
+}
+
 // Reads messages from the connection for the provided duration, and returns the read messages.
 func readMessagesForDuration(t *testing.T, conn *websocket.Conn, duration time.Duration) [][]byte {
 	// We set a timeout to kill the test, in case we never receive a log.
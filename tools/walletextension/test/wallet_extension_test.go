@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"net/http"
 	"strings"
 	"testing"
 	"time"
@@ -15,9 +16,16 @@ import (
 	"github.com/obscuronet/go-obscuro/integration"
 	"github.com/obscuronet/go-obscuro/tools/walletextension"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/accountmanager"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/ratelimiter"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/go-kit/kit/transport/http/jsonrpc"
+	"github.com/gorilla/websocket"
 	wecommon "github.com/obscuronet/go-obscuro/tools/walletextension/common"
 )
 
@@ -33,6 +41,7 @@ type testHelper struct {
 	hostPort       int
 	walletHTTPPort int
 	walletWSPort   int
+	adminPort      int
 	hostAPI        *DummyAPI
 }
 
@@ -45,6 +54,13 @@ func TestWalletExtension(t *testing.T) {
 		"canInvokeSensitiveMethodsAfterSubmittingMultipleViewingKeys": canInvokeSensitiveMethodsAfterSubmittingMultipleViewingKeys,
 		"cannotSubscribeOverHTTP":                                     cannotSubscribeOverHTTP,
 		"canRegisterViewingKeyAndMakeRequestsOverWebsockets":          canRegisterViewingKeyAndMakeRequestsOverWebsockets,
+		"canProxyBatchRequestsOverHTTP":                               canProxyBatchRequestsOverHTTP,
+		"canProxyBatchRequestsOverWebsockets":                         canProxyBatchRequestsOverWebsockets,
+		"cannotExceedMaxBatchSize":                                    cannotExceedMaxBatchSize,
+		"canThrottleUsersAsAdmin":                                     canThrottleUsersAsAdmin,
+		"canInspectAndDeleteUsersAsAdmin":                             canInspectAndDeleteUsersAsAdmin,
+		"canAuthenticateAccountWithEIP712Signature":                   canAuthenticateAccountWithEIP712Signature,
+		"canSignInWithEthereum":                                       canSignInWithEthereum,
 	} {
 		t.Run(name, func(t *testing.T) {
 			hostPort := _hostWSPort + i*_testOffset
@@ -55,6 +71,7 @@ func TestWalletExtension(t *testing.T) {
 				hostPort:       hostPort,
 				walletHTTPPort: hostPort + 1,
 				walletWSPort:   hostPort + 2,
+				adminPort:      hostPort + 3,
 				hostAPI:        dummyAPI,
 			}
 
@@ -235,6 +252,24 @@ func TestKeysAreReloadedWhenWalletExtensionRestarts(t *testing.T) {
 	}
 }
 
+func TestCannotSignInWithEthereumWithoutDomain(t *testing.T) {
+	hostPort := _hostWSPort + _testOffset*12
+	walletHTTPPort := hostPort + 1
+	walletWSPort := hostPort + 2
+
+	_, shutdownHost := createDummyHost(t, hostPort)
+	defer shutdownHost() //nolint: errcheck
+	walExtCfg := createWalExtCfg(hostPort, walletHTTPPort, walletWSPort)
+	walExtCfg.SIWEDomain = ""
+	shutdownWallet := createWalExt(t, walExtCfg)
+	defer shutdownWallet() //nolint: errcheck
+
+	// the Sign-In-With-Ethereum routes are not served, as the host of the requests is set by the client
+	gatewayURL := fmt.Sprintf("http://%s:%d%s", wecommon.Localhost, walletHTTPPort, wecommon.APIVersion1)
+	status, respBody := makeSessionRequestHTTP(http.MethodGet, gatewayURL+wecommon.PathSIWENonce, "", nil)
+	assert.NotEqual(t, http.StatusOK, status, string(respBody))
+}
+
 func TestCanSubscribeForLogsOverWebsockets(t *testing.T) {
 	hostPort := _hostWSPort + _testOffset*9
 	walletHTTPPort := hostPort + 1
@@ -281,6 +316,87 @@ func TestCanSubscribeForLogsOverWebsockets(t *testing.T) {
 	}
 }
 
+func TestCanResumeSubscriptionAfterWebsocketIsClosed(t *testing.T) {
+	hostPort := _hostWSPort + _testOffset*10
+	walletHTTPPort := hostPort + 1
+	walletWSPort := hostPort + 2
+
+	dummyHash := gethcommon.BigToHash(big.NewInt(1234))
+
+	dummyAPI, shutdownHost := createDummyHost(t, hostPort)
+	defer shutdownHost() //nolint: errcheck
+	shutdownWallet := createWalExt(t, createWalExtCfg(hostPort, walletHTTPPort, walletWSPort))
+	defer shutdownWallet() //nolint: errcheck
+
+	dummyAPI.setViewingKey(simulateViewingKeyRegister(t, walletHTTPPort, walletWSPort, false))
+
+	filter := common.FilterCriteriaJSON{Topics: []interface{}{dummyHash}}
+	resp, conn := makeWSEthJSONReq(walletWSPort, rpc.Subscribe, []interface{}{rpc.SubscriptionTypeLogs, filter})
+	subID := validateJSONResponse(t, resp)
+	logsBeforeClose := readMessagesForDuration(t, conn, 300*time.Millisecond)
+	conn.Close()
+
+	// The logs emitted while the websocket is closed are buffered by the gateway.
+	time.Sleep(500 * time.Millisecond)
+
+	resp, conn = makeWSEthJSONReq(walletWSPort, rpc.Subscribe, []interface{}{accountmanager.SubscriptionTypeResume, subID})
+	defer conn.Close()
+	assert.Equal(t, subID, validateJSONResponse(t, resp))
+	logsAfterResume := readMessagesForDuration(t, conn, 300*time.Millisecond)
+
+	assertNoDupeLogs(t, append(logsBeforeClose, logsAfterResume...))
+
+	// The logs still in flight when the websocket was closed are lost, but the ones emitted while it was closed are
+	// replayed, so the resumed subscription has no gaps and picks up shortly after the last log received.
+	lastIdxBeforeClose := logIndexes(t, subID, logsBeforeClose)
+	idxs := logIndexes(t, subID, logsAfterResume)
+	if idxs[0]-lastIdxBeforeClose[len(lastIdxBeforeClose)-1] > 10 {
+		t.Errorf("expected the resumed subscription to continue from log %d, it continued from log %d", lastIdxBeforeClose[len(lastIdxBeforeClose)-1]+1, idxs[0])
+	}
+	for i := 1; i < len(idxs); i++ {
+		if idxs[i] != idxs[i-1]+1 {
+			t.Fatalf("log %d was not received after resuming the subscription", idxs[i-1]+1)
+		}
+	}
+	if len(idxs) < 50 {
+		t.Errorf("expected to receive at least 50 logs after resuming the subscription, only received %d", len(idxs))
+	}
+}
+
+// Returns the incrementing topics set by the API on the logs received for the subscription
+func logIndexes(t *testing.T, subID interface{}, logsJSON [][]byte) []int64 {
+	idxs := make([]int64, 0, len(logsJSON))
+	for _, logJSON := range logsJSON {
+		var logResp map[string]interface{}
+		err := json.Unmarshal(logJSON, &logResp)
+		if err != nil {
+			t.Fatalf("could not unmarshal received log from JSON")
+		}
+		params := logResp[wecommon.JSONKeyParams].(map[string]interface{})
+		assert.Equal(t, subID, params[wecommon.JSONKeySubscription])
+		topics := params[wecommon.JSONKeyResult].(map[string]interface{})[jsonKeyTopics].([]interface{})
+		idxs = append(idxs, gethcommon.HexToHash(topics[1].(string)).Big().Int64())
+	}
+	return idxs
+}
+
+func TestCannotResumeUnknownSubscription(t *testing.T) {
+	hostPort := _hostWSPort + _testOffset*11
+	walletHTTPPort := hostPort + 1
+	walletWSPort := hostPort + 2
+
+	_, shutdownHost := createDummyHost(t, hostPort)
+	defer shutdownHost() //nolint: errcheck
+	shutdownWallet := createWalExt(t, createWalExtCfg(hostPort, walletHTTPPort, walletWSPort))
+	defer shutdownWallet() //nolint: errcheck
+
+	resp, conn := makeWSEthJSONReq(walletWSPort, rpc.Subscribe, []interface{}{accountmanager.SubscriptionTypeResume, "0x1234"})
+	defer conn.Close()
+	if !strings.Contains(string(resp), "could not resume subscription 0x1234") {
+		t.Fatalf("expected an error when resuming an unknown subscription, got %s", resp)
+	}
+}
+
 func TestGetStorageAtForReturningUserID(t *testing.T) {
 	hostPort := _hostWSPort + _testOffset*8
 	walletHTTPPort := hostPort + 1
@@ -323,3 +439,229 @@ func TestGetStorageAtForReturningUserID(t *testing.T) {
 		t.Fatalf("expected response not containing userID as the parameters are wrong ")
 	}
 }
+
+func canProxyBatchRequestsOverHTTP(t *testing.T, testHelper *testHelper) {
+	respBody := makeRequestHTTP(fmt.Sprintf("http://%s:%d/v1/", wecommon.Localhost, testHelper.walletHTTPPort), batchRequestBody())
+	validateBatchResponse(t, respBody)
+}
+
+func canProxyBatchRequestsOverWebsockets(t *testing.T, testHelper *testHelper) {
+	respBody, conn := makeRequestWS(fmt.Sprintf("ws://%s:%d", wecommon.Localhost, testHelper.walletWSPort), batchRequestBody())
+	defer conn.Close()
+	validateBatchResponse(t, respBody)
+}
+
+func cannotExceedMaxBatchSize(t *testing.T, testHelper *testHelper) {
+	requests := make([]map[string]interface{}, maxBatchSize+1)
+	for i := range requests {
+		requests[i] = map[string]interface{}{wecommon.JSONKeyRPCVersion: jsonrpc.Version, wecommon.JSONKeyMethod: rpc.ChainID, wecommon.JSONKeyParams: []interface{}{}}
+	}
+	respBody := makeRequestHTTP(fmt.Sprintf("http://%s:%d/v1/", wecommon.Localhost, testHelper.walletHTTPPort), prepareBatchRequestBody(requests))
+	if !strings.Contains(string(respBody), "the maximum is") {
+		t.Fatalf("expected the batch to be rejected, got '%s'", string(respBody))
+	}
+}
+
+func canThrottleUsersAsAdmin(t *testing.T, testHelper *testHelper) {
+	userID := "aa01"
+	limitsURL := fmt.Sprintf("http://%s:%d/v1%s?u=%s", wecommon.Localhost, testHelper.adminPort, wecommon.PathAdminLimits, userID)
+
+	// the admin endpoints are not served on the public port
+	statusCode, _ := makeAdminRequestHTTP(http.MethodGet, fmt.Sprintf("http://%s:%d/v1%s", wecommon.Localhost, testHelper.walletHTTPPort, wecommon.PathAdminLimits), adminAPIKey, nil)
+	assert.NotEqual(t, http.StatusOK, statusCode)
+
+	statusCode, _ = makeAdminRequestHTTP(http.MethodGet, limitsURL, "", nil)
+	assert.Equal(t, http.StatusUnauthorized, statusCode)
+	statusCode, _ = makeAdminRequestHTTP(http.MethodGet, limitsURL, "wrongAPIKey", nil)
+	assert.Equal(t, http.StatusUnauthorized, statusCode)
+
+	statusCode, respBody := makeAdminRequestHTTP(http.MethodPut, limitsURL, adminAPIKey, []byte(`{"computeUnitsPerSecond":0.1,"burst":1}`))
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Contains(t, string(respBody), `"customLimits":true`)
+
+	// the second request is throttled, as the bucket of the user only holds a compute unit
+	makeHTTPEthJSONReqWithUserID(testHelper.walletHTTPPort, rpc.ChainID, []interface{}{}, userID)
+	respBody = makeHTTPEthJSONReqWithUserID(testHelper.walletHTTPPort, rpc.ChainID, []interface{}{}, userID)
+	assert.Contains(t, string(respBody), fmt.Sprintf(`"code":%d`, ratelimiter.ErrorCodeLimitExceeded))
+}
+
+func canInspectAndDeleteUsersAsAdmin(t *testing.T, testHelper *testHelper) {
+	adminURL := fmt.Sprintf("http://%s:%d", wecommon.Localhost, testHelper.adminPort)
+	userID := string(makeHTTPEthJSONReqWithPath(testHelper.walletHTTPPort, "v1/join"))
+	makeHTTPEthJSONReqWithUserID(testHelper.walletHTTPPort, rpc.ChainID, []interface{}{}, userID)
+
+	statusCode, _ := makeAdminRequestHTTP(http.MethodGet, adminURL+"/v1"+wecommon.PathAdminUsers, "wrongAPIKey", nil)
+	assert.Equal(t, http.StatusUnauthorized, statusCode)
+
+	// the user is listed with their request
+	statusCode, respBody := makeAdminRequestHTTP(http.MethodGet, adminURL+"/v1"+wecommon.PathAdminUsers, adminAPIKey, nil)
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Contains(t, string(respBody), fmt.Sprintf(`{"userID":"%s","accounts":[],"requests":1,"activeSubscriptions":0}`, userID))
+
+	// the requests are counted per method, and exported in the Prometheus format
+	statusCode, respBody = makeAdminRequestHTTP(http.MethodGet, adminURL+"/v1"+wecommon.PathAdminMethods, adminAPIKey, nil)
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Contains(t, string(respBody), fmt.Sprintf(`"%s":{"requests":1,"errors":0`, rpc.ChainID))
+	statusCode, respBody = makeAdminRequestHTTP(http.MethodGet, adminURL+wecommon.PathMetrics, adminAPIKey, nil)
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Contains(t, string(respBody), fmt.Sprintf("gateway_requests_%s_count 1", rpc.ChainID))
+
+	statusCode, _ = makeAdminRequestHTTP(http.MethodDelete, fmt.Sprintf("%s/v1%s?u=%s", adminURL, wecommon.PathAdminUsers, userID), adminAPIKey, nil)
+	assert.Equal(t, http.StatusOK, statusCode)
+	_, respBody = makeAdminRequestHTTP(http.MethodGet, adminURL+"/v1"+wecommon.PathAdminUsers, adminAPIKey, nil)
+	assert.NotContains(t, string(respBody), userID)
+}
+
+func canAuthenticateAccountWithEIP712Signature(t *testing.T, testHelper *testHelper) {
+	accountPrivateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	accountAddr := crypto.PubkeyToAddress(accountPrivateKey.PublicKey)
+
+	gatewayURL := fmt.Sprintf("http://%s:%d%s", wecommon.Localhost, testHelper.walletHTTPPort, wecommon.APIVersion1)
+	userID := string(makeRequestHTTP(gatewayURL+wecommon.PathJoin, nil))
+
+	// the account signs the typed data returned by the gateway
+	var typedData apitypes.TypedData
+	err = json.Unmarshal(makeRequestHTTP(fmt.Sprintf("%s%s?u=%s", gatewayURL, wecommon.PathGetMessage, userID), nil), &typedData)
+	if err != nil {
+		t.Fatalf("could not unmarshal the typed data. Cause: %s", err)
+	}
+	assert.Equal(t, userID, typedData.Message["userID"])
+	signature := signTypedData(t, accountPrivateKey, typedData)
+
+	authenticateURL := fmt.Sprintf("%s%s?u=%s", gatewayURL, wecommon.PathAuthenticate, userID)
+	authenticateBody, err := json.Marshal(map[string]interface{}{
+		wecommon.JSONKeySignature: hexutil.Encode(signature),
+		"issuedAt":                typedData.Message["issuedAt"],
+		"expiry":                  typedData.Message["expiry"],
+	})
+	assert.NoError(t, err)
+	respBody := makeRequestHTTP(authenticateURL, authenticateBody)
+	assert.Equal(t, wecommon.SuccessMsg, string(respBody))
+
+	respBody = makeRequestHTTP(fmt.Sprintf("%s%s?u=%s&a=%s", gatewayURL, wecommon.PathQuery, userID, accountAddr.Hex()), nil)
+	assert.Contains(t, string(respBody), `"status":true`)
+
+	// a signature over typed data with a different expiry is not accepted
+	otherAccountPrivateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	otherAccountAddr := crypto.PubkeyToAddress(otherAccountPrivateKey.PublicKey)
+	signature = signTypedData(t, otherAccountPrivateKey, typedData)
+	authenticateBody, err = json.Marshal(map[string]interface{}{
+		wecommon.JSONKeySignature: hexutil.Encode(signature),
+		"issuedAt":                typedData.Message["issuedAt"],
+		"expiry":                  typedData.Message["expiry"].(float64) + 1,
+	})
+	assert.NoError(t, err)
+	makeRequestHTTP(authenticateURL, authenticateBody)
+
+	respBody = makeRequestHTTP(fmt.Sprintf("%s%s?u=%s&a=%s", gatewayURL, wecommon.PathQuery, userID, otherAccountAddr.Hex()), nil)
+	assert.Contains(t, string(respBody), `"status":false`)
+}
+
+func canSignInWithEthereum(t *testing.T, testHelper *testHelper) {
+	accountPrivateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	accountAddr := crypto.PubkeyToAddress(accountPrivateKey.PublicKey)
+
+	gatewayHost := fmt.Sprintf("%s:%d", wecommon.Localhost, testHelper.walletHTTPPort)
+	gatewayURL := fmt.Sprintf("http://%s%s", gatewayHost, wecommon.APIVersion1)
+	userID := string(makeRequestHTTP(gatewayURL+wecommon.PathJoin, nil))
+
+	// the account is registered for the user
+	var typedData apitypes.TypedData
+	err = json.Unmarshal(makeRequestHTTP(fmt.Sprintf("%s%s?u=%s", gatewayURL, wecommon.PathGetMessage, userID), nil), &typedData)
+	assert.NoError(t, err)
+	authenticateBody, err := json.Marshal(map[string]interface{}{
+		wecommon.JSONKeySignature: hexutil.Encode(signTypedData(t, accountPrivateKey, typedData)),
+		"issuedAt":                typedData.Message["issuedAt"],
+		"expiry":                  typedData.Message["expiry"],
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, wecommon.SuccessMsg, string(makeRequestHTTP(fmt.Sprintf("%s%s?u=%s", gatewayURL, wecommon.PathAuthenticate, userID), authenticateBody)))
+
+	// the account signs a message with a nonce issued by the gateway
+	status, respBody := makeSessionRequestHTTP(http.MethodGet, gatewayURL+wecommon.PathSIWENonce, "", nil)
+	assert.Equal(t, http.StatusOK, status)
+	var nonceResp map[string]string
+	assert.NoError(t, json.Unmarshal(respBody, &nonceResp))
+	message := fmt.Sprintf("%s wants you to sign in with your Ethereum account:\n%s\n\nSign in to the Obscuro gateway.\n\n"+
+		"URI: http://%s\nVersion: 1\nChain ID: %d\nNonce: %s\nIssued At: %s",
+		gatewayHost, accountAddr.Hex(), gatewayHost, obscuroChainID, nonceResp["nonce"], time.Now().UTC().Format(time.RFC3339))
+	loginBody, err := json.Marshal(map[string]string{
+		"message":                 message,
+		wecommon.JSONKeySignature: hexutil.Encode(signSIWEMessage(t, accountPrivateKey, message)),
+	})
+	assert.NoError(t, err)
+	status, respBody = makeSessionRequestHTTP(http.MethodPost, gatewayURL+wecommon.PathSIWELogin, "", loginBody)
+	assert.Equal(t, http.StatusOK, status, string(respBody))
+	var loginResp map[string]interface{}
+	assert.NoError(t, json.Unmarshal(respBody, &loginResp))
+	assert.Equal(t, userID, loginResp["userID"])
+	token := loginResp["token"].(string)
+
+	// the nonce can only be used once
+	status, _ = makeSessionRequestHTTP(http.MethodPost, gatewayURL+wecommon.PathSIWELogin, "", loginBody)
+	assert.Equal(t, http.StatusUnauthorized, status)
+
+	// the requests authenticated with the session token are made on behalf of the user, over HTTP and websockets
+	getUserIDBody := prepareRequestBody(rpc.GetStorageAt, []interface{}{wecommon.GetStorageAtUserIDRequestMethodName, "0", nil})
+	rootURL := fmt.Sprintf("http://%s%s%s", gatewayHost, wecommon.APIVersion1, wecommon.PathRoot)
+	status, respBody = makeSessionRequestHTTP(http.MethodPost, rootURL, token, getUserIDBody)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, string(respBody), userID)
+
+	conn, dialResp, err := websocket.DefaultDialer.Dial(
+		fmt.Sprintf("ws://%s:%d", wecommon.Localhost, testHelper.walletWSPort),
+		http.Header{"Authorization": []string{"Bearer " + token}},
+	)
+	if dialResp != nil && dialResp.Body != nil {
+		defer dialResp.Body.Close()
+	}
+	assert.NoError(t, err)
+	assert.Contains(t, string(issueRequestWS(conn, getUserIDBody)), userID)
+	conn.Close()
+
+	// the session can no longer be used once revoked
+	status, _ = makeSessionRequestHTTP(http.MethodPost, gatewayURL+wecommon.PathSIWELogout, token, nil)
+	assert.Equal(t, http.StatusOK, status)
+	status, respBody = makeSessionRequestHTTP(http.MethodPost, rootURL, token, getUserIDBody)
+	assert.Equal(t, http.StatusUnauthorized, status)
+	assert.NotContains(t, string(respBody), userID)
+}
+
+// a batch mixing valid requests with a request that cannot be parsed and a subscription, which is not allowed in a batch
+func batchRequestBody() []byte {
+	return prepareBatchRequestBody([]map[string]interface{}{
+		{wecommon.JSONKeyRPCVersion: jsonrpc.Version, wecommon.JSONKeyMethod: rpc.ChainID, wecommon.JSONKeyParams: []interface{}{}},
+		{wecommon.JSONKeyRPCVersion: jsonrpc.Version, wecommon.JSONKeyMethod: rpc.ChainID, wecommon.JSONKeyParams: "not a list"},
+		{wecommon.JSONKeyRPCVersion: jsonrpc.Version, wecommon.JSONKeyMethod: rpc.Subscribe, wecommon.JSONKeyParams: []interface{}{rpc.SubscriptionTypeLogs}},
+		{wecommon.JSONKeyRPCVersion: jsonrpc.Version, wecommon.JSONKeyMethod: rpc.ChainID, wecommon.JSONKeyParams: []interface{}{}},
+	})
+}
+
+func validateBatchResponse(t *testing.T, respBody []byte) {
+	var responses []map[string]interface{}
+	err := json.Unmarshal(respBody, &responses)
+	if err != nil {
+		t.Fatalf("could not unmarshal batch response '%s' to JSON", string(respBody))
+	}
+	if len(responses) != 4 {
+		t.Fatalf("expected 4 responses, got %d", len(responses))
+	}
+
+	// the responses are in the order of the requests, and each one carries the id of its request
+	for i, response := range responses {
+		assert.Equal(t, float64(i), response[wecommon.JSONKeyID])
+	}
+	assert.Equal(t, l2ChainIDHex, responses[0][wecommon.JSONKeyResult])
+	assert.Contains(t, responses[1], wecommon.JSONKeyErr)
+	assert.Contains(t, responses[2], wecommon.JSONKeyErr)
+	assert.Equal(t, l2ChainIDHex, responses[3][wecommon.JSONKeyResult])
+}
@@ -1,56 +1,378 @@
 package useraccountmanager
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"sync"
+	"time"
 
-	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/rpc"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/accountmanager"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/hostpool"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/storage"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	wecommon "github.com/obscuronet/go-obscuro/tools/walletextension/common"
 )
 
+// minIdleCheckInterval - the idle account managers are looked for at most this often
+const minIdleCheckInterval = time.Second
+
+// UserAccountManager holds the account managers of the users, each with a live client per registered account.
+// Only the most recently used account managers are kept in memory (hydrated). The other ones are rebuilt from the database
+// on first use, and the ones that are not used for longer than the idle timeout are evicted, stopping their clients.
+// The account managers are returned along with a release function, and are not evicted until all the requests using
+// them have released them.
+// It is safe for concurrent use.
 type UserAccountManager struct {
-	userAccountManager    map[string]*accountmanager.AccountManager
+	accountManagers       lru.BasicLRU[string, *hydratedAccountManager]
+	pinnedAccountManagers map[string]*accountmanager.AccountManager // never evicted, as their clients cannot be rebuilt from the database
+	mutex                 sync.Mutex
+	maxHydratedUsers      int // zero disables the limit
+	idleTimeout           time.Duration
+	subscriptionConfig    accountmanager.SubscriptionConfig
 	unauthenticatedClient rpc.Client
+	storage               storage.Storage
+	hostPool              *hostpool.Pool
+	stopCh                chan struct{}
+	stopOnce              sync.Once
 	logger                gethlog.Logger
+
+	activeUsersGauge  gethmetrics.Gauge
+	hydrationsCounter gethmetrics.Counter
+	evictionsCounter  gethmetrics.Counter
 }
 
-func NewUserAccountManager(unauthenticatedClient rpc.Client, logger gethlog.Logger) UserAccountManager {
-	return UserAccountManager{
-		userAccountManager:    make(map[string]*accountmanager.AccountManager),
+type hydratedAccountManager struct {
+	accountManager  *accountmanager.AccountManager
+	lastUsed        time.Time
+	inUse           int  // the number of requests that have not released the account manager yet
+	closeWhenUnused bool // set when the account manager is deleted while in use
+}
+
+func NewUserAccountManager(
+	unauthenticatedClient rpc.Client,
+	storage storage.Storage,
+	hostPool *hostpool.Pool,
+	maxHydratedUsers int,
+	idleTimeout time.Duration,
+	subscriptionConfig accountmanager.SubscriptionConfig,
+	metricsRegistry gethmetrics.Registry,
+	logger gethlog.Logger,
+) *UserAccountManager {
+	userAccountManager := &UserAccountManager{
+		// the size is enforced by the UserAccountManager, as the evicted clients must be stopped
+		accountManagers:       lru.NewBasicLRU[string, *hydratedAccountManager](math.MaxInt),
+		pinnedAccountManagers: make(map[string]*accountmanager.AccountManager),
+		maxHydratedUsers:      maxHydratedUsers,
+		idleTimeout:           idleTimeout,
+		subscriptionConfig:    subscriptionConfig,
 		unauthenticatedClient: unauthenticatedClient,
+		storage:               storage,
+		hostPool:              hostPool,
+		stopCh:                make(chan struct{}),
 		logger:                logger,
+		activeUsersGauge:      gethmetrics.GetOrRegisterGauge("gateway/users/active", metricsRegistry),
+		hydrationsCounter:     gethmetrics.GetOrRegisterCounter("gateway/users/hydrations", metricsRegistry),
+		evictionsCounter:      gethmetrics.GetOrRegisterCounter("gateway/users/evictions", metricsRegistry),
+	}
+	gethmetrics.NewRegisteredFunctionalGauge("gateway/subscriptions/active", metricsRegistry, func() int64 {
+		var active int64
+		for _, userSubscriptions := range userAccountManager.ActiveSubscriptions() {
+			active += userSubscriptions
+		}
+		return active
+	})
+	return userAccountManager
+}
+
+// Start periodically evicts the account managers that are idle for longer than the idle timeout
+func (m *UserAccountManager) Start() {
+	if m.idleTimeout <= 0 {
+		return
+	}
+	checkInterval := m.idleTimeout / 4
+	if checkInterval < minIdleCheckInterval {
+		checkInterval = minIdleCheckInterval
 	}
+
+	go func() {
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.evictIdle(time.Now())
+			case <-m.stopCh:
+				return
+			}
+		}
+	}()
 }
 
-// AddAndReturnAccountManager adds new UserAccountManager if it doesn't exist and returns it, if UserAccountManager already exists for that user just return it
-func (m *UserAccountManager) AddAndReturnAccountManager(userID string) *accountmanager.AccountManager {
-	existingUserAccountManager, exists := m.userAccountManager[userID]
-	if exists {
-		return existingUserAccountManager
+// Stop stops the idle eviction and the clients of all the account managers
+func (m *UserAccountManager) Stop() {
+	m.stopOnce.Do(func() {
+		close(m.stopCh)
+	})
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for _, userID := range m.accountManagers.Keys() {
+		hydrated, _ := m.accountManagers.Peek(userID)
+		hydrated.accountManager.Close()
+	}
+	m.accountManagers.Purge()
+	for userID, accountManager := range m.pinnedAccountManagers {
+		accountManager.Close()
+		delete(m.pinnedAccountManagers, userID)
 	}
-	newAccountManager := accountmanager.NewAccountManager(m.unauthenticatedClient, m.logger)
-	m.userAccountManager[userID] = newAccountManager
-	return newAccountManager
+	m.updateActiveUsers()
 }
 
-// GetUserAccountManager retrieves the UserAccountManager associated with the given userID.
-// It returns the UserAccountManager and nil error if one exists.
-// If a UserAccountManager does not exist for the userID, it returns nil and an error.
-func (m *UserAccountManager) GetUserAccountManager(userID string) (*accountmanager.AccountManager, error) {
-	userAccManager, exists := m.userAccountManager[userID]
-	if exists {
-		return userAccManager, nil
+// PinAccountManager loads the account manager of the user from the database, or creates an empty one if the user is not stored,
+// and keeps it in memory until it is deleted.
+// It is used for the default user, whose clients are not fully described by the database.
+func (m *UserAccountManager) PinAccountManager(userID string) (*accountmanager.AccountManager, error) {
+	accountManager, err := m.hydrate(userID)
+	if errors.Is(err, errutil.ErrNotFound) {
+		accountManager, err = accountmanager.NewAccountManager(m.unauthenticatedClient, m.subscriptionConfig, m.logger), nil
 	}
-	return nil, fmt.Errorf("UserAccountManager doesn't exist for user: %s", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if existing, found := m.pinnedAccountManagers[userID]; found {
+		accountManager.Close()
+		return existing, nil
+	}
+	m.pinnedAccountManagers[userID] = accountManager
+	m.updateActiveUsers()
+	return accountManager, nil
 }
 
-// DeleteUserAccountManager removes the UserAccountManager associated with the given userID.
-// It returns an error if no UserAccountManager exists for that userID.
+// AddAndReturnAccountManager returns the AccountManager of the user, loading it from the database if it is not in memory.
+// An empty AccountManager is created if the user is not stored in the database yet.
+// The returned function must be called once the AccountManager is no longer used.
+func (m *UserAccountManager) AddAndReturnAccountManager(userID string) (*accountmanager.AccountManager, func(), error) {
+	accountManager, release, err := m.GetUserAccountManager(userID)
+	if err == nil {
+		return accountManager, release, nil
+	}
+	if !errors.Is(err, errutil.ErrNotFound) {
+		return nil, nil, err
+	}
+	accountManager, release = m.add(userID, accountmanager.NewAccountManager(m.unauthenticatedClient, m.subscriptionConfig, m.logger))
+	return accountManager, release, nil
+}
+
+// GetUserAccountManager retrieves the AccountManager associated with the given userID, loading it from the database if it is
+// not in memory. It returns an error wrapping errutil.ErrNotFound if the user doesn't exist.
+// The returned function must be called once the AccountManager is no longer used, as it is not evicted until then.
+func (m *UserAccountManager) GetUserAccountManager(userID string) (*accountmanager.AccountManager, func(), error) {
+	if accountManager, release, found := m.get(userID); found {
+		return accountManager, release, nil
+	}
+
+	// the clients are created without holding the lock, so the requests of the other users are not blocked
+	accountManager, err := m.hydrate(userID)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, nil, fmt.Errorf("UserAccountManager doesn't exist for user: %s. Cause: %w", userID, err)
+		}
+		return nil, nil, fmt.Errorf("could not load UserAccountManager for user: %s. Cause: %w", userID, err)
+	}
+	accountManager, release := m.add(userID, accountManager)
+	return accountManager, release, nil
+}
+
+// DeleteUserAccountManager removes the AccountManager associated with the given userID from memory, and stops its clients
+// once the requests using it have released it.
+// It returns an error if no AccountManager is in memory for that userID.
 func (m *UserAccountManager) DeleteUserAccountManager(userID string) error {
-	_, exists := m.userAccountManager[userID]
-	if !exists {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if accountManager, found := m.pinnedAccountManagers[userID]; found {
+		accountManager.Close()
+		delete(m.pinnedAccountManagers, userID)
+		m.updateActiveUsers()
+		return nil
+	}
+	hydrated, found := m.accountManagers.Peek(userID)
+	if !found {
 		return fmt.Errorf("no UserAccountManager exists for userID %s", userID)
 	}
-	delete(m.userAccountManager, userID)
+	m.accountManagers.Remove(userID)
+	m.closeWhenUnused(hydrated)
+	m.updateActiveUsers()
 	return nil
 }
+
+// ActiveUsers returns the number of users whose AccountManager is in memory
+func (m *UserAccountManager) ActiveUsers() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.accountManagers.Len() + len(m.pinnedAccountManagers)
+}
+
+// ActiveSubscriptions returns the number of subscriptions still being served for each user whose AccountManager is in
+// memory. The users without subscriptions are omitted.
+func (m *UserAccountManager) ActiveSubscriptions() map[string]int64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	subscriptions := make(map[string]int64)
+	for userID, accountManager := range m.pinnedAccountManagers {
+		if active := accountManager.ActiveSubscriptions(); active > 0 {
+			subscriptions[userID] = active
+		}
+	}
+	for _, userID := range m.accountManagers.Keys() {
+		hydrated, _ := m.accountManagers.Peek(userID)
+		if active := hydrated.accountManager.ActiveSubscriptions(); active > 0 {
+			subscriptions[userID] = active
+		}
+	}
+	return subscriptions
+}
+
+func (m *UserAccountManager) get(userID string) (*accountmanager.AccountManager, func(), bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if accountManager, found := m.pinnedAccountManagers[userID]; found {
+		return accountManager, func() {}, true
+	}
+	hydrated, found := m.accountManagers.Get(userID)
+	if !found {
+		return nil, nil, false
+	}
+	return hydrated.accountManager, m.acquire(hydrated), true
+}
+
+// add keeps the AccountManager in memory, evicting the least recently used one if the maximum is reached.
+// If another request added an AccountManager for the same user in the meantime, that one is returned instead.
+func (m *UserAccountManager) add(userID string, accountManager *accountmanager.AccountManager) (*accountmanager.AccountManager, func()) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if existing, found := m.pinnedAccountManagers[userID]; found {
+		accountManager.Close()
+		return existing, func() {}
+	}
+	if existing, found := m.accountManagers.Get(userID); found {
+		accountManager.Close()
+		return existing.accountManager, m.acquire(existing)
+	}
+
+	m.evictLeastRecentlyUsed()
+	hydrated := &hydratedAccountManager{accountManager: accountManager}
+	m.accountManagers.Add(userID, hydrated)
+	m.updateActiveUsers()
+	return accountManager, m.acquire(hydrated)
+}
+
+// acquire marks the AccountManager as used by a request, and returns the function releasing it.
+// It must be called while holding the lock.
+func (m *UserAccountManager) acquire(hydrated *hydratedAccountManager) func() {
+	hydrated.inUse++
+	hydrated.lastUsed = time.Now()
+
+	var releaseOnce sync.Once
+	return func() {
+		releaseOnce.Do(func() {
+			m.mutex.Lock()
+			defer m.mutex.Unlock()
+			hydrated.inUse--
+			hydrated.lastUsed = time.Now()
+			if hydrated.inUse == 0 && hydrated.closeWhenUnused {
+				hydrated.accountManager.Close()
+			}
+		})
+	}
+}
+
+// closeWhenUnused stops the clients of an AccountManager that was removed from memory, or defers it until the requests
+// using it have released it. It must be called while holding the lock.
+func (m *UserAccountManager) closeWhenUnused(hydrated *hydratedAccountManager) {
+	if hydrated.inUse > 0 {
+		hydrated.closeWhenUnused = true
+		return
+	}
+	hydrated.accountManager.Close()
+}
+
+// evictLeastRecentlyUsed makes room for a new AccountManager. The ones serving subscriptions or requests are kept, even if
+// it means going above the maximum, as evicting them would end the subscriptions and fail the requests.
+func (m *UserAccountManager) evictLeastRecentlyUsed() {
+	if m.maxHydratedUsers <= 0 {
+		return
+	}
+	for attempts := m.accountManagers.Len(); attempts > 0 && m.accountManagers.Len() >= m.maxHydratedUsers; attempts-- {
+		userID, hydrated, _ := m.accountManagers.RemoveOldest()
+		if hydrated.inUse > 0 || hydrated.accountManager.HasActiveSubscriptions() {
+			m.accountManagers.Add(userID, hydrated)
+			continue
+		}
+		hydrated.accountManager.Close()
+		m.evictionsCounter.Inc(1)
+	}
+}
+
+// evictIdle evicts the AccountManagers that were not used since the idle timeout, and are not serving subscriptions or requests
+func (m *UserAccountManager) evictIdle(now time.Time) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, userID := range m.accountManagers.Keys() {
+		hydrated, _ := m.accountManagers.Peek(userID)
+		if hydrated.inUse > 0 || now.Sub(hydrated.lastUsed) < m.idleTimeout || hydrated.accountManager.HasActiveSubscriptions() {
+			continue
+		}
+		hydrated.accountManager.Close()
+		m.accountManagers.Remove(userID)
+		m.evictionsCounter.Inc(1)
+	}
+	m.updateActiveUsers()
+}
+
+// hydrate rebuilds the AccountManager of the user from the accounts stored in the database
+func (m *UserAccountManager) hydrate(userID string) (*accountmanager.AccountManager, error) {
+	userIDBytes, err := wecommon.GetUserIDbyte(userID)
+	if err != nil {
+		return nil, fmt.Errorf("userID is not hex encoded. Cause: %w", errutil.ErrNotFound)
+	}
+	privateKey, err := m.storage.GetUserPrivateKey(userIDBytes)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := m.storage.GetAccounts(userIDBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	accountManager := accountmanager.NewAccountManager(m.unauthenticatedClient, m.subscriptionConfig, m.logger)
+	for _, account := range accounts {
+		encClient, err := wecommon.CreateEncClient(m.hostPool.NewClient(userID), account.AccountAddress, privateKey, account.Signature)
+		if err != nil {
+			m.logger.Error("error creating new client", "userID", userID, log.ErrKey, err)
+			continue
+		}
+		accountManager.AddClient(gethcommon.BytesToAddress(account.AccountAddress), encClient)
+	}
+	m.hydrationsCounter.Inc(1)
+	return accountManager, nil
+}
+
+func (m *UserAccountManager) updateActiveUsers() {
+	m.activeUsersGauge.Update(int64(m.accountManagers.Len() + len(m.pinnedAccountManagers)))
+}
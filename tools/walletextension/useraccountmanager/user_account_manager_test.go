@@ -1,26 +1,38 @@
 package useraccountmanager
 
 import (
+	"encoding/hex"
+	"math/rand"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
 	"github.com/obscuronet/go-obscuro/go/rpc"
+	"github.com/obscuronet/go-obscuro/integration/datagenerator"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/accountmanager"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/hostpool"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAddingAndGettingUserAccountManagers(t *testing.T) {
 	unauthedClient, _ := rpc.NewNetworkClient("ws://test")
-	userAccountManager := NewUserAccountManager(unauthedClient, log.New())
+	userAccountManager := NewUserAccountManager(unauthedClient, newInMemoryStorage(), newHostPool(t, "ws://test"), 0, 0, accountmanager.SubscriptionConfig{}, metrics.NewRegistry(), log.New())
 	userID1 := "user1"
 	userID2 := "user2"
 
 	// Test adding and getting account manager for userID1
 	userAccountManager.AddAndReturnAccountManager(userID1)
-	accManager1, err := userAccountManager.GetUserAccountManager(userID1)
+	accManager1, _, err := userAccountManager.GetUserAccountManager(userID1)
 	if err != nil {
 		t.Fatal(err)
 	}
 	// We should get error if we try to get Account manager for User2
-	_, err = userAccountManager.GetUserAccountManager(userID2)
+	_, _, err = userAccountManager.GetUserAccountManager(userID2)
 
 	if err == nil {
 		t.Fatal("expecting error when trying to get AccountManager for user that doesn't exist.")
@@ -28,7 +40,7 @@ func TestAddingAndGettingUserAccountManagers(t *testing.T) {
 
 	// After trying to add new AccountManager for the same user we should get the same instance (not overriding old one)
 	userAccountManager.AddAndReturnAccountManager(userID1)
-	accManager1New, err := userAccountManager.GetUserAccountManager(userID1)
+	accManager1New, _, err := userAccountManager.GetUserAccountManager(userID1)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -39,7 +51,7 @@ func TestAddingAndGettingUserAccountManagers(t *testing.T) {
 
 	// We get a new instance of AccountManager when we add it for a new user
 	userAccountManager.AddAndReturnAccountManager(userID2)
-	accManager2, err := userAccountManager.GetUserAccountManager(userID2)
+	accManager2, _, err := userAccountManager.GetUserAccountManager(userID2)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -51,7 +63,7 @@ func TestAddingAndGettingUserAccountManagers(t *testing.T) {
 
 func TestDeletingUserAccountManagers(t *testing.T) {
 	unauthedClient, _ := rpc.NewNetworkClient("ws://test")
-	userAccountManager := NewUserAccountManager(unauthedClient, log.New())
+	userAccountManager := NewUserAccountManager(unauthedClient, newInMemoryStorage(), newHostPool(t, "ws://test"), 0, 0, accountmanager.SubscriptionConfig{}, metrics.NewRegistry(), log.New())
 	userID := "user1"
 
 	// Add an account manager for the user
@@ -64,7 +76,7 @@ func TestDeletingUserAccountManagers(t *testing.T) {
 	}
 
 	// After deleting, we should get an error if we try to get the user's account manager
-	_, err = userAccountManager.GetUserAccountManager(userID)
+	_, _, err = userAccountManager.GetUserAccountManager(userID)
 	if err == nil {
 		t.Fatal("expected an error after trying to get a deleted account manager")
 	}
@@ -75,3 +87,251 @@ func TestDeletingUserAccountManagers(t *testing.T) {
 		t.Fatal("expected an error after trying to delete an account manager that doesn't exist")
 	}
 }
+
+func TestAccountManagersAreLoadedLazilyAndEvicted(t *testing.T) {
+	const (
+		numUsers         = 2_000
+		maxHydratedUsers = 100
+		numWorkers       = 20
+		requestsPerUser  = 3
+		idleTimeout      = time.Minute
+	)
+
+	storage := newInMemoryStorage()
+	userIDs := make([]string, numUsers)
+	for i := range userIDs {
+		privateKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		userID := datagenerator.RandomBytes(20)
+		require.NoError(t, storage.AddUser(userID, crypto.FromECDSA(privateKey)))
+		require.NoError(t, storage.AddAccount(userID, datagenerator.RandomAddress().Bytes(), datagenerator.RandomBytes(65)))
+		userIDs[i] = hex.EncodeToString(userID)
+	}
+
+	userAccountManager := NewUserAccountManager(nil, storage, newHostPool(t, "ws://test"), maxHydratedUsers, idleTimeout, accountmanager.SubscriptionConfig{}, metrics.NewRegistry(), log.New())
+	defer userAccountManager.Stop()
+
+	// the users make requests concurrently, in a random order
+	var wg sync.WaitGroup
+	requests := make(chan string)
+	for w := 0; w < numWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for userID := range requests {
+				accountManager, release, err := userAccountManager.GetUserAccountManager(userID)
+				if err != nil {
+					t.Error(err)
+					continue
+				}
+				if accountManager == nil {
+					t.Error("nil account manager returned")
+				}
+				release()
+			}
+		}()
+	}
+	for _, i := range rand.Perm(numUsers * requestsPerUser) { //nolint:gosec
+		requests <- userIDs[i%numUsers]
+	}
+	close(requests)
+	wg.Wait()
+
+	require.LessOrEqual(t, userAccountManager.ActiveUsers(), maxHydratedUsers)
+
+	// an unknown user is not created by a lookup
+	_, _, err := userAccountManager.GetUserAccountManager(hex.EncodeToString(datagenerator.RandomBytes(20)))
+	require.ErrorIs(t, err, errutil.ErrNotFound)
+
+	// the users that are idle are evicted, unless they are pinned
+	_, err = userAccountManager.PinAccountManager(hex.EncodeToString([]byte(common.DefaultUser)))
+	require.NoError(t, err)
+	userAccountManager.evictIdle(time.Now().Add(idleTimeout))
+	require.Equal(t, 1, userAccountManager.ActiveUsers())
+
+	// an evicted user is loaded again on its next request
+	accountManager, release, err := userAccountManager.GetUserAccountManager(userIDs[0])
+	require.NoError(t, err)
+	require.NotNil(t, accountManager)
+	release()
+	require.Equal(t, 2, userAccountManager.ActiveUsers())
+}
+
+func TestAccountManagersInUseAreNotEvicted(t *testing.T) {
+	const idleTimeout = time.Minute
+
+	storage := newInMemoryStorage()
+	userIDs := make([]string, 3)
+	for i := range userIDs {
+		privateKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		userID := datagenerator.RandomBytes(20)
+		require.NoError(t, storage.AddUser(userID, crypto.FromECDSA(privateKey)))
+		userIDs[i] = hex.EncodeToString(userID)
+	}
+
+	userAccountManager := NewUserAccountManager(nil, storage, newHostPool(t, "ws://test"), 1, idleTimeout, accountmanager.SubscriptionConfig{}, metrics.NewRegistry(), log.New())
+	defer userAccountManager.Stop()
+
+	// a request of the first user is in flight while the second user is loaded
+	inFlight, releaseInFlight, err := userAccountManager.GetUserAccountManager(userIDs[0])
+	require.NoError(t, err)
+	_, release, err := userAccountManager.GetUserAccountManager(userIDs[1])
+	require.NoError(t, err)
+	release()
+	require.Equal(t, 2, userAccountManager.ActiveUsers())
+
+	// nor is it evicted for being idle
+	userAccountManager.evictIdle(time.Now().Add(idleTimeout))
+	require.Equal(t, 1, userAccountManager.ActiveUsers())
+
+	// the request still gets the same AccountManager, until it is released
+	accountManager, releaseAgain, err := userAccountManager.GetUserAccountManager(userIDs[0])
+	require.NoError(t, err)
+	require.Same(t, inFlight, accountManager)
+	releaseAgain()
+	releaseInFlight()
+	releaseInFlight() // releasing twice has no effect
+
+	// once released, it is evicted to make room for another user
+	_, release, err = userAccountManager.GetUserAccountManager(userIDs[2])
+	require.NoError(t, err)
+	release()
+	require.Equal(t, 1, userAccountManager.ActiveUsers())
+	_, found := userAccountManager.accountManagers.Peek(userIDs[0])
+	require.False(t, found)
+
+	// an AccountManager deleted while in use is only closed once released
+	_, release, err = userAccountManager.GetUserAccountManager(userIDs[2])
+	require.NoError(t, err)
+	hydrated, _ := userAccountManager.accountManagers.Peek(userIDs[2])
+	require.NoError(t, userAccountManager.DeleteUserAccountManager(userIDs[2]))
+	require.True(t, hydrated.closeWhenUnused)
+	require.Equal(t, 1, hydrated.inUse)
+	release()
+	require.Equal(t, 0, hydrated.inUse)
+}
+
+// inMemoryStorage is a storage.Storage keeping the users in memory
+type inMemoryStorage struct {
+	users    map[string][]byte
+	accounts map[string][]common.AccountDB
+	mutex    sync.RWMutex
+}
+
+func newInMemoryStorage() *inMemoryStorage {
+	return &inMemoryStorage{
+		users:    map[string][]byte{},
+		accounts: map[string][]common.AccountDB{},
+	}
+}
+
+func (s *inMemoryStorage) AddUser(userID []byte, privateKey []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.users[string(userID)] = privateKey
+	return nil
+}
+
+func (s *inMemoryStorage) DeleteUser(userID []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.users, string(userID))
+	delete(s.accounts, string(userID))
+	return nil
+}
+
+func (s *inMemoryStorage) GetUserPrivateKey(userID []byte) ([]byte, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	privateKey, found := s.users[string(userID)]
+	if !found {
+		return nil, errutil.ErrNotFound
+	}
+	return privateKey, nil
+}
+
+func (s *inMemoryStorage) AddAccount(userID []byte, accountAddress []byte, signature []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.accounts[string(userID)] = append(s.accounts[string(userID)], common.AccountDB{AccountAddress: accountAddress, Signature: signature})
+	return nil
+}
+
+func (s *inMemoryStorage) GetAccounts(userID []byte) ([]common.AccountDB, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.accounts[string(userID)], nil
+}
+
+func (s *inMemoryStorage) GetAllUsers() ([]common.UserDB, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	users := make([]common.UserDB, 0, len(s.users))
+	for userID, privateKey := range s.users {
+		users = append(users, common.UserDB{UserID: []byte(userID), PrivateKey: privateKey})
+	}
+	return users, nil
+}
+
+func (s *inMemoryStorage) GetAllUserAccounts() ([]common.UserAccountsDB, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	users := make([]common.UserAccountsDB, 0, len(s.users))
+	for userID := range s.users {
+		user := common.UserAccountsDB{UserID: []byte(userID)}
+		for _, account := range s.accounts[userID] {
+			user.AccountAddresses = append(user.AccountAddresses, account.AccountAddress)
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+func (s *inMemoryStorage) RotateMasterKey([]byte) error {
+	return nil
+}
+
+func (s *inMemoryStorage) GetUserLimits([]byte) (*common.UserLimitsDB, error) {
+	return nil, errutil.ErrNotFound
+}
+
+func (s *inMemoryStorage) SetUserLimits([]byte, common.UserLimitsDB) error {
+	return nil
+}
+
+func (s *inMemoryStorage) DeleteUserLimits([]byte) error {
+	return nil
+}
+
+func (s *inMemoryStorage) AddComputeUnits([]byte, string, uint64) error {
+	return nil
+}
+
+func (s *inMemoryStorage) GetComputeUnits([]byte, string) (uint64, error) {
+	return 0, nil
+}
+
+func (s *inMemoryStorage) GetUsersOfAccount([]byte) ([][]byte, error) {
+	return nil, nil
+}
+
+func (s *inMemoryStorage) AddSession([]byte, common.SessionDB, int64) error {
+	return nil
+}
+
+func (s *inMemoryStorage) GetSession([]byte) (*common.SessionDB, error) {
+	return nil, errutil.ErrNotFound
+}
+
+func (s *inMemoryStorage) DeleteSession([]byte) error {
+	return nil
+}
+
+// newHostPool returns a pool of hosts that are never health-checked. Its clients only connect to the host when a request
+// is made, so the clients of thousands of users can be created without a node.
+func newHostPool(t *testing.T, address string) *hostpool.Pool {
+	pool, err := hostpool.New([]string{address}, 0, 1, time.Minute, log.New())
+	require.NoError(t, err)
+	return pool
+}
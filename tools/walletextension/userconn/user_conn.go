@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -31,6 +32,7 @@ type UserConn interface {
 	HandleError(msg string)
 	SupportsSubscriptions() bool
 	IsClosed() bool
+	ClientIP() string
 }
 
 // Represents a user's connection over HTTP.
@@ -106,6 +108,11 @@ func (h *userConnHTTP) ReadRequestParams() map[string]string {
 	return getQueryParams(h.req.URL.Query())
 }
 
+// ClientIP returns the IP the request was sent from
+func (h *userConnHTTP) ClientIP() string {
+	return clientIP(h.req)
+}
+
 func (w *userConnWS) ReadRequest() ([]byte, error) {
 	_, msg, err := w.conn.ReadMessage()
 	if err != nil {
@@ -166,6 +173,11 @@ func (w *userConnWS) ReadRequestParams() map[string]string {
 	return getQueryParams(w.req.URL.Query())
 }
 
+// ClientIP returns the IP the websocket connection was opened from
+func (w *userConnWS) ClientIP() string {
+	return clientIP(w.req)
+}
+
 // Logs the error, prints it to the console, and returns the error over HTTP.
 func httpLogAndSendErr(resp http.ResponseWriter, msg string) {
 	http.Error(resp, msg, httpCodeErr)
@@ -179,3 +191,12 @@ func getQueryParams(query url.Values) map[string]string {
 	}
 	return params
 }
+
+// clientIP returns the IP the request was sent from
+func clientIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
@@ -3,10 +3,12 @@ package walletextension
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"crypto/subtle"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/obscuronet/go-obscuro/go/common/log"
 
@@ -14,12 +16,18 @@ import (
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/ecies"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/go-kit/kit/transport/http/jsonrpc"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
 	"github.com/obscuronet/go-obscuro/go/common/stopcontrol"
 	"github.com/obscuronet/go-obscuro/go/common/viewingkey"
 	"github.com/obscuronet/go-obscuro/go/rpc"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/accountmanager"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/hostpool"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/metrics"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/ratelimiter"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/session"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/storage"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/userconn"
 
@@ -27,32 +35,65 @@ import (
 	gethlog "github.com/ethereum/go-ethereum/log"
 )
 
-var ErrSubscribeFailHTTP = fmt.Sprintf("received an %s request but the connection does not support subscriptions", rpc.Subscribe)
+var (
+	ErrSubscribeFailHTTP     = fmt.Sprintf("received an %s request but the connection does not support subscriptions", rpc.Subscribe)
+	ErrLegacySignatureDenied = errors.New("personal-sign text signatures are not accepted, the viewing key must be signed as EIP-712 typed data")
+)
 
 // WalletExtension handles the management of viewing keys and the forwarding of Ethereum JSON-RPC requests.
 type WalletExtension struct {
-	hostAddr           string // The address on which the Obscuro host can be reached.
+	hostPool           *hostpool.Pool // The Obscuro hosts the requests are sent to
 	userAccountManager *useraccountmanager.UserAccountManager
 	unsignedVKs        map[gethcommon.Address]*viewingkey.ViewingKey // Map temporarily holding VKs that have been generated but not yet signed
 	storage            storage.Storage
 	logger             gethlog.Logger
 	stopControl        *stopcontrol.StopControl
+	maxBatchSize       int // The maximum number of requests accepted in a single JSON-RPC batch
+	rateLimiter        *ratelimiter.RateLimiter
+	adminAPIKey        string // The key authenticating the admin requests. The admin endpoints are disabled if it is empty
+	obscuroChainID     int64  // The chain ID the EIP-712 signatures of the viewing keys are bound to
+	viewingKeyValidity time.Duration
+	allowLegacySigs    bool // Whether viewing keys signed as personal-sign text are accepted
+	metrics            *metrics.Metrics
+	sessions           *session.Manager // The sessions of the users who signed in with Ethereum. Nil if Sign-In-With-Ethereum is disabled.
+}
+
+// UserAccounts are the accounts registered by a user
+type UserAccounts struct {
+	HexUserID string
+	Accounts  []gethcommon.Address
 }
 
 func New(
-	hostAddr string,
+	hostPool *hostpool.Pool,
 	userAccountManager *useraccountmanager.UserAccountManager,
 	storage storage.Storage,
 	stopControl *stopcontrol.StopControl,
+	maxBatchSize int,
+	rateLimiter *ratelimiter.RateLimiter,
+	adminAPIKey string,
+	obscuroChainID int64,
+	viewingKeyValidity time.Duration,
+	allowLegacySigs bool,
+	metrics *metrics.Metrics,
+	sessions *session.Manager,
 	logger gethlog.Logger,
 ) *WalletExtension {
 	return &WalletExtension{
-		hostAddr:           hostAddr,
+		hostPool:           hostPool,
 		userAccountManager: userAccountManager,
 		unsignedVKs:        map[gethcommon.Address]*viewingkey.ViewingKey{},
 		storage:            storage,
 		logger:             logger,
 		stopControl:        stopControl,
+		maxBatchSize:       maxBatchSize,
+		rateLimiter:        rateLimiter,
+		adminAPIKey:        adminAPIKey,
+		obscuroChainID:     obscuroChainID,
+		viewingKeyValidity: viewingKeyValidity,
+		allowLegacySigs:    allowLegacySigs,
+		metrics:            metrics,
+		sessions:           sessions,
 	}
 }
 
@@ -66,8 +107,53 @@ func (w *WalletExtension) Logger() gethlog.Logger {
 	return w.logger
 }
 
-// ProxyEthRequest proxys an incoming user request to the enclave
+// MaxBatchSize returns the maximum number of requests accepted in a single JSON-RPC batch
+func (w *WalletExtension) MaxBatchSize() int {
+	return w.maxBatchSize
+}
+
+// RateLimiter returns the rate limiter throttling the requests of the users
+func (w *WalletExtension) RateLimiter() *ratelimiter.RateLimiter {
+	return w.rateLimiter
+}
+
+// Metrics returns the metrics of the requests proxied by the WE
+func (w *WalletExtension) Metrics() *metrics.Metrics {
+	return w.metrics
+}
+
+// Sessions returns the manager of the sessions of the users who signed in with Ethereum, or nil if Sign-In-With-Ethereum
+// is disabled
+func (w *WalletExtension) Sessions() *session.Manager {
+	return w.sessions
+}
+
+// ActiveSubscriptions returns the number of subscriptions still being served for each user that has some
+func (w *WalletExtension) ActiveSubscriptions() map[string]int64 {
+	return w.userAccountManager.ActiveSubscriptions()
+}
+
+// IsAdmin returns whether the key authenticates an admin request
+func (w *WalletExtension) IsAdmin(apiKey string) bool {
+	return w.adminAPIKey != "" && subtle.ConstantTimeCompare([]byte(apiKey), []byte(w.adminAPIKey)) == 1
+}
+
+// ProxyEthRequest proxys an incoming user request to the enclave, and records its latency and outcome
 func (w *WalletExtension) ProxyEthRequest(request *accountmanager.RPCRequest, conn userconn.UserConn, hexUserID string) (map[string]interface{}, error) {
+	start := time.Now()
+	response, err := w.proxyEthRequest(request, conn, hexUserID)
+	w.metrics.RecordRequest(request.Method, time.Since(start), err != nil || response[common.JSONKeyErr] != nil)
+	return response, err
+}
+
+func (w *WalletExtension) proxyEthRequest(request *accountmanager.RPCRequest, conn userconn.UserConn, hexUserID string) (map[string]interface{}, error) {
+	// the request is charged to the user and to their IP before reaching the host
+	err := w.rateLimiter.Allow(hexUserID, conn.ClientIP(), request.Method)
+	if err != nil {
+		w.logger.Debug("request throttled", "method", request.Method, "userID", hexUserID, log.ErrKey, err)
+		return nil, err
+	}
+
 	response := map[string]interface{}{}
 	// all responses must contain the request id. Both successful and unsuccessful.
 	response[common.JSONKeyRPCVersion] = jsonrpc.Version
@@ -85,11 +171,13 @@ func (w *WalletExtension) ProxyEthRequest(request *accountmanager.RPCRequest, co
 	}
 
 	// get account manager for current user (if there is no users in the query parameters - use defaultUser for WE endpoints)
-	selectedAccountManager, err := w.userAccountManager.GetUserAccountManager(hexUserID)
+	selectedAccountManager, release, err := w.userAccountManager.GetUserAccountManager(hexUserID)
 	if err != nil {
 		w.Logger().Error(fmt.Errorf("error getting accountManager for user (%s), %w", hexUserID, err).Error())
 		return nil, err
 	}
+	defer release()
+	w.metrics.RecordUserRequest(hexUserID)
 
 	err = selectedAccountManager.ProxyRequest(request, &rpcResp, conn)
 
@@ -130,28 +218,65 @@ func (w *WalletExtension) GenerateViewingKey(addr gethcommon.Address) (string, e
 	return hex.EncodeToString(viewingKeyBytes), nil
 }
 
-// SubmitViewingKey checks the signed viewing key and stores it
+// SubmitViewingKey checks the viewing key signed as personal-sign text and stores it
 func (w *WalletExtension) SubmitViewingKey(address gethcommon.Address, signature []byte) error {
+	if !w.allowLegacySigs {
+		return ErrLegacySignatureDenied
+	}
+
 	vk, found := w.unsignedVKs[address]
 	if !found {
 		return fmt.Errorf(fmt.Sprintf("no viewing key found to sign for acc=%s, please call %s to generate key before sending signature", address, common.PathGenerateViewingKey))
 	}
+	if len(signature) != common.SignatureLen {
+		return errors.New("incorrect signature length")
+	}
 
 	// We transform the V from 27/28 to 0/1. This same change is made in Geth internals, for legacy reasons to be able
 	// to recover the address: https://github.com/ethereum/go-ethereum/blob/55599ee95d4151a2502465e0afc7c47bd1acba77/internal/ethapi/api.go#L452-L459
 	signature[64] -= 27
 
+	return w.storeViewingKey(address, vk, signature)
+}
+
+// SubmitViewingKeyEIP712 checks the viewing key signed as EIP-712 typed data (see viewingkey.GenerateEIP712TypedData)
+// and stores it
+func (w *WalletExtension) SubmitViewingKeyEIP712(address gethcommon.Address, signature []byte, issuedAt uint64, expiry uint64) error {
+	vk, found := w.unsignedVKs[address]
+	if !found {
+		return fmt.Errorf(fmt.Sprintf("no viewing key found to sign for acc=%s, please call %s to generate key before sending signature", address, common.PathGenerateViewingKey))
+	}
+	if len(signature) != common.SignatureLen {
+		return errors.New("incorrect signature length")
+	}
+
+	// the V is transformed from 27/28 to 0/1 as for the personal-sign signatures
+	signature[64] -= 27
+	encodedSignature := viewingkey.EncodeEIP712Signature(signature, issuedAt, expiry)
+
+	err := viewingkey.CheckEIP712Signature(&address, vk.PublicKey, encodedSignature, w.obscuroChainID, time.Now())
+	if err != nil {
+		return fmt.Errorf("invalid signature for acc=%s - %w", address, err)
+	}
+
+	return w.storeViewingKey(address, vk, encodedSignature)
+}
+
+// storeViewingKey registers the signed viewing key for the default user, and stores it
+func (w *WalletExtension) storeViewingKey(address gethcommon.Address, vk *viewingkey.ViewingKey, signature []byte) error {
 	vk.Signature = signature
 	// create an encrypted RPC client with the signed VK and register it with the enclave
 	// todo (@ziga) - Create the clients lazily, to reduce connections to the host.
-	client, err := rpc.NewEncNetworkClient(w.hostAddr, vk, w.logger)
+	defaultUserID := hex.EncodeToString([]byte(common.DefaultUser))
+	client, err := rpc.NewEncRPCClient(w.hostPool.NewClient(defaultUserID), vk, w.logger)
 	if err != nil {
 		return fmt.Errorf("failed to create encrypted RPC client for account %s - %w", address, err)
 	}
-	defaultAccountManager, err := w.userAccountManager.GetUserAccountManager(hex.EncodeToString([]byte(common.DefaultUser)))
+	defaultAccountManager, release, err := w.userAccountManager.GetUserAccountManager(defaultUserID)
 	if err != nil {
 		return fmt.Errorf(fmt.Sprintf("error getting default user account manager: %s", err))
 	}
+	defer release()
 
 	defaultAccountManager.AddClient(address, client)
 
@@ -195,13 +320,22 @@ func (w *WalletExtension) GenerateAndStoreNewUser() (string, error) {
 
 	hexUserID := hex.EncodeToString(userID)
 
-	w.userAccountManager.AddAndReturnAccountManager(hexUserID)
+	_, release, err := w.userAccountManager.AddAndReturnAccountManager(hexUserID)
+	if err != nil {
+		w.Logger().Error(fmt.Errorf("error creating UserAccountManager for user (%s), %w", hexUserID, err).Error())
+		return "", err
+	}
+	release()
 
 	return hexUserID, nil
 }
 
 // AddAddressToUser checks if message is in correct format and if signature is valid. If all checks pass we save address and signature against userID
 func (w *WalletExtension) AddAddressToUser(hexUserID string, message string, signature []byte) error {
+	if !w.allowLegacySigs {
+		return ErrLegacySignatureDenied
+	}
+
 	// parse the message to get userID and account address
 	messageUserID, messageAddressHex, err := common.GetUserIDAndAddressFromMessage(message)
 	if err != nil {
@@ -224,29 +358,107 @@ func (w *WalletExtension) AddAddressToUser(hexUserID string, message string, sig
 		return err
 	}
 
+	return w.addAccountToUser(hexUserID, addressFromMessage, signature)
+}
+
+// AddAddressToUserEIP712 checks if the signature of the EIP-712 typed data (see GenerateEIP712TypedData) is valid and
+// has not expired. If all checks pass we save the address recovered from the signature against userID
+func (w *WalletExtension) AddAddressToUserEIP712(hexUserID string, signature []byte, issuedAt uint64, expiry uint64) error {
+	userIDBytes, err := common.GetUserIDbyte(hexUserID)
+	if err != nil {
+		w.Logger().Error(fmt.Errorf("error decoding string (%s), %w", hexUserID, err).Error())
+		return errors.New("error decoding userID. It should be in hex format")
+	}
+
+	// check if the signature length is correct
+	if len(signature) != common.SignatureLen {
+		return errors.New("incorrect signature length")
+	}
+
+	privateKeyBytes, err := w.storage.GetUserPrivateKey(userIDBytes)
+	if err != nil {
+		w.Logger().Error(fmt.Errorf("error getting private key for user: (%s), %w", hexUserID, err).Error())
+		return err
+	}
+	privateKey, err := common.BytesToPrivateKey(privateKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	// the V is transformed from 27/28 to 0/1 as for the personal-sign signatures
+	signature[64] -= 27
+	encodedSignature := viewingkey.EncodeEIP712Signature(signature, issuedAt, expiry)
+
+	// the signer is recovered from the typed data, which binds the viewing key of the user to the chain ID
+	address, err := viewingkey.RecoverEIP712Signer(common.PrivateKeyToCompressedPubKey(privateKey), encodedSignature, w.obscuroChainID, time.Now())
+	if err != nil {
+		w.Logger().Error(fmt.Errorf("error: EIP-712 signature is not valid for user (%s), %w", hexUserID, err).Error())
+		return err
+	}
+
+	return w.addAccountToUser(hexUserID, address, encodedSignature)
+}
+
+// GenerateEIP712TypedData returns the typed data the user signs with eth_signTypedData_v4 to register an account
+func (w *WalletExtension) GenerateEIP712TypedData(hexUserID string) (*apitypes.TypedData, error) {
+	userIDBytes, err := common.GetUserIDbyte(hexUserID)
+	if err != nil {
+		w.Logger().Error(fmt.Errorf("error decoding string (%s), %w", hexUserID, err).Error())
+		return nil, errors.New("error decoding userID. It should be in hex format")
+	}
+
+	privateKeyBytes, err := w.storage.GetUserPrivateKey(userIDBytes)
+	if err != nil {
+		w.Logger().Error(fmt.Errorf("error getting private key for user: (%s), %w", hexUserID, err).Error())
+		return nil, err
+	}
+	privateKey, err := common.BytesToPrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	issuedAt := time.Now()
+	typedData := viewingkey.GenerateEIP712TypedData(
+		common.PrivateKeyToCompressedPubKey(privateKey),
+		w.obscuroChainID,
+		uint64(issuedAt.Unix()),
+		uint64(issuedAt.Add(w.viewingKeyValidity).Unix()),
+	)
+	return &typedData, nil
+}
+
+// addAccountToUser saves the address and signature against userID, and creates the client of the account
+func (w *WalletExtension) addAccountToUser(hexUserID string, addressFromMessage gethcommon.Address, signature []byte) error {
 	// register the account for that viewing key
 	userIDBytes, err := common.GetUserIDbyte(hexUserID)
 	if err != nil {
 		w.Logger().Error(fmt.Errorf("error decoding string (%s), %w", hexUserID[2:], err).Error())
 		return errors.New("error decoding userID. It should be in hex format")
 	}
+	// Get account manager for current userID (and create it if it doesn't exist).
+	// It is loaded before the account is stored, so the client for the new account is only created once.
+	accManager, release, err := w.userAccountManager.AddAndReturnAccountManager(hexUserID)
+	if err != nil {
+		w.Logger().Error(fmt.Errorf("error getting UserAccountManager for user (%s), %w", hexUserID, err).Error())
+		return err
+	}
+	defer release()
+
 	err = w.storage.AddAccount(userIDBytes, addressFromMessage.Bytes(), signature)
 	if err != nil {
 		w.Logger().Error(fmt.Errorf("error while storing account (%s) for user (%s): %w", addressFromMessage.Hex(), hexUserID, err).Error())
 		return err
 	}
 
-	// Get account manager for current userID (and create it if it doesn't exist) accManager := w.userAccountManager.AddAndReturnAccountManager(messageUserID)
 	privateKeyBytes, err := w.storage.GetUserPrivateKey(userIDBytes)
 	if err != nil {
 		w.Logger().Error(fmt.Errorf("error getting private key for user: (%s), %w", hexUserID, err).Error())
 	}
 
-	accManager := w.userAccountManager.AddAndReturnAccountManager(hexUserID)
-
-	encClient, err := common.CreateEncClient(w.hostAddr, addressFromMessage.Bytes(), privateKeyBytes, signature)
+	encClient, err := common.CreateEncClient(w.hostPool.NewClient(hexUserID), addressFromMessage.Bytes(), privateKeyBytes, signature)
 	if err != nil {
 		w.Logger().Error(fmt.Errorf("error creating encrypted client for user: (%s), %w", hexUserID, err).Error())
+		return err
 	}
 
 	accManager.AddClient(addressFromMessage, encClient)
@@ -286,14 +498,59 @@ func (w *WalletExtension) UserHasAccount(hexUserID string, address string) (bool
 	return found, nil
 }
 
+// ListUsers returns the users stored in the database, with their registered accounts. The private keys of the users
+// are not read.
+func (w *WalletExtension) ListUsers() ([]UserAccounts, error) {
+	users, err := w.storage.GetAllUserAccounts()
+	if err != nil {
+		return nil, fmt.Errorf("could not get the users. Cause: %w", err)
+	}
+
+	userAccounts := make([]UserAccounts, 0, len(users))
+	for _, user := range users {
+		addresses := make([]gethcommon.Address, len(user.AccountAddresses))
+		for i, accountAddress := range user.AccountAddresses {
+			addresses[i] = gethcommon.BytesToAddress(accountAddress)
+		}
+		userAccounts = append(userAccounts, UserAccounts{HexUserID: hex.EncodeToString(user.UserID), Accounts: addresses})
+	}
+	return userAccounts, nil
+}
+
 // DeleteUser deletes user and accounts associated with user from database for given userID
 func (w *WalletExtension) DeleteUser(hexUserID string) error {
+	return w.deleteUser(hexUserID, false)
+}
+
+// ForceDeleteUser deletes the user like DeleteUser, even if their viewing keys could not be revoked in the enclaves
+func (w *WalletExtension) ForceDeleteUser(hexUserID string) error {
+	return w.deleteUser(hexUserID, true)
+}
+
+func (w *WalletExtension) deleteUser(hexUserID string, force bool) error {
 	userIDBytes, err := common.GetUserIDbyte(hexUserID)
 	if err != nil {
 		w.Logger().Error(fmt.Errorf("error decoding string (%s), %w", hexUserID, err).Error())
 		return err
 	}
 
+	// revoke the viewing keys in the enclaves first, so the user can retry if it fails
+	accManager, release, err := w.userAccountManager.GetUserAccountManager(hexUserID)
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		w.Logger().Error(fmt.Errorf("error getting AccountManager for user (%s), %w", hexUserID, err).Error())
+		return err
+	}
+	if accManager != nil {
+		// the clients are stopped once the AccountManager is both deleted and released
+		defer release()
+		if err = accManager.RevokeViewingKeys(); err != nil {
+			w.Logger().Error(fmt.Errorf("error revoking viewing keys of user (%s), %w", hexUserID, err).Error())
+			if !force {
+				return err
+			}
+		}
+	}
+
 	err = w.storage.DeleteUser(userIDBytes)
 	if err != nil {
 		w.Logger().Error(fmt.Errorf("error deleting user (%s), %w", hexUserID, err).Error())
@@ -305,6 +562,7 @@ func (w *WalletExtension) DeleteUser(hexUserID string) error {
 	if err != nil {
 		w.Logger().Error(fmt.Errorf("error deleting UserAccointManager for user (%s), %w", hexUserID, err).Error())
 	}
+	w.metrics.RemoveUser(hexUserID)
 
 	return nil
 }